@@ -0,0 +1,246 @@
+// Package app wires the repositories, services and handlers of the money
+// tracker together and exposes them as a single http.Handler.
+package app
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"modernc.org/sqlite"
+
+	"github.com/varomnrg/money-tracker/config"
+
+	kh "github.com/varomnrg/money-tracker/handler/apikey"
+	ah "github.com/varomnrg/money-tracker/handler/auth"
+	bh "github.com/varomnrg/money-tracker/handler/budget"
+	ch "github.com/varomnrg/money-tracker/handler/category"
+	nh "github.com/varomnrg/money-tracker/handler/notification"
+	rh "github.com/varomnrg/money-tracker/handler/rate"
+	eh "github.com/varomnrg/money-tracker/handler/recurring"
+	ph "github.com/varomnrg/money-tracker/handler/report"
+	th "github.com/varomnrg/money-tracker/handler/transaction"
+	fh "github.com/varomnrg/money-tracker/handler/transfer"
+	uh "github.com/varomnrg/money-tracker/handler/user"
+	wh "github.com/varomnrg/money-tracker/handler/wallet"
+
+	kr "github.com/varomnrg/money-tracker/repository/apikey"
+	br "github.com/varomnrg/money-tracker/repository/budget"
+	cr "github.com/varomnrg/money-tracker/repository/category"
+	"github.com/varomnrg/money-tracker/repository/memory"
+	nr "github.com/varomnrg/money-tracker/repository/notification"
+	rr "github.com/varomnrg/money-tracker/repository/rate"
+	er "github.com/varomnrg/money-tracker/repository/recurring"
+	sr "github.com/varomnrg/money-tracker/repository/session"
+	tr "github.com/varomnrg/money-tracker/repository/transaction"
+	fr "github.com/varomnrg/money-tracker/repository/transfer"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	ur "github.com/varomnrg/money-tracker/repository/user"
+	wr "github.com/varomnrg/money-tracker/repository/wallet"
+
+	ks "github.com/varomnrg/money-tracker/service/apikey"
+	as "github.com/varomnrg/money-tracker/service/auth"
+	bs "github.com/varomnrg/money-tracker/service/budget"
+	cs "github.com/varomnrg/money-tracker/service/category"
+	ns "github.com/varomnrg/money-tracker/service/notification"
+	rs "github.com/varomnrg/money-tracker/service/rate"
+	es "github.com/varomnrg/money-tracker/service/recurring"
+	ps "github.com/varomnrg/money-tracker/service/report"
+	ts "github.com/varomnrg/money-tracker/service/transaction"
+	fs "github.com/varomnrg/money-tracker/service/transfer"
+	us "github.com/varomnrg/money-tracker/service/user"
+	ws "github.com/varomnrg/money-tracker/service/wallet"
+)
+
+type Handlers struct {
+	Auth         *ah.AuthHandler
+	APIKey       *kh.APIKeyHandler
+	User         *uh.UserHandler
+	Category     *ch.CategoryHandler
+	Wallet       *wh.WalletHandler
+	Transaction  *th.TransactionHandler
+	Transfer     *fh.TransferHandler
+	Rate         *rh.RateHandler
+	Report       *ph.ReportHandler
+	Budget       *bh.BudgetHandler
+	Notification *nh.NotificationHandler
+	Recurring    *eh.RecurringHandler
+}
+
+type App struct {
+	DB       *sql.DB
+	Handlers Handlers
+	Router   *httprouter.Router
+	// Scheduler creates the transactions of recurring transactions as they
+	// fall due while Run serves the application.
+	Scheduler *es.Scheduler
+
+	notifications  *ns.NotificationService
+	requestTimeout time.Duration
+}
+
+// Repositories is the storage layer the services are built on.
+type Repositories struct {
+	User         ur.IUserRepository
+	Category     cr.ICategoryRepository
+	Wallet       wr.IWalletRepository
+	Transaction  tr.ITransactionRepository
+	Transfer     fr.ITransferRepository
+	Rate         rr.IRateRepository
+	Session      sr.ISessionRepository
+	APIKey       kr.IAPIKeyRepository
+	Budget       br.IBudgetRepository
+	Notification nr.INotificationRepository
+	Recurring    er.IRecurringRepository
+	UnitOfWork   unitofwork.IUnitOfWork
+}
+
+// PostgresqlRepositories builds every repository on the shared connection
+// pool.
+func PostgresqlRepositories(connectionPool *sql.DB) Repositories {
+	return Repositories{
+		User:         ur.NewPostgresqlUserRepository(connectionPool),
+		Category:     cr.NewPostgresqlCategoryRepository(connectionPool),
+		Wallet:       wr.NewPostgresqlWalletRepository(connectionPool),
+		Transaction:  tr.NewPostgresqlTransactionRepository(connectionPool),
+		Transfer:     fr.NewPostgresqlTransferRepository(connectionPool),
+		Rate:         rr.NewPostgresqlRateRepository(connectionPool),
+		Session:      sr.NewPostgresqlSessionRepository(connectionPool),
+		APIKey:       kr.NewPostgresqlAPIKeyRepository(connectionPool),
+		Budget:       br.NewPostgresqlBudgetRepository(connectionPool),
+		Notification: nr.NewPostgresqlNotificationRepository(connectionPool),
+		Recurring:    er.NewPostgresqlRecurringRepository(connectionPool),
+		UnitOfWork:   unitofwork.NewPostgresqlUnitOfWork(connectionPool),
+	}
+}
+
+// SqliteRepositories builds every repository on the shared SQLite connection
+// pool.
+func SqliteRepositories(connectionPool *sql.DB) Repositories {
+	return Repositories{
+		User:         ur.NewSqliteUserRepository(connectionPool),
+		Category:     cr.NewSqliteCategoryRepository(connectionPool),
+		Wallet:       wr.NewSqliteWalletRepository(connectionPool),
+		Transaction:  tr.NewSqliteTransactionRepository(connectionPool),
+		Transfer:     fr.NewSqliteTransferRepository(connectionPool),
+		Rate:         rr.NewSqliteRateRepository(connectionPool),
+		Session:      sr.NewSqliteSessionRepository(connectionPool),
+		APIKey:       kr.NewSqliteAPIKeyRepository(connectionPool),
+		Budget:       br.NewSqliteBudgetRepository(connectionPool),
+		Notification: nr.NewSqliteNotificationRepository(connectionPool),
+		Recurring:    er.NewSqliteRecurringRepository(connectionPool),
+		UnitOfWork:   unitofwork.NewSqliteUnitOfWork(connectionPool),
+	}
+}
+
+// MemoryRepositories builds every repository on store, which also serves as
+// their unit of work.
+func MemoryRepositories(store *memory.Store) Repositories {
+	return Repositories{
+		User:         ur.NewMemoryUserRepository(store),
+		Category:     cr.NewMemoryCategoryRepository(store),
+		Wallet:       wr.NewMemoryWalletRepository(store),
+		Transaction:  tr.NewMemoryTransactionRepository(store),
+		Transfer:     fr.NewMemoryTransferRepository(store),
+		Rate:         rr.NewMemoryRateRepository(store),
+		Session:      sr.NewMemorySessionRepository(store),
+		APIKey:       kr.NewMemoryAPIKeyRepository(store),
+		Budget:       br.NewMemoryBudgetRepository(store),
+		Notification: nr.NewMemoryNotificationRepository(store),
+		Recurring:    er.NewMemoryRecurringRepository(store),
+		UnitOfWork:   store,
+	}
+}
+
+// DatabaseRepositories builds every repository on connectionPool. The driver
+// of the pool decides between the PostgreSQL and SQLite implementations.
+func DatabaseRepositories(connectionPool *sql.DB) Repositories {
+	if _, ok := connectionPool.Driver().(*sqlite.Driver); ok {
+		return SqliteRepositories(connectionPool)
+	}
+
+	return PostgresqlRepositories(connectionPool)
+}
+
+// New builds the application on repositories sharing connectionPool, which
+// Close releases.
+func New(connectionPool *sql.DB, cfg config.Config) *App {
+	app := NewWithRepositories(DatabaseRepositories(connectionPool), cfg)
+	app.DB = connectionPool
+
+	return app
+}
+
+// NewWithRepositories builds the services on top of repos and the handlers,
+// and registers all routes.
+func NewWithRepositories(repos Repositories, cfg config.Config) *App {
+	userService := us.NewUserServiceWithPasswordCost(repos.User, repos.UnitOfWork, cfg.Auth.BcryptCost)
+	rateService := rs.NewRateService(repos.Rate, repos.UnitOfWork)
+	notificationService := ns.NewNotificationService(repos.Notification, repos.User, cfg.Notifications.Timeout, notificationChannels(cfg.Notifications)...)
+	budgetService := bs.NewBudgetService(repos.Budget, repos.Category, repos.Transaction, repos.User, rateService, notificationService, repos.UnitOfWork)
+	categoryService := cs.NewCategoryService(repos.Category, repos.User, repos.UnitOfWork)
+	walletService := ws.NewWalletService(repos.Wallet, repos.User, rateService, repos.UnitOfWork)
+	transactionService := ts.NewTransactionService(repos.Transaction, repos.Wallet, repos.Category, repos.User, rateService, budgetService, repos.UnitOfWork)
+	transferService := fs.NewTransferService(repos.Transfer, repos.Wallet, repos.User, rateService, repos.UnitOfWork)
+	authService := as.NewAuthService(userService, repos.Session, cfg.Auth.JWTSecret, cfg.Auth.AccessTokenTTL, cfg.Auth.RefreshTokenTTL)
+	apiKeyService := ks.NewAPIKeyService(repos.APIKey, repos.User)
+	reportService := ps.NewReportService(repos.Transaction, repos.User, rateService)
+	recurringService := es.NewRecurringService(repos.Recurring, repos.Wallet, repos.Category, repos.User, transactionService, repos.UnitOfWork)
+
+	app := &App{
+		Handlers: Handlers{
+			Auth:         ah.NewAuthHandler(authService, apiKeyService),
+			APIKey:       kh.NewAPIKeyHandler(apiKeyService),
+			User:         uh.NewUserHandler(userService),
+			Category:     ch.NewCategoryHandler(categoryService),
+			Wallet:       wh.NewWalletHandler(walletService),
+			Transaction:  th.NewTransactionHandler(transactionService),
+			Transfer:     fh.NewTransferHandler(transferService),
+			Rate:         rh.NewRateHandler(rateService),
+			Report:       ph.NewReportHandler(reportService),
+			Budget:       bh.NewBudgetHandler(budgetService),
+			Notification: nh.NewNotificationHandler(notificationService),
+			Recurring:    eh.NewRecurringHandler(recurringService),
+		},
+		Router:         httprouter.New(),
+		Scheduler:      es.NewScheduler(recurringService, cfg.Scheduler.Interval),
+		notifications:  notificationService,
+		requestTimeout: cfg.Server.RequestTimeout,
+	}
+
+	app.registerRoutes()
+
+	return app
+}
+
+func (a *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.Router.ServeHTTP(w, r)
+}
+
+// notificationChannels returns the delivery channels enabled in cfg.
+func notificationChannels(cfg config.Notifications) []ns.Channel {
+	var channels []ns.Channel
+
+	if cfg.WebhookURL != "" {
+		channels = append(channels, ns.NewWebhookChannel(cfg.WebhookURL, cfg.WebhookSecret))
+	}
+
+	if cfg.SMTPAddr != "" {
+		channels = append(channels, ns.NewSMTPChannel(cfg.SMTPAddr, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom))
+	}
+
+	return channels
+}
+
+// Close waits for notifications still being delivered, then releases the
+// shared connection pool, if the application has one.
+func (a *App) Close() error {
+	a.notifications.Wait()
+
+	if a.DB == nil {
+		return nil
+	}
+
+	return a.DB.Close()
+}
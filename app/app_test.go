@@ -0,0 +1,87 @@
+package app_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+var wildcard = regexp.MustCompile(`:\w+`)
+
+// SetupApp builds the application on a connection pool that is never used,
+// which is enough to exercise routing and authentication.
+func SetupApp(t *testing.T) *app.App {
+	db, err := sql.Open("postgres", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	application := app.New(db, config.Config{Auth: config.Auth{JWTSecret: "test-secret", AccessTokenTTL: time.Minute, RefreshTokenTTL: time.Hour, BcryptCost: 4}})
+	t.Cleanup(func() { application.Close() })
+
+	return application
+}
+
+func TestRoutesAreRegistered(t *testing.T) {
+	application := SetupApp(t)
+
+	for _, route := range application.Routes() {
+		path := wildcard.ReplaceAllString(route.Path, "x")
+
+		handle, _, _ := application.Router.Lookup(route.Method, path)
+
+		assert.NotNil(t, handle, "Expected %s %s to be registered", route.Method, route.Path)
+	}
+}
+
+func TestRoutesRequireAuthentication(t *testing.T) {
+	application := SetupApp(t)
+
+	for _, route := range application.Routes() {
+		if route.Access == app.Public {
+			continue
+		}
+
+		t.Run(route.Method+" "+route.Path, func(t *testing.T) {
+			req := httptest.NewRequest(route.Method, wildcard.ReplaceAllString(route.Path, "x"), nil)
+			recorder := httptest.NewRecorder()
+
+			application.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code, "Expected status Unauthorized")
+			assert.Equal(t, utils.ProblemContentType, recorder.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestPublicRoutesSkipAuthentication(t *testing.T) {
+	application := SetupApp(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{}`))
+	recorder := httptest.NewRecorder()
+
+	application.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected validation to run without credentials")
+}
+
+func TestUnknownRouteIsProblem(t *testing.T) {
+	application := SetupApp(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/nowhere", nil)
+	recorder := httptest.NewRecorder()
+
+	application.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	assert.Equal(t, utils.ProblemContentType, recorder.Header().Get("Content-Type"))
+}
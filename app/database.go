@@ -0,0 +1,82 @@
+package app
+
+import (
+	"database/sql"
+	"net/url"
+	"strings"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/config"
+	_ "modernc.org/sqlite"
+)
+
+// sqlitePragmas are applied to every SQLite connection. Foreign keys are off
+// by default in SQLite; the busy timeout lets a second process wait for the
+// write lock instead of failing at once.
+var sqlitePragmas = []string{"foreign_keys(1)", "busy_timeout(5000)", "journal_mode(WAL)"}
+
+// ParseDatabaseURL returns the database/sql driver name and data source name
+// for a database URL. "sqlite:<path>" and "sqlite://<path>" select SQLite,
+// with ":memory:" as the path for a throwaway database; anything else is a
+// PostgreSQL connection string.
+func ParseDatabaseURL(databaseURL string) (string, string, error) {
+	path, ok := strings.CutPrefix(databaseURL, "sqlite:")
+	if !ok {
+		return "postgres", databaseURL, nil
+	}
+
+	path, rawQuery, _ := strings.Cut(strings.TrimPrefix(path, "//"), "?")
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, pragma := range sqlitePragmas {
+		query.Add("_pragma", pragma)
+	}
+
+	// Timestamps are written in UTC by the SQLite repositories; this format
+	// makes their text sort in time order.
+	query.Set("_time_format", "sqlite")
+
+	return "sqlite", "file:" + path + "?" + query.Encode(), nil
+}
+
+// OpenDatabase opens the connection pool shared by every repository, applies
+// the configured pool limits and checks that the database is reachable.
+// SQLite pools keep a single connection open for good, ignoring the pool
+// settings: SQLite allows one writer at a time, so more connections would
+// only wait on each other's locks, and a ":memory:" database lives only as
+// long as its connection.
+func OpenDatabase(cfg config.Database) (*sql.DB, error) {
+	driver, dsn, err := ParseDatabaseURL(cfg.URL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	connectionPool, err := sql.Open(driver, dsn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if driver == "sqlite" {
+		connectionPool.SetMaxOpenConns(1)
+	} else {
+		connectionPool.SetMaxOpenConns(cfg.MaxOpenConns)
+		connectionPool.SetMaxIdleConns(cfg.MaxIdleConns)
+		connectionPool.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+		connectionPool.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	err = connectionPool.Ping()
+
+	if err != nil {
+		connectionPool.Close()
+		return nil, err
+	}
+
+	return connectionPool, nil
+}
@@ -0,0 +1,56 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+)
+
+func TestParseDatabaseURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		wantDriver string
+		wantPrefix string
+	}{
+		{"PostgreSQL URL", "postgres://tracker@localhost/money", "postgres", "postgres://tracker@localhost/money"},
+		{"PostgreSQL key/value", "host=localhost dbname=money", "postgres", "host=localhost dbname=money"},
+		{"SQLite relative path", "sqlite:money.db", "sqlite", "file:money.db?"},
+		{"SQLite absolute path", "sqlite:///var/lib/money.db", "sqlite", "file:/var/lib/money.db?"},
+		{"SQLite in memory", "sqlite::memory:", "sqlite", "file::memory:?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver, dsn, err := app.ParseDatabaseURL(tt.url)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDriver, driver)
+			assert.True(t, strings.HasPrefix(dsn, tt.wantPrefix), "Expected %q to start with %q", dsn, tt.wantPrefix)
+		})
+	}
+}
+
+func TestParseDatabaseURL_SqliteOptions(t *testing.T) {
+	_, dsn, err := app.ParseDatabaseURL("sqlite:money.db?_txlock=immediate")
+
+	assert.NoError(t, err)
+	assert.Contains(t, dsn, "_txlock=immediate")
+	assert.Contains(t, dsn, "_time_format=sqlite")
+	assert.Contains(t, dsn, "foreign_keys%281%29")
+}
+
+func TestDatabaseRepositories_Sqlite(t *testing.T) {
+	db, err := app.OpenDatabase(config.Database{URL: "sqlite::memory:"})
+	require.NoError(t, err)
+	defer db.Close()
+
+	repos := app.DatabaseRepositories(db)
+
+	assert.IsType(t, app.SqliteRepositories(db).User, repos.User)
+	assert.IsType(t, app.SqliteRepositories(db).UnitOfWork, repos.UnitOfWork)
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+var ErrAdminOnly = model.NewError(model.CodeForbidden, "only admins can access this resource")
+
+func loggerHandler(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		startTime := time.Now()
+
+		// Call the original handler
+		h(w, r, ps)
+
+		// Log information about the request
+		duration := time.Since(startTime)
+		slog.Info("Request", "method", r.Method, "uri", r.RequestURI, "remote", r.RemoteAddr, "duration", duration)
+	}
+}
+
+// timeoutHandler gives every request a deadline, so that database calls made
+// on its behalf are cancelled once it has taken too long. A zero timeout
+// leaves the request without a deadline.
+func timeoutHandler(timeout time.Duration, h httprouter.Handle) httprouter.Handle {
+	if timeout <= 0 {
+		return h
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		h(w, r.WithContext(ctx), ps)
+	}
+}
+
+// adminHandler rejects callers that are not admins. It must run after
+// authentication.
+func adminHandler(h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if !utils.IsAdmin(r.Context()) {
+			utils.WriteError(w, ErrAdminOnly)
+			return
+		}
+
+		h(w, r, ps)
+	}
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutHandler(t *testing.T) {
+	t.Run("Sets a deadline", func(t *testing.T) {
+		var deadline time.Time
+		var ok bool
+
+		handle := timeoutHandler(time.Second, func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+			deadline, ok = r.Context().Deadline()
+		})
+
+		handle(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), nil)
+
+		assert.True(t, ok, "Expected the request context to have a deadline")
+		assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+	})
+
+	t.Run("Cancels the context once the deadline passes", func(t *testing.T) {
+		var err error
+
+		handle := timeoutHandler(10*time.Millisecond, func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+			<-r.Context().Done()
+			err = r.Context().Err()
+		})
+
+		handle(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), nil)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Zero timeout leaves the request alone", func(t *testing.T) {
+		var ok bool
+
+		handle := timeoutHandler(0, func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+			_, ok = r.Context().Deadline()
+		})
+
+		handle(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), nil)
+
+		assert.False(t, ok)
+	})
+}
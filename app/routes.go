@@ -0,0 +1,126 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+var ErrRouteNotFound = model.NewError(model.CodeNotFound, "no such endpoint")
+
+// Access controls which callers may reach a route.
+type Access int
+
+const (
+	// Authenticated routes need an access token or API key.
+	Authenticated Access = iota
+	// Public routes can be called without credentials.
+	Public
+	// Admin routes need an authenticated admin.
+	Admin
+)
+
+type Route struct {
+	Method string
+	Path   string
+	Handle httprouter.Handle
+	Access Access
+}
+
+// Routes lists every endpoint of the API. User-scoped collections live under
+// /users/:userID and single resources under their own top-level path, since
+// httprouter does not allow differently named wildcards at the same position.
+func (a *App) Routes() []Route {
+	h := a.Handlers
+
+	return []Route{
+		{http.MethodPost, "/auth/login", h.Auth.Login, Public},
+		{http.MethodPost, "/auth/refresh", h.Auth.Refresh, Public},
+		{http.MethodPost, "/auth/logout", h.Auth.Logout, Public},
+		{http.MethodGet, "/auth/sessions", h.Auth.GetSessions, Authenticated},
+		{http.MethodDelete, "/auth/sessions/:id", h.Auth.RevokeSession, Authenticated},
+		{http.MethodGet, "/auth/api-keys", h.APIKey.GetAPIKeys, Authenticated},
+		{http.MethodPost, "/auth/api-keys", h.APIKey.CreateAPIKey, Authenticated},
+		{http.MethodDelete, "/auth/api-keys/:id", h.APIKey.DeleteAPIKey, Authenticated},
+
+		{http.MethodGet, "/users", h.User.GetUsers, Authenticated},
+		{http.MethodPost, "/users", h.User.CreateUser, Public},
+		{http.MethodGet, "/users/:userID", h.User.GetUser, Authenticated},
+		{http.MethodPut, "/users/:userID", h.User.UpdateUser, Authenticated},
+		{http.MethodDelete, "/users/:userID", h.User.DeleteUser, Authenticated},
+
+		{http.MethodGet, "/categories", h.Category.GetCategories, Authenticated},
+		{http.MethodGet, "/categories/:id", h.Category.GetCategory, Authenticated},
+		{http.MethodDelete, "/categories/:id", h.Category.DeleteCategory, Authenticated},
+		{http.MethodGet, "/users/:userID/categories", h.Category.GetUserCategories, Authenticated},
+		{http.MethodPost, "/users/:userID/categories", h.Category.CreateCategory, Authenticated},
+
+		{http.MethodGet, "/wallets/:id", h.Wallet.GetWallet, Authenticated},
+		{http.MethodPut, "/wallets/:id", h.Wallet.UpdateWallet, Authenticated},
+		{http.MethodDelete, "/wallets/:id", h.Wallet.DeleteWallet, Authenticated},
+		{http.MethodGet, "/users/:userID/wallets", h.Wallet.GetUserWallets, Authenticated},
+		{http.MethodPost, "/users/:userID/wallets", h.Wallet.CreateWallet, Authenticated},
+		{http.MethodGet, "/users/:userID/net-total", h.Wallet.GetUserNetTotal, Authenticated},
+
+		{http.MethodGet, "/transactions/:id", h.Transaction.GetTransaction, Authenticated},
+		{http.MethodPut, "/transactions/:id", h.Transaction.UpdateTransaction, Authenticated},
+		{http.MethodDelete, "/transactions/:id", h.Transaction.DeleteTransaction, Authenticated},
+		{http.MethodGet, "/users/:userID/transactions", h.Transaction.GetUserTransactions, Authenticated},
+		{http.MethodPost, "/users/:userID/transactions", h.Transaction.CreateTransaction, Authenticated},
+
+		{http.MethodGet, "/transfers/:id", h.Transfer.GetTransfer, Authenticated},
+		{http.MethodPut, "/transfers/:id", h.Transfer.UpdateTransfer, Authenticated},
+		{http.MethodDelete, "/transfers/:id", h.Transfer.DeleteTransfer, Authenticated},
+		{http.MethodGet, "/users/:userID/transfers", h.Transfer.GetUserTransfers, Authenticated},
+		{http.MethodPost, "/users/:userID/transfers", h.Transfer.CreateTransfer, Authenticated},
+
+		{http.MethodGet, "/budgets/:id", h.Budget.GetBudget, Authenticated},
+		{http.MethodPut, "/budgets/:id", h.Budget.UpdateBudget, Authenticated},
+		{http.MethodDelete, "/budgets/:id", h.Budget.DeleteBudget, Authenticated},
+		{http.MethodGet, "/users/:userID/budgets", h.Budget.GetUserBudgets, Authenticated},
+		{http.MethodPost, "/users/:userID/budgets", h.Budget.CreateBudget, Authenticated},
+
+		{http.MethodGet, "/recurring-transactions/:id", h.Recurring.GetRecurringTransaction, Authenticated},
+		{http.MethodPut, "/recurring-transactions/:id", h.Recurring.UpdateRecurringTransaction, Authenticated},
+		{http.MethodDelete, "/recurring-transactions/:id", h.Recurring.DeleteRecurringTransaction, Authenticated},
+		{http.MethodPost, "/recurring-transactions/:id/pause", h.Recurring.PauseRecurringTransaction, Authenticated},
+		{http.MethodPost, "/recurring-transactions/:id/resume", h.Recurring.ResumeRecurringTransaction, Authenticated},
+		{http.MethodPost, "/recurring-transactions/:id/skip", h.Recurring.SkipRecurringTransaction, Authenticated},
+		{http.MethodGet, "/users/:userID/recurring-transactions", h.Recurring.GetUserRecurringTransactions, Authenticated},
+		{http.MethodPost, "/users/:userID/recurring-transactions", h.Recurring.CreateRecurringTransaction, Authenticated},
+
+		{http.MethodGet, "/users/:userID/reports/summary", h.Report.GetSummary, Authenticated},
+
+		{http.MethodGet, "/users/:userID/notifications", h.Notification.GetUserNotifications, Authenticated},
+		{http.MethodPost, "/users/:userID/notifications/read", h.Notification.MarkAllRead, Authenticated},
+		{http.MethodPost, "/notifications/:id/read", h.Notification.MarkRead, Authenticated},
+
+		{http.MethodGet, "/rates", h.Rate.GetRates, Authenticated},
+		{http.MethodPost, "/rates", h.Rate.SaveRate, Admin},
+		{http.MethodPost, "/rates/import", h.Rate.ImportRates, Admin},
+		{http.MethodDelete, "/rates/:id", h.Rate.DeleteRate, Admin},
+	}
+}
+
+func (a *App) registerRoutes() {
+	for _, route := range a.Routes() {
+		a.Router.Handle(route.Method, route.Path, loggerHandler(timeoutHandler(a.requestTimeout, a.protect(route))))
+	}
+
+	a.Router.NotFound = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		utils.WriteError(w, ErrRouteNotFound)
+	})
+}
+
+func (a *App) protect(route Route) httprouter.Handle {
+	switch route.Access {
+	case Public:
+		return route.Handle
+	case Admin:
+		return a.Handlers.Auth.Authenticate(adminHandler(route.Handle))
+	default:
+		return a.Handlers.Auth.Authenticate(route.Handle)
+	}
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/varomnrg/money-tracker/config"
+)
+
+// NewServer returns an http.Server for handler with the configured timeouts.
+func NewServer(cfg config.Server, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+}
+
+// Serve runs server on listener until ctx is cancelled. It then stops
+// accepting connections and waits up to shutdownTimeout for in-flight
+// requests to finish before closing the remaining connections.
+func Serve(ctx context.Context, server *http.Server, listener net.Listener, shutdownTimeout time.Duration) error {
+	serveErr := make(chan error, 1)
+
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("Shutting down, draining in-flight requests...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := server.Shutdown(shutdownCtx)
+	if err != nil {
+		server.Close()
+		return fmt.Errorf("graceful shutdown: %w", err)
+	}
+
+	err = <-serveErr
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Run serves the application on listener and runs the scheduler until ctx is
+// cancelled. It then drains the server, waits for the scheduler to stop and
+// closes the database pool.
+func (a *App) Run(ctx context.Context, listener net.Listener, cfg config.Server) error {
+	server := NewServer(cfg, a)
+
+	schedulerCtx, stopScheduler := context.WithCancel(ctx)
+	schedulerDone := make(chan struct{})
+
+	go func() {
+		defer close(schedulerDone)
+		a.Scheduler.Run(schedulerCtx)
+	}()
+
+	err := Serve(ctx, server, listener, cfg.ShutdownTimeout)
+
+	stopScheduler()
+	<-schedulerDone
+
+	return errors.Join(err, a.Close())
+}
@@ -0,0 +1,137 @@
+package app_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+)
+
+func listen(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return listener
+}
+
+func TestServe_InFlightRequestCompletesDuringShutdown(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte("done"))
+	})
+
+	listener := listen(t)
+	server := app.NewServer(config.Server{}, handler)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	served := make(chan error, 1)
+	go func() { served <- app.Serve(ctx, server, listener, 5*time.Second) }()
+
+	type result struct {
+		status int
+		body   string
+		err    error
+	}
+	responded := make(chan result, 1)
+	go func() {
+		res, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			responded <- result{err: err}
+			return
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		responded <- result{status: res.StatusCode, body: string(body), err: err}
+	}()
+
+	<-started
+	cancel()
+
+	// New connections are refused once shutdown has begun, while the
+	// in-flight request is still running.
+	assert.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		if err == nil {
+			conn.Close()
+		}
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	select {
+	case <-served:
+		t.Fatal("server stopped before the in-flight request finished")
+	default:
+	}
+
+	close(release)
+
+	res := <-responded
+	assert.NoError(t, res.err)
+	assert.Equal(t, http.StatusOK, res.status)
+	assert.Equal(t, "done", res.body)
+	assert.NoError(t, <-served)
+}
+
+func TestServe_ShutdownTimeoutExceeded(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+
+	listener := listen(t)
+	server := app.NewServer(config.Server{}, handler)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	served := make(chan error, 1)
+	go func() { served <- app.Serve(ctx, server, listener, 50*time.Millisecond) }()
+
+	go http.Get("http://" + listener.Addr().String())
+
+	<-started
+	cancel()
+
+	assert.ErrorIs(t, <-served, context.DeadlineExceeded)
+}
+
+func TestRun_ClosesDatabase(t *testing.T) {
+	application := SetupApp(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := application.Run(ctx, listen(t), config.Server{ShutdownTimeout: time.Second})
+
+	assert.NoError(t, err)
+	assert.ErrorContains(t, application.DB.Ping(), "database is closed")
+}
+
+func TestNewServer_AppliesTimeouts(t *testing.T) {
+	server := app.NewServer(config.Server{
+		Addr:              ":8000",
+		ReadTimeout:       time.Second,
+		ReadHeaderTimeout: 2 * time.Second,
+		WriteTimeout:      3 * time.Second,
+		IdleTimeout:       4 * time.Second,
+	}, http.NotFoundHandler())
+
+	assert.Equal(t, ":8000", server.Addr)
+	assert.Equal(t, time.Second, server.ReadTimeout)
+	assert.Equal(t, 2*time.Second, server.ReadHeaderTimeout)
+	assert.Equal(t, 3*time.Second, server.WriteTimeout)
+	assert.Equal(t, 4*time.Second, server.IdleTimeout)
+}
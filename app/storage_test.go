@@ -0,0 +1,223 @@
+package app_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+	"github.com/varomnrg/money-tracker/migration"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+var testAuth = config.Auth{JWTSecret: "test-secret", AccessTokenTTL: time.Minute, RefreshTokenTTL: time.Hour, BcryptCost: 4}
+
+// SetupMemoryApp builds the application on in-memory storage, so requests run
+// end to end without a database.
+func SetupMemoryApp(t *testing.T) (*app.App, app.Repositories) {
+	repos := app.MemoryRepositories(memory.NewStore())
+
+	application := app.NewWithRepositories(repos, config.Config{Auth: testAuth})
+	t.Cleanup(func() { application.Close() })
+
+	return application, repos
+}
+
+// SetupSqliteApp builds the application on a migrated in-memory SQLite
+// database.
+func SetupSqliteApp(t *testing.T) (*app.App, app.Repositories) {
+	db, err := app.OpenDatabase(config.Database{URL: "sqlite::memory:"})
+	require.NoError(t, err)
+
+	migrator, err := migration.New(db)
+	require.NoError(t, err)
+
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	application := app.New(db, config.Config{Auth: testAuth})
+	t.Cleanup(func() { application.Close() })
+
+	return application, app.SqliteRepositories(db)
+}
+
+func serve(application *app.App, method string, path string, token string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	recorder := httptest.NewRecorder()
+	application.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func decode[T any](t *testing.T, recorder *httptest.ResponseRecorder) T {
+	var v T
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &v), recorder.Body.String())
+
+	return v
+}
+
+func TestStorage_EndToEnd(t *testing.T) {
+	backends := map[string]func(t *testing.T) (*app.App, app.Repositories){
+		"memory": SetupMemoryApp,
+		"sqlite": SetupSqliteApp,
+	}
+
+	for name, setup := range backends {
+		t.Run(name, func(t *testing.T) {
+			application, repos := setup(t)
+			testEndToEnd(t, application, repos)
+		})
+	}
+}
+
+func testEndToEnd(t *testing.T, application *app.App, repos app.Repositories) {
+
+	res := serve(application, http.MethodPost, "/users", "", `{"username":"alice","password":"password123","email":"alice@example.com"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	res = serve(application, http.MethodPost, "/auth/login", "", `{"username":"alice","password":"password123"}`)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	tokens := decode[model.TokenResponse](t, res)
+
+	res = serve(application, http.MethodPost, "/auth/refresh", "", `{"refresh_token":"`+tokens.Refresh_Token+`"}`)
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+	token := decode[model.TokenResponse](t, res).Access_Token
+
+	sessions := decode[[]model.Session](t, serve(application, http.MethodGet, "/auth/sessions", token, ""))
+	require.Len(t, sessions, 1, "Expected the refreshed login to remain one session")
+	assert.False(t, sessions[0].Created_At.After(sessions[0].Last_Used_At))
+
+	user, err := repos.User.GetUserByUsername(context.Background(), "alice")
+	require.NoError(t, err)
+	users := "/users/" + user.ID
+
+	res = serve(application, http.MethodPost, "/users", "", `{"username":"bob","password":"password123","email":"bob@example.com"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	res = serve(application, http.MethodPut, users, token, `{"username":"bob","password":"password123","email":"alice@example.com"}`)
+	assert.Equal(t, http.StatusConflict, res.Code, "Expected a taken username to be refused")
+
+	for _, name := range []string{"Cash", "Bank"} {
+		res = serve(application, http.MethodPost, users+"/wallets", token, `{"name":"`+name+`"}`)
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	}
+
+	res = serve(application, http.MethodPost, users+"/categories", token, `{"name":"Food"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	wallets := decode[[]model.Wallet](t, serve(application, http.MethodGet, users+"/wallets", token, ""))
+	require.Len(t, wallets, 2)
+	walletIDs := map[string]string{}
+	for _, wallet := range wallets {
+		walletIDs[wallet.Name] = wallet.ID
+	}
+
+	categories := decode[model.Page[model.Category]](t, serve(application, http.MethodGet, users+"/categories", token, "")).Items
+	require.Len(t, categories, 1)
+
+	for _, transaction := range []string{
+		`{"wallet_id":"` + walletIDs["Cash"] + `","category_id":"` + categories[0].ID + `","type":"income","amount":{"amount":"100.00"},"transaction_date":"2024-01-02T00:00:00Z"}`,
+		`{"wallet_id":"` + walletIDs["Cash"] + `","category_id":"` + categories[0].ID + `","type":"expense","amount":{"amount":"25.50"},"transaction_date":"2024-01-03T00:00:00Z"}`,
+	} {
+		res = serve(application, http.MethodPost, users+"/transactions", token, transaction)
+		require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+	}
+
+	res = serve(application, http.MethodPost, users+"/transfers", token, `{"from_wallet_id":"`+walletIDs["Cash"]+`","to_wallet_id":"`+walletIDs["Bank"]+`","amount":{"amount":"50"},"transfer_date":"2024-01-04T00:00:00Z"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	cash := decode[model.Wallet](t, serve(application, http.MethodGet, "/wallets/"+walletIDs["Cash"], token, ""))
+	bank := decode[model.Wallet](t, serve(application, http.MethodGet, "/wallets/"+walletIDs["Bank"], token, ""))
+
+	assert.Equal(t, model.NewMoney(2450, model.DefaultCurrency), cash.Balance)
+	assert.Equal(t, model.NewMoney(5000, model.DefaultCurrency), bank.Balance)
+
+	transactions := decode[model.Page[model.Transaction]](t, serve(application, http.MethodGet, users+"/transactions?limit=3", token, ""))
+	require.Len(t, transactions.Items, 3)
+	require.NotEmpty(t, transactions.Next_Cursor)
+
+	rest := decode[model.Page[model.Transaction]](t, serve(application, http.MethodGet, users+"/transactions?limit=3&cursor="+transactions.Next_Cursor, token, ""))
+	assert.Len(t, rest.Items, 1, "Expected both transactions and both transfer legs")
+	assert.Empty(t, rest.Next_Cursor)
+
+	summary := decode[model.Summary](t, serve(application, http.MethodGet, users+"/reports/summary?month=2024-01", token, ""))
+	assert.Equal(t, "100.00", summary.Income.Decimal())
+	assert.Equal(t, "25.50", summary.Expense.Decimal())
+	assert.Equal(t, "74.50", summary.Net.Decimal(), "Expected the transfer to be left out")
+	assert.Len(t, summary.Categories, 2)
+
+	res = serve(application, http.MethodPost, users+"/budgets", token, `{"category_id":"`+categories[0].ID+`","month":"2024-01","amount":{"amount":"20"}}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	budgets := decode[[]model.BudgetProgress](t, serve(application, http.MethodGet, users+"/budgets?month=2024-01", token, ""))
+	require.Len(t, budgets, 1)
+	assert.Equal(t, "25.50", budgets[0].Spent.Decimal())
+	assert.Equal(t, "-5.50", budgets[0].Remaining.Decimal())
+
+	res = serve(application, http.MethodPost, users+"/transactions", token, `{"wallet_id":"`+walletIDs["Cash"]+`","category_id":"`+categories[0].ID+`","type":"expense","amount":{"amount":"1.00"},"transaction_date":"2024-01-05T00:00:00Z"}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	notifications := decode[model.Page[model.Notification]](t, serve(application, http.MethodGet, users+"/notifications?unread=true", token, ""))
+	require.Len(t, notifications.Items, 1, "Expected one alert for the overspent budget")
+	assert.Equal(t, "Food budget for 2024-01 is used up", notifications.Items[0].Title)
+
+	res = serve(application, http.MethodPost, users+"/notifications/read", token, "")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+
+	notifications = decode[model.Page[model.Notification]](t, serve(application, http.MethodGet, users+"/notifications?unread=true", token, ""))
+	assert.Empty(t, notifications.Items)
+
+	res = serve(application, http.MethodPost, users+"/recurring-transactions", token, `{"wallet_id":"`+walletIDs["Bank"]+`","category_id":"`+categories[0].ID+`","type":"income","amount":{"amount":"10.00"},"frequency":"monthly","start_date":"2024-02-01T00:00:00Z","count":2}`)
+	require.Equal(t, http.StatusCreated, res.Code, res.Body.String())
+
+	application.Scheduler.RunOnce(context.Background())
+	application.Scheduler.RunOnce(context.Background())
+
+	bank = decode[model.Wallet](t, serve(application, http.MethodGet, "/wallets/"+walletIDs["Bank"], token, ""))
+	assert.Equal(t, model.NewMoney(7000, model.DefaultCurrency), bank.Balance, "Expected each occurrence to be materialized once")
+
+	recurring := decode[[]model.RecurringTransaction](t, serve(application, http.MethodGet, users+"/recurring-transactions", token, ""))
+	require.Len(t, recurring, 1)
+	assert.Equal(t, 2, recurring[0].Occurrences)
+	assert.Nil(t, recurring[0].Next_Date)
+
+	res = serve(application, http.MethodPost, "/recurring-transactions/"+recurring[0].ID+"/skip", token, "")
+	assert.Equal(t, http.StatusConflict, res.Code, "Expected an ended schedule to have nothing to skip")
+
+	res = serve(application, http.MethodDelete, "/categories/"+categories[0].ID, token, "")
+	assert.Equal(t, http.StatusConflict, res.Code, "Expected a category with transactions to be kept")
+
+	res = serve(application, http.MethodDelete, "/wallets/"+walletIDs["Cash"], token, "")
+	assert.Equal(t, http.StatusConflict, res.Code, "Expected a wallet with transfers to be kept")
+
+	res = serve(application, http.MethodDelete, users, token, "")
+	require.Equal(t, http.StatusOK, res.Code, res.Body.String())
+
+	wallets, err = repos.Wallet.GetUserWallets(context.Background(), user.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, wallets, "Expected the user's wallets to be deleted with the user")
+
+	remaining, err := repos.Budget.GetUserBudgets(context.Background(), user.ID, "2024-01")
+	assert.NoError(t, err)
+	assert.Empty(t, remaining, "Expected the user's budgets to be deleted with the user")
+
+	inbox, err := repos.Notification.GetUserNotifications(context.Background(), user.ID, model.NotificationQuery{ListQuery: model.ListQuery{Sort: "-created_at"}})
+	assert.NoError(t, err)
+	assert.Empty(t, inbox, "Expected the user's notifications to be deleted with the user")
+
+	_, err = repos.Recurring.GetRecurringTransaction(context.Background(), recurring[0].ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows, "Expected the user's recurring transactions to be deleted with the user")
+}
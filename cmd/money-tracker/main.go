@@ -1,53 +1,134 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
-	"net/http"
+	"log/slog"
+	"net"
 	"os"
-	"time"
+	"os/signal"
+	"syscall"
 
-	"github.com/joho/godotenv"
-	uh "github.com/varomnrg/money-tracker/handler/user"
-	ur "github.com/varomnrg/money-tracker/repository/user"
-	us "github.com/varomnrg/money-tracker/service/user"
-
-	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+	"github.com/varomnrg/money-tracker/migration"
+	"github.com/varomnrg/money-tracker/repository/memory"
+	"github.com/varomnrg/money-tracker/utils"
 )
 
 func main() {
-	router := httprouter.New()
+	name, args := os.Args[0], os.Args[1:]
+
+	isMigrate := len(args) > 0 && args[0] == "migrate"
+	if isMigrate {
+		name, args = name+" migrate", args[1:]
+	}
 
-	err := godotenv.Load()
+	cfg, args, err := config.Load(name, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatal("Error loading .env file")
+		log.Fatal(err)
 	}
 
-	DB_URL := os.Getenv("PSQL_DB_URL")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if isMigrate {
+		err = migrate(ctx, cfg, args)
+	} else {
+		err = serve(ctx, cfg)
+	}
 
-	// User Service
-	usersPostgresRepo := ur.NewPostgresqlUserRepository(DB_URL)
-	userService := us.NewUserService(usersPostgresRepo)
-	userHandler := uh.NewUserHandler(userService)
+	if err != nil {
+		stop()
+		log.Fatal(err)
+	}
+}
+
+func serve(ctx context.Context, cfg config.Config) error {
+	err := cfg.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
+	}
+
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: cfg.SlogLevel()})))
+
+	location, _ := cfg.Location()
+	utils.SetLocation(location)
+
+	log.Printf("Effective configuration:\n%s", cfg)
+
+	application, err := newApp(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", cfg.Server.Addr)
+	if err != nil {
+		application.Close()
+		return err
+	}
 
-	router.GET("/users", loggerHandler(userHandler.GetUsers))
-	router.GET("/users/:id", loggerHandler(userHandler.GetUser))
-	router.POST("/users", loggerHandler(userHandler.CreateUser))
-	router.PUT("/users/:id", loggerHandler(userHandler.UpdateUser))
-	router.DELETE("/users/:id", loggerHandler(userHandler.DeleteUser))
+	log.Printf("Server is running at %s.", listener.Addr())
 
-	log.Println("Server is running at 8000 port.")
-	log.Fatal(http.ListenAndServe(":8000", router))
+	err = application.Run(ctx, listener, cfg.Server)
+	if err != nil {
+		return err
+	}
+
+	log.Println("Server stopped.")
+
+	return nil
+}
+
+// newApp builds the application on the configured storage, connecting to and
+// migrating the database first unless the data is kept in memory.
+func newApp(ctx context.Context, cfg config.Config) (*app.App, error) {
+	if cfg.Storage == config.StorageMemory {
+		log.Println("Using in-memory storage; all data is lost when the server stops.")
+
+		return app.NewWithRepositories(app.MemoryRepositories(memory.NewStore()), cfg), nil
+	}
+
+	db, err := app.OpenDatabase(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("Successfully connected to database!")
+
+	if cfg.Database.AutoMigrate {
+		err = autoMigrate(ctx, db)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	return app.New(db, cfg), nil
 }
 
-func loggerHandler(h httprouter.Handle) httprouter.Handle {
-	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-		startTime := time.Now()
+func autoMigrate(ctx context.Context, db *sql.DB) error {
+	migrator, err := migration.New(db)
+	if err != nil {
+		return err
+	}
 
-		// Call the original handler
-		h(w, r, ps)
+	steps, err := migrator.Up(ctx)
+	logSteps(steps)
+	if err != nil {
+		return err
+	}
 
-		// Log information about the request
-		duration := time.Since(startTime)
-		log.Printf("[%s] %s %s %s", r.Method, r.RequestURI, r.RemoteAddr, duration)
+	if len(steps) == 0 {
+		log.Println("Database schema is up to date.")
 	}
+
+	return nil
 }
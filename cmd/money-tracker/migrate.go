@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+	"github.com/varomnrg/money-tracker/migration"
+)
+
+const migrateUsage = "usage: money-tracker migrate [flags] up | down | status | to VERSION | force VERSION"
+
+var errMigrateUsage = errors.New(migrateUsage)
+
+// migrate runs the migrate subcommand:
+//
+//	up             apply every pending migration
+//	down           roll back the latest applied migration
+//	status         list migrations and when they were applied
+//	to VERSION     migrate up or down to VERSION (0 rolls back everything)
+//	force VERSION  mark migrations up to VERSION as applied without running
+//	               them, for databases created by hand
+func migrate(ctx context.Context, cfg config.Config, args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+
+	command, args := args[0], args[1:]
+
+	version := 0
+	switch command {
+	case "up", "down", "status":
+		if len(args) != 0 {
+			return errMigrateUsage
+		}
+	case "to", "force":
+		if len(args) != 1 {
+			return errMigrateUsage
+		}
+
+		var err error
+		version, err = strconv.Atoi(args[0])
+		if err != nil || version < 0 {
+			return fmt.Errorf("VERSION must be a migration number, got %q", args[0])
+		}
+	default:
+		return errMigrateUsage
+	}
+
+	if cfg.Storage == config.StorageMemory {
+		return errors.New("in-memory storage has no schema to migrate")
+	}
+
+	if cfg.Database.URL == "" {
+		return errors.New("database URL is required (DATABASE_URL or -db-url)")
+	}
+
+	db, err := app.OpenDatabase(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := migration.New(db)
+	if err != nil {
+		return err
+	}
+
+	var steps []migration.Step
+
+	switch command {
+	case "up":
+		steps, err = migrator.Up(ctx)
+	case "down":
+		steps, err = migrator.Down(ctx)
+	case "to":
+		steps, err = migrator.To(ctx, version)
+	case "force":
+		err = migrator.Force(ctx, version)
+		if err == nil {
+			log.Printf("Marked migrations up to %d as applied.", version)
+		}
+		return err
+	case "status":
+		return printStatus(ctx, migrator)
+	}
+
+	logSteps(steps)
+
+	if err == nil && len(steps) == 0 {
+		log.Println("Nothing to migrate.")
+	}
+
+	return err
+}
+
+func logSteps(steps []migration.Step) {
+	for _, step := range steps {
+		log.Printf("Migrated %s %04d_%s", step.Direction, step.Migration.Version, step.Migration.Name)
+	}
+}
+
+func printStatus(ctx context.Context, migrator *migration.Migrator) error {
+	states, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+
+	for _, state := range states {
+		appliedAt := "pending"
+		if state.Applied_At != nil {
+			appliedAt = state.Applied_At.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\n", state.Migration.Version, state.Migration.Name, appliedAt)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+	us "github.com/varomnrg/money-tracker/service/user"
+)
+
+// rehash-passwords hashes user passwords that were stored in plaintext before
+// bcrypt hashing was introduced. Rows that already hold a hash are skipped.
+func main() {
+	cfg, _, err := config.Load(os.Args[0], os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if cfg.Database.URL == "" {
+		log.Fatal("database URL is required (DATABASE_URL or -db-url)")
+	}
+
+	db, err := app.OpenDatabase(cfg.Database)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	repos := app.DatabaseRepositories(db)
+	userService := us.NewUserServiceWithPasswordCost(repos.User, repos.UnitOfWork, cfg.Auth.BcryptCost)
+
+	count, err := userService.RehashPlaintextPasswords(context.Background())
+	if err != nil {
+		log.Fatalf("Rehashed %d passwords before failing: %v", count, err)
+	}
+
+	log.Printf("Rehashed %d plaintext passwords.", count)
+}
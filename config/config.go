@@ -0,0 +1,374 @@
+// Package config loads the server configuration from defaults, an optional
+// .env file, environment variables and command-line flags, in increasing
+// order of precedence.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/joho/godotenv"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const redacted = "[redacted]"
+
+// MinJWTSecretLength is the shortest accepted HMAC secret, matching the
+// SHA-256 output size.
+const MinJWTSecretLength = 32
+
+// Storage backends. StorageMemory keeps all data in process memory and loses
+// it on shutdown; it needs no database and is meant for demos and tests.
+const (
+	StorageDatabase = "database"
+	StorageMemory   = "memory"
+)
+
+type Config struct {
+	Storage       string
+	Server        Server
+	Database      Database
+	Auth          Auth
+	Notifications Notifications
+	Scheduler     Scheduler
+	LogLevel      string
+	Timezone      string
+}
+
+type Server struct {
+	Addr              string
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	ShutdownTimeout   time.Duration
+	RequestTimeout    time.Duration
+}
+
+type Database struct {
+	URL             string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	AutoMigrate     bool
+}
+
+type Auth struct {
+	JWTSecret       string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	BcryptCost      int
+}
+
+// Notifications configures the channels notifications are delivered through
+// besides the inbox. A channel is enabled by setting its address.
+type Notifications struct {
+	WebhookURL    string
+	WebhookSecret string
+	SMTPAddr      string
+	SMTPUsername  string
+	SMTPPassword  string
+	SMTPFrom      string
+	Timeout       time.Duration
+}
+
+// Scheduler configures the background job that creates the transactions of
+// recurring transactions as they fall due.
+type Scheduler struct {
+	// Interval is the time between runs; 0 disables the scheduler.
+	Interval time.Duration
+}
+
+var (
+	storages  = []string{StorageDatabase, StorageMemory}
+	logLevels = []string{"debug", "info", "warn", "error"}
+)
+
+// Load reads .env from the working directory if it exists, then builds the
+// configuration from the environment and the command-line arguments, and
+// returns the arguments left after the flags. It does not validate the
+// result; call Validate for that.
+func Load(name string, args []string) (Config, []string, error) {
+	err := godotenv.Load()
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return Config{}, nil, fmt.Errorf("loading .env: %w", err)
+	}
+
+	env := &envReader{}
+	config := Config{}
+
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	flags.StringVar(&config.Server.Addr, "addr", env.string("LISTEN_ADDR", ":8000"), "address the HTTP server listens on")
+	flags.DurationVar(&config.Server.ReadTimeout, "read-timeout", env.duration("READ_TIMEOUT", 15*time.Second), "maximum duration for reading a request")
+	flags.DurationVar(&config.Server.ReadHeaderTimeout, "read-header-timeout", env.duration("READ_HEADER_TIMEOUT", 5*time.Second), "maximum duration for reading request headers")
+	flags.DurationVar(&config.Server.WriteTimeout, "write-timeout", env.duration("WRITE_TIMEOUT", 30*time.Second), "maximum duration for writing a response")
+	flags.DurationVar(&config.Server.IdleTimeout, "idle-timeout", env.duration("IDLE_TIMEOUT", 2*time.Minute), "maximum time to keep idle connections open")
+	flags.DurationVar(&config.Server.RequestTimeout, "request-timeout", env.duration("REQUEST_TIMEOUT", 10*time.Second), "deadline for handling a request, including its database calls; 0 for none")
+	flags.DurationVar(&config.Server.ShutdownTimeout, "shutdown-timeout", env.duration("SHUTDOWN_TIMEOUT", 20*time.Second), "maximum time to drain connections on shutdown")
+
+	flags.StringVar(&config.Storage, "storage", env.string("STORAGE", StorageDatabase), "where data is kept: "+strings.Join(storages, ", "))
+	flags.StringVar(&config.Database.URL, "db-url", env.string("DATABASE_URL", env.string("PSQL_DB_URL", "")), "PostgreSQL connection string, or sqlite:<path> for a SQLite database file")
+	flags.IntVar(&config.Database.MaxOpenConns, "db-max-open-conns", env.int("DB_MAX_OPEN_CONNS", 25), "maximum open database connections, 0 for unlimited")
+	flags.IntVar(&config.Database.MaxIdleConns, "db-max-idle-conns", env.int("DB_MAX_IDLE_CONNS", 25), "maximum idle database connections")
+	flags.DurationVar(&config.Database.ConnMaxLifetime, "db-conn-max-lifetime", env.duration("DB_CONN_MAX_LIFETIME", 30*time.Minute), "maximum lifetime of a database connection, 0 for unlimited")
+	flags.DurationVar(&config.Database.ConnMaxIdleTime, "db-conn-max-idle-time", env.duration("DB_CONN_MAX_IDLE_TIME", 5*time.Minute), "maximum idle time of a database connection, 0 for unlimited")
+	flags.BoolVar(&config.Database.AutoMigrate, "auto-migrate", env.bool("AUTO_MIGRATE", false), "apply pending schema migrations on startup")
+
+	flags.StringVar(&config.Auth.JWTSecret, "jwt-secret", env.string("JWT_SECRET", ""), "secret used to sign access tokens")
+	flags.DurationVar(&config.Auth.AccessTokenTTL, "access-token-ttl", env.duration("ACCESS_TOKEN_TTL", 15*time.Minute), "lifetime of access tokens")
+	flags.DurationVar(&config.Auth.RefreshTokenTTL, "refresh-token-ttl", env.duration("REFRESH_TOKEN_TTL", 30*24*time.Hour), "lifetime of refresh tokens")
+	flags.IntVar(&config.Auth.BcryptCost, "bcrypt-cost", env.int("BCRYPT_COST", bcrypt.DefaultCost), "bcrypt cost for password hashes")
+
+	flags.StringVar(&config.Notifications.WebhookURL, "webhook-url", env.string("WEBHOOK_URL", ""), "URL notifications are posted to as JSON; empty to disable")
+	flags.StringVar(&config.Notifications.WebhookSecret, "webhook-secret", env.string("WEBHOOK_SECRET", ""), "secret used to sign webhook bodies")
+	flags.StringVar(&config.Notifications.SMTPAddr, "smtp-addr", env.string("SMTP_ADDR", ""), "host:port of the SMTP server notifications are emailed through; empty to disable")
+	flags.StringVar(&config.Notifications.SMTPUsername, "smtp-username", env.string("SMTP_USERNAME", ""), "SMTP username; empty to send without authenticating")
+	flags.StringVar(&config.Notifications.SMTPPassword, "smtp-password", env.string("SMTP_PASSWORD", ""), "SMTP password")
+	flags.StringVar(&config.Notifications.SMTPFrom, "smtp-from", env.string("SMTP_FROM", ""), "sender address of notification emails")
+	flags.DurationVar(&config.Notifications.Timeout, "notification-timeout", env.duration("NOTIFICATION_TIMEOUT", 10*time.Second), "maximum duration for delivering a notification through one channel")
+
+	flags.DurationVar(&config.Scheduler.Interval, "scheduler-interval", env.duration("SCHEDULER_INTERVAL", time.Minute), "time between runs of the recurring transaction scheduler; 0 to disable")
+
+	flags.StringVar(&config.LogLevel, "log-level", env.string("LOG_LEVEL", "info"), "log level: "+strings.Join(logLevels, ", "))
+	flags.StringVar(&config.Timezone, "timezone", env.string("DEFAULT_TIMEZONE", "Asia/Jakarta"), "IANA time zone used for timestamps")
+
+	if len(env.errs) > 0 {
+		return Config{}, nil, errors.Join(env.errs...)
+	}
+
+	err = flags.Parse(args)
+	if err != nil {
+		return Config{}, nil, err
+	}
+
+	return config, flags.Args(), nil
+}
+
+// Validate reports every invalid or missing setting at once.
+func (c Config) Validate() error {
+	var errs []error
+
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Server.Addr != "", "listen address is required")
+	check(c.Server.ReadTimeout >= 0, "read timeout cannot be negative")
+	check(c.Server.ReadHeaderTimeout >= 0, "read header timeout cannot be negative")
+	check(c.Server.WriteTimeout >= 0, "write timeout cannot be negative")
+	check(c.Server.IdleTimeout >= 0, "idle timeout cannot be negative")
+	check(c.Server.RequestTimeout >= 0, "request timeout cannot be negative")
+	check(c.Server.WriteTimeout == 0 || c.Server.RequestTimeout <= c.Server.WriteTimeout, "request timeout cannot exceed write timeout")
+	check(c.Server.ShutdownTimeout > 0, "shutdown timeout must be positive")
+
+	check(slices.Contains(storages, c.Storage), "storage must be one of %s", strings.Join(storages, ", "))
+	check(c.Storage != StorageDatabase || c.Database.URL != "", "database URL is required (DATABASE_URL or -db-url)")
+	check(c.Database.MaxOpenConns >= 0, "database max open connections cannot be negative")
+	check(c.Database.MaxIdleConns >= 0, "database max idle connections cannot be negative")
+	check(c.Database.MaxOpenConns == 0 || c.Database.MaxIdleConns <= c.Database.MaxOpenConns, "database max idle connections cannot exceed max open connections")
+	check(c.Database.ConnMaxLifetime >= 0, "database connection max lifetime cannot be negative")
+	check(c.Database.ConnMaxIdleTime >= 0, "database connection max idle time cannot be negative")
+
+	check(len(c.Auth.JWTSecret) >= MinJWTSecretLength, "JWT secret must be at least %d characters (JWT_SECRET or -jwt-secret)", MinJWTSecretLength)
+	check(c.Auth.AccessTokenTTL > 0, "access token TTL must be positive")
+	check(c.Auth.RefreshTokenTTL > c.Auth.AccessTokenTTL, "refresh token TTL must be longer than access token TTL")
+	check(c.Auth.BcryptCost >= bcrypt.MinCost && c.Auth.BcryptCost <= bcrypt.MaxCost, "bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+
+	if c.Notifications.WebhookURL != "" {
+		u, err := url.Parse(c.Notifications.WebhookURL)
+		check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", "webhook URL must be an absolute http or https URL")
+	}
+	if c.Notifications.SMTPAddr != "" {
+		_, _, err := net.SplitHostPort(c.Notifications.SMTPAddr)
+		check(err == nil, "SMTP address must be host:port")
+		_, err = mail.ParseAddress(c.Notifications.SMTPFrom)
+		check(err == nil, "SMTP sender address is required to send email (SMTP_FROM or -smtp-from)")
+	}
+	check(c.Notifications.Timeout > 0, "notification timeout must be positive")
+
+	check(c.Scheduler.Interval >= 0, "scheduler interval cannot be negative")
+
+	check(slices.Contains(logLevels, c.LogLevel), "log level must be one of %s", strings.Join(logLevels, ", "))
+
+	_, err := c.Location()
+	check(err == nil, "unknown timezone %q", c.Timezone)
+
+	return errors.Join(errs...)
+}
+
+// Location returns the configured default time zone.
+func (c Config) Location() (*time.Location, error) {
+	return time.LoadLocation(c.Timezone)
+}
+
+// SlogLevel converts LogLevel for use with log/slog. Unknown levels fall back
+// to info; Validate rejects them.
+func (c Config) SlogLevel() slog.Level {
+	switch c.LogLevel {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// String renders the effective configuration with secrets redacted, one
+// setting per line.
+func (c Config) String() string {
+	lines := []string{
+		"addr=" + c.Server.Addr,
+		"read-timeout=" + c.Server.ReadTimeout.String(),
+		"read-header-timeout=" + c.Server.ReadHeaderTimeout.String(),
+		"write-timeout=" + c.Server.WriteTimeout.String(),
+		"idle-timeout=" + c.Server.IdleTimeout.String(),
+		"request-timeout=" + c.Server.RequestTimeout.String(),
+		"shutdown-timeout=" + c.Server.ShutdownTimeout.String(),
+		"storage=" + c.Storage,
+		"db-url=" + redactDatabaseURL(c.Database.URL),
+		"db-max-open-conns=" + strconv.Itoa(c.Database.MaxOpenConns),
+		"db-max-idle-conns=" + strconv.Itoa(c.Database.MaxIdleConns),
+		"db-conn-max-lifetime=" + c.Database.ConnMaxLifetime.String(),
+		"db-conn-max-idle-time=" + c.Database.ConnMaxIdleTime.String(),
+		"auto-migrate=" + strconv.FormatBool(c.Database.AutoMigrate),
+		"jwt-secret=" + redactSecret(c.Auth.JWTSecret),
+		"access-token-ttl=" + c.Auth.AccessTokenTTL.String(),
+		"refresh-token-ttl=" + c.Auth.RefreshTokenTTL.String(),
+		"bcrypt-cost=" + strconv.Itoa(c.Auth.BcryptCost),
+		"webhook-url=" + redactWebhookURL(c.Notifications.WebhookURL),
+		"webhook-secret=" + redactSecret(c.Notifications.WebhookSecret),
+		"smtp-addr=" + c.Notifications.SMTPAddr,
+		"smtp-username=" + c.Notifications.SMTPUsername,
+		"smtp-password=" + redactSecret(c.Notifications.SMTPPassword),
+		"smtp-from=" + c.Notifications.SMTPFrom,
+		"notification-timeout=" + c.Notifications.Timeout.String(),
+		"scheduler-interval=" + c.Scheduler.Interval.String(),
+		"log-level=" + c.LogLevel,
+		"timezone=" + c.Timezone,
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redacted
+}
+
+var dsnPassword = regexp.MustCompile(`(password\s*=\s*)('[^']*'|\S+)`)
+
+// redactDatabaseURL hides the password of both URL ("postgres://u:p@host")
+// and key/value ("host=... password=...") connection strings.
+func redactDatabaseURL(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err == nil && u.Scheme != "" {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redacted)
+		}
+
+		query := u.Query()
+		if query.Has("password") {
+			query.Set("password", redacted)
+			u.RawQuery = query.Encode()
+		}
+
+		return u.String()
+	}
+
+	return dsnPassword.ReplaceAllString(dsn, "${1}"+redacted)
+}
+
+// redactWebhookURL keeps only the scheme and host of a webhook URL, as
+// services put the token authenticating it in its user info, path or query.
+func redactWebhookURL(webhookURL string) string {
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Host == "" {
+		return redactSecret(webhookURL)
+	}
+
+	if u.User == nil && strings.Trim(u.Path, "/") == "" && u.RawQuery == "" {
+		return webhookURL
+	}
+
+	return u.Scheme + "://" + u.Host + "/" + redacted
+}
+
+// envReader reads typed environment variables, collecting parse errors so
+// they can be reported together.
+type envReader struct {
+	errs []error
+}
+
+func (e *envReader) string(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func (e *envReader) int(key string, fallback int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s must be a number, got %q", key, value))
+		return fallback
+	}
+
+	return n
+}
+
+func (e *envReader) bool(key string, fallback bool) bool {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s must be true or false, got %q", key, value))
+		return fallback
+	}
+
+	return b
+}
+
+func (e *envReader) duration(key string, fallback time.Duration) time.Duration {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s must be a duration such as 30s, got %q", key, value))
+		return fallback
+	}
+
+	return d
+}
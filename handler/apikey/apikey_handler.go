@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/apikey"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type APIKeyHandler struct {
+	service service.IAPIKeyService
+}
+
+var ErrAPIKeyManagement = model.NewError(model.CodeForbidden, "api keys cannot be managed with an api key")
+
+func NewAPIKeyHandler(apiKeyService service.IAPIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{service: apiKeyService}
+}
+
+// GetAPIKeys lists the caller's API keys.
+func (h *APIKeyHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	user, _ := utils.AuthUserFromContext(r.Context())
+
+	keys, err := h.service.GetUserAPIKeys(r.Context(), user.ID)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(keys)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+// CreateAPIKey creates a key for the caller and returns it in plaintext. This
+// is the only time the key is shown.
+func (h *APIKeyHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	user, _ := utils.AuthUserFromContext(r.Context())
+	key := model.APIKeyRequest{}
+
+	if user.IsAPIKey() {
+		utils.WriteError(w, ErrAPIKeyManagement)
+		return
+	}
+
+	err := utils.DecodeJSON(r, &key)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	created, err := h.service.CreateAPIKey(r.Context(), user.ID, key)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(created)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(res)
+}
+
+// DeleteAPIKey revokes one of the caller's API keys.
+func (h *APIKeyHandler) DeleteAPIKey(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	user, _ := utils.AuthUserFromContext(r.Context())
+	id := ps.ByName("id")
+
+	if user.IsAPIKey() {
+		utils.WriteError(w, ErrAPIKeyManagement)
+		return
+	}
+
+	err := h.service.DeleteAPIKey(r.Context(), user.ID, id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("API key deleted"))
+}
@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/apikey"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/apikey"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/apikey"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner  = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	script = model.AuthUser{ID: "user-1", Role: model.RoleUser, Scope: model.ScopeReadWrite}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupAPIKeyHandler(t *testing.T) (*gomock.Controller, *handler.APIKeyHandler, *mock_service.MockIAPIKeyService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockIAPIKeyService(ctrl)
+	apiKeyHandler := handler.NewAPIKeyHandler(mockService)
+
+	return ctrl, apiKeyHandler, mockService
+}
+
+func TestGetAPIKeys_Handler(t *testing.T) {
+	ctrl, apiKeyHandler, mockService := SetupAPIKeyHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetUserAPIKeys(gomock.Any(), "user-1").Return([]model.APIKey{{ID: "key-1", Name: "spreadsheet", Key_Hash: "secret-hash"}}, nil)
+
+	req, _ := http.NewRequest("GET", "/auth/api-keys", nil)
+	recorder := httptest.NewRecorder()
+
+	apiKeyHandler.GetAPIKeys(recorder, withAuthUser(req, owner), nil)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	assert.NotContains(t, recorder.Body.String(), "secret-hash", "Expected key hash not to be exposed")
+}
+
+func TestCreateAPIKey_Handler(t *testing.T) {
+	ctrl, apiKeyHandler, mockService := SetupAPIKeyHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().CreateAPIKey(gomock.Any(), "user-1", model.APIKeyRequest{Name: "spreadsheet", Scope: model.ScopeRead}).Return(model.APIKeyCreated{APIKey: model.APIKey{ID: "key-1"}, Key: "mt_secret"}, nil)
+
+	tests := []struct {
+		name   string
+		user   model.AuthUser
+		key    model.APIKeyRequest
+		status int
+	}{
+		{"Create API Key", owner, model.APIKeyRequest{Name: "spreadsheet", Scope: model.ScopeRead}, http.StatusCreated},
+		{"Create API Key with invalid scope", owner, model.APIKeyRequest{Name: "spreadsheet", Scope: "admin"}, http.StatusBadRequest},
+		{"Create API Key using an API key", script, model.APIKeyRequest{Name: "spreadsheet", Scope: model.ScopeRead}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.key)
+			req, _ := http.NewRequest("POST", "/auth/api-keys", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			apiKeyHandler.CreateAPIKey(recorder, withAuthUser(req, tt.user), nil)
+
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
+
+func TestDeleteAPIKey_Handler(t *testing.T) {
+	ctrl, apiKeyHandler, mockService := SetupAPIKeyHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().DeleteAPIKey(gomock.Any(), "user-1", "key-1").Return(nil)
+	mockService.EXPECT().DeleteAPIKey(gomock.Any(), "user-1", "key-2").Return(service.ErrAPIKeyNotFound)
+
+	tests := []struct {
+		name   string
+		user   model.AuthUser
+		id     string
+		status int
+	}{
+		{"Delete API Key", owner, "key-1", http.StatusOK},
+		{"Delete unknown API Key", owner, "key-2", http.StatusNotFound},
+		{"Delete API Key using an API key", script, "key-1", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("DELETE", "/auth/api-keys/"+tt.id, nil)
+			recorder := httptest.NewRecorder()
+
+			apiKeyHandler.DeleteAPIKey(recorder, withAuthUser(req, tt.user), []httprouter.Param{{Key: "id", Value: tt.id}})
+
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
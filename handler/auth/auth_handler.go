@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	apiKeyService "github.com/varomnrg/money-tracker/service/apikey"
+	service "github.com/varomnrg/money-tracker/service/auth"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type AuthHandler struct {
+	service service.IAuthService
+	apiKeys apiKeyService.IAPIKeyService
+}
+
+var (
+	ErrUnauthorized = model.NewError(model.CodeUnauthorized, "authentication required")
+	ErrReadOnlyKey  = model.NewError(model.CodeForbidden, "api key is read-only")
+)
+
+// APIKeyHeader carries an API key as an alternative to the Authorization
+// header.
+const APIKeyHeader = "X-API-Key"
+
+func NewAuthHandler(authService service.IAuthService, apiKeys apiKeyService.IAPIKeyService) *AuthHandler {
+	return &AuthHandler{service: authService, apiKeys: apiKeys}
+}
+
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	login := model.LoginRequest{}
+
+	err := utils.DecodeJSON(r, &login)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	token, err := h.service.Login(r.Context(), login, r.UserAgent())
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(token)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	refresh := model.RefreshRequest{}
+
+	err := utils.DecodeJSON(r, &refresh)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	token, err := h.service.Refresh(r.Context(), refresh.Refresh_Token, r.UserAgent())
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(token)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	refresh := model.RefreshRequest{}
+
+	err := utils.DecodeJSON(r, &refresh)
+	if err == nil {
+		err = h.service.Logout(r.Context(), refresh.Refresh_Token)
+	}
+	if errors.Is(err, service.ErrSessionNotFound) {
+		// The token is well-formed but its session is gone, which the
+		// client cannot tell apart from an invalid token.
+		err = service.ErrInvalidToken
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Logged out"))
+}
+
+// GetSessions lists the caller's active sessions.
+func (h *AuthHandler) GetSessions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	user, _ := utils.AuthUserFromContext(r.Context())
+
+	sessions, err := h.service.GetSessions(r.Context(), user.ID)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(sessions)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+// RevokeSession signs the caller out of one of their sessions.
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	user, _ := utils.AuthUserFromContext(r.Context())
+	id := ps.ByName("id")
+
+	err := h.service.RevokeSession(r.Context(), user.ID, id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Session revoked"))
+}
+
+// Authenticate rejects requests without a valid bearer token or API key and
+// stores the caller in the request context for the wrapped handler. Read-only
+// API keys are limited to safe methods.
+func (h *AuthHandler) Authenticate(next httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		user, err := h.authenticate(r)
+		if err != nil {
+			unauthorized(w, err)
+			return
+		}
+
+		if !user.CanWrite() && !isSafeMethod(r.Method) {
+			utils.WriteError(w, ErrReadOnlyKey)
+			return
+		}
+
+		next(w, r.WithContext(utils.WithAuthUser(r.Context(), user)), ps)
+	}
+}
+
+func (h *AuthHandler) authenticate(r *http.Request) (model.AuthUser, error) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return h.apiKeys.Authenticate(r.Context(), key)
+	}
+
+	token, ok := bearerToken(r)
+	if !ok {
+		return model.AuthUser{}, ErrUnauthorized
+	}
+
+	if strings.HasPrefix(token, apiKeyService.KeyPrefix) {
+		return h.apiKeys.Authenticate(r.Context(), token)
+	}
+
+	return h.service.ParseAccessToken(r.Context(), token)
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+
+	if !ok || !strings.EqualFold(scheme, service.TokenType) || token == "" {
+		return "", false
+	}
+
+	return strings.TrimSpace(token), true
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// unauthorized reports a failed authentication. Failures that are not
+// themselves unauthorized errors are reported as ErrUnauthorized.
+func unauthorized(w http.ResponseWriter, err error) {
+	if !errors.Is(err, model.ErrUnauthorized) {
+		err = ErrUnauthorized
+	}
+
+	w.Header().Set("WWW-Authenticate", service.TokenType)
+	utils.WriteError(w, err)
+}
@@ -0,0 +1,269 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/auth"
+	mock_apikey "github.com/varomnrg/money-tracker/mocks/service/apikey"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/auth"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/auth"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+func SetupAuthHandler(t *testing.T) (*gomock.Controller, *handler.AuthHandler, *mock_service.MockIAuthService) {
+	ctrl, authHandler, mockService, _ := SetupAuthHandlerWithAPIKeys(t)
+
+	return ctrl, authHandler, mockService
+}
+
+func SetupAuthHandlerWithAPIKeys(t *testing.T) (*gomock.Controller, *handler.AuthHandler, *mock_service.MockIAuthService, *mock_apikey.MockIAPIKeyService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockIAuthService(ctrl)
+	mockAPIKeyService := mock_apikey.NewMockIAPIKeyService(ctrl)
+	authHandler := handler.NewAuthHandler(mockService, mockAPIKeyService)
+
+	return ctrl, authHandler, mockService, mockAPIKeyService
+}
+
+func TestLogin_Handler(t *testing.T) {
+	ctrl, authHandler, mockService := SetupAuthHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().Login(gomock.Any(), model.LoginRequest{Username: "user1", Password: "password123"}, gomock.Any()).Return(model.TokenResponse{Access_Token: "token", Token_Type: "Bearer", Expires_In: 900}, nil)
+	mockService.EXPECT().Login(gomock.Any(), model.LoginRequest{Username: "user1", Password: "wrong"}, gomock.Any()).Return(model.TokenResponse{}, service.ErrInvalidCredentials)
+
+	t.Run("Login", func(t *testing.T) {
+		body, _ := json.Marshal(model.LoginRequest{Username: "user1", Password: "password123"})
+		req, _ := http.NewRequest("POST", "/auth/login", bytes.NewBuffer(body))
+		recorder := httptest.NewRecorder()
+
+		authHandler.Login(recorder, req, nil)
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var token model.TokenResponse
+		json.Unmarshal(recorder.Body.Bytes(), &token)
+
+		assert.Equal(t, "token", token.Access_Token, "Expected access token in response")
+	})
+
+	t.Run("Login with wrong password", func(t *testing.T) {
+		body, _ := json.Marshal(model.LoginRequest{Username: "user1", Password: "wrong"})
+		req, _ := http.NewRequest("POST", "/auth/login", bytes.NewBuffer(body))
+		recorder := httptest.NewRecorder()
+
+		authHandler.Login(recorder, req, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code, "Expected status Unauthorized")
+	})
+
+	t.Run("Login without password", func(t *testing.T) {
+		body, _ := json.Marshal(model.LoginRequest{Username: "user1"})
+		req, _ := http.NewRequest("POST", "/auth/login", bytes.NewBuffer(body))
+		recorder := httptest.NewRecorder()
+
+		authHandler.Login(recorder, req, nil)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestAuthenticate_Handler(t *testing.T) {
+	ctrl, authHandler, mockService := SetupAuthHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().ParseAccessToken(gomock.Any(), "valid").Return(model.AuthUser{ID: "user-1", Role: model.RoleUser}, nil)
+	mockService.EXPECT().ParseAccessToken(gomock.Any(), "expired").Return(model.AuthUser{}, service.ErrInvalidToken)
+
+	protected := authHandler.Authenticate(func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		user, _ := utils.AuthUserFromContext(r.Context())
+		w.Write([]byte(user.ID))
+	})
+
+	tests := []struct {
+		name          string
+		authorization string
+		status        int
+		body          string
+	}{
+		{"Valid token", "Bearer valid", http.StatusOK, "user-1"},
+		{"Expired token", "Bearer expired", http.StatusUnauthorized, ""},
+		{"Missing header", "", http.StatusUnauthorized, ""},
+		{"Wrong scheme", "Basic dXNlcjpwYXNz", http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/users", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			recorder := httptest.NewRecorder()
+
+			protected(recorder, req, nil)
+
+			assert.Equal(t, tt.status, recorder.Code)
+			if tt.body != "" {
+				assert.Equal(t, tt.body, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestRefresh_Handler(t *testing.T) {
+	ctrl, authHandler, mockService := SetupAuthHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().Refresh(gomock.Any(), "fresh", "test-agent").Return(model.TokenResponse{Access_Token: "token", Refresh_Token: "rotated"}, nil)
+	mockService.EXPECT().Refresh(gomock.Any(), "used", "test-agent").Return(model.TokenResponse{}, service.ErrRefreshTokenReused)
+
+	tests := []struct {
+		name   string
+		token  string
+		status int
+	}{
+		{"Refresh", "fresh", http.StatusOK},
+		{"Refresh with used token", "used", http.StatusUnauthorized},
+		{"Refresh without token", "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(model.RefreshRequest{Refresh_Token: tt.token})
+			req, _ := http.NewRequest("POST", "/auth/refresh", bytes.NewBuffer(body))
+			req.Header.Set("User-Agent", "test-agent")
+			recorder := httptest.NewRecorder()
+
+			authHandler.Refresh(recorder, req, nil)
+
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
+
+func TestLogout_Handler(t *testing.T) {
+	ctrl, authHandler, mockService := SetupAuthHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().Logout(gomock.Any(), "token").Return(nil)
+	mockService.EXPECT().Logout(gomock.Any(), "unknown").Return(service.ErrInvalidToken)
+
+	tests := []struct {
+		name   string
+		token  string
+		status int
+	}{
+		{"Logout", "token", http.StatusOK},
+		{"Logout with unknown token", "unknown", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(model.RefreshRequest{Refresh_Token: tt.token})
+			req, _ := http.NewRequest("POST", "/auth/logout", bytes.NewBuffer(body))
+			recorder := httptest.NewRecorder()
+
+			authHandler.Logout(recorder, req, nil)
+
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
+
+func TestGetSessions_Handler(t *testing.T) {
+	ctrl, authHandler, mockService := SetupAuthHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetSessions(gomock.Any(), "user-1").Return([]model.Session{{ID: "sess-1", User_Agent: "test-agent"}}, nil)
+
+	req, _ := http.NewRequest("GET", "/auth/sessions", nil)
+	req = req.WithContext(utils.WithAuthUser(req.Context(), model.AuthUser{ID: "user-1", Role: model.RoleUser}))
+	recorder := httptest.NewRecorder()
+
+	authHandler.GetSessions(recorder, req, nil)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+	var sessions []model.Session
+	json.Unmarshal(recorder.Body.Bytes(), &sessions)
+
+	assert.Len(t, sessions, 1, "Expected one session returned")
+}
+
+func TestRevokeSession_Handler(t *testing.T) {
+	ctrl, authHandler, mockService := SetupAuthHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().RevokeSession(gomock.Any(), "user-1", "sess-1").Return(nil)
+	mockService.EXPECT().RevokeSession(gomock.Any(), "user-1", "sess-2").Return(service.ErrSessionNotFound)
+
+	tests := []struct {
+		name      string
+		sessionID string
+		status    int
+	}{
+		{"Revoke Session", "sess-1", http.StatusOK},
+		{"Revoke unknown Session", "sess-2", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("DELETE", "/auth/sessions/"+tt.sessionID, nil)
+			req = req.WithContext(utils.WithAuthUser(req.Context(), model.AuthUser{ID: "user-1", Role: model.RoleUser}))
+			recorder := httptest.NewRecorder()
+
+			authHandler.RevokeSession(recorder, req, []httprouter.Param{{Key: "id", Value: tt.sessionID}})
+
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
+
+func TestAuthenticateWithAPIKey_Handler(t *testing.T) {
+	ctrl, authHandler, _, mockAPIKeyService := SetupAuthHandlerWithAPIKeys(t)
+	defer ctrl.Finish()
+
+	mockAPIKeyService.EXPECT().Authenticate(gomock.Any(), "mt_read").Return(model.AuthUser{ID: "user-1", Role: model.RoleUser, Scope: model.ScopeRead}, nil).Times(2)
+	mockAPIKeyService.EXPECT().Authenticate(gomock.Any(), "mt_write").Return(model.AuthUser{ID: "user-1", Role: model.RoleUser, Scope: model.ScopeReadWrite}, nil).Times(2)
+	mockAPIKeyService.EXPECT().Authenticate(gomock.Any(), "mt_revoked").Return(model.AuthUser{}, errors.New("api key is invalid"))
+
+	protected := authHandler.Authenticate(func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		user, _ := utils.AuthUserFromContext(r.Context())
+		w.Write([]byte(user.ID))
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		header string
+		value  string
+		status int
+	}{
+		{"Read with read-only key", "GET", handler.APIKeyHeader, "mt_read", http.StatusOK},
+		{"Write with read-only key", "POST", "Authorization", "Bearer mt_read", http.StatusForbidden},
+		{"Read with read-write key", "GET", "Authorization", "Bearer mt_write", http.StatusOK},
+		{"Write with read-write key", "DELETE", handler.APIKeyHeader, "mt_write", http.StatusOK},
+		{"Revoked key", "GET", handler.APIKeyHeader, "mt_revoked", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, "/users/user-1", nil)
+			req.Header.Set(tt.header, tt.value)
+			recorder := httptest.NewRecorder()
+
+			protected(recorder, req, nil)
+
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/budget"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type BudgetHandler struct {
+	service service.IBudgetService
+}
+
+func NewBudgetHandler(budgetService service.IBudgetService) *BudgetHandler {
+	return &BudgetHandler{service: budgetService}
+}
+
+// GetUserBudgets lists the user's budgets for the month given as YYYY-MM,
+// by default the current month, with their progress.
+func (h *BudgetHandler) GetUserBudgets(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	month := r.URL.Query().Get("month")
+	if month == "" {
+		month = utils.GetCurrentTime().Format("2006-01")
+	}
+
+	budgets, err := h.service.GetUserBudgets(r.Context(), userID, month)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(budgets)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *BudgetHandler) GetBudget(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	_, err := h.authorizeBudget(r, id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	budget, err := h.service.GetBudget(r.Context(), id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(budget)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *BudgetHandler) CreateBudget(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+	budget := model.BudgetRequest{}
+
+	err := utils.DecodeJSON(r, &budget)
+	if err == nil {
+		err = h.service.CreateBudget(r.Context(), userID, budget)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("Budget created"))
+}
+
+func (h *BudgetHandler) UpdateBudget(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+	budget := model.BudgetRequest{}
+
+	err := utils.DecodeJSON(r, &budget)
+	if err == nil {
+		_, err = h.authorizeBudget(r, id)
+	}
+	if err == nil {
+		err = h.service.UpdateBudget(r.Context(), id, budget)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Budget updated"))
+}
+
+func (h *BudgetHandler) DeleteBudget(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	_, err := h.authorizeBudget(r, id)
+	if err == nil {
+		err = h.service.DeleteBudget(r.Context(), id)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Budget deleted"))
+}
+
+func (h *BudgetHandler) authorizeBudget(r *http.Request, id string) (model.Budget, error) {
+	return utils.Authorize(r.Context(), h.service.FindBudget, id, func(budget model.Budget) string { return budget.User_ID }, service.ErrBudgetNotFound)
+}
@@ -0,0 +1,285 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/budget"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/budget"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/budget"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+
+	foodBudget = model.BudgetRequest{Category_ID: "cat-food", Month: "2024-03", Amount: model.NewMoney(100000, "IDR")}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupBudgetHandler(t *testing.T) (*gomock.Controller, *handler.BudgetHandler, *mock_service.MockIBudgetService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockIBudgetService(ctrl)
+	budgetHandler := handler.NewBudgetHandler(mockService)
+
+	return ctrl, budgetHandler, mockService
+}
+
+func TestGetUserBudgets_Handler(t *testing.T) {
+	ctrl, budgetHandler, mockService := SetupBudgetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetUserBudgets(gomock.Any(), "user-1", "2024-03").Return(
+		[]model.BudgetProgress{
+			{Budget: model.Budget{ID: "budget-1", Category_ID: "cat-food", User_ID: "user-1"}, Spent: model.NewMoney(25000, "IDR"), Percentage: 25},
+			{Budget: model.Budget{ID: "budget-2", Category_ID: "cat-rent", User_ID: "user-1"}},
+		},
+		nil,
+	)
+	mockService.EXPECT().GetUserBudgets(gomock.Any(), "user-1", utils.GetCurrentTime().Format("2006-01")).Return([]model.BudgetProgress{}, nil)
+	mockService.EXPECT().GetUserBudgets(gomock.Any(), "user-1", "March").Return(nil, service.ErrInvalidMonth)
+	mockService.EXPECT().GetUserBudgets(gomock.Any(), "invalid_id", gomock.Any()).Return(nil, service.ErrUserNotFound)
+
+	t.Run("Get User Budgets", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/budgets?month=2024-03", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.GetUserBudgets(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var budgets []model.BudgetProgress
+		json.Unmarshal(recorder.Body.Bytes(), &budgets)
+
+		assert.Len(t, budgets, 2, "Expected two budgets returned")
+		assert.Equal(t, "250.00", budgets[0].Spent.Decimal())
+		assert.Equal(t, 25.0, budgets[0].Percentage)
+	})
+
+	t.Run("Get User Budgets for the current month", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/budgets", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.GetUserBudgets(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Get User Budgets with invalid month", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/budgets?month=March", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.GetUserBudgets(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Get User Budgets with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/invalid_id/budgets", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.GetUserBudgets(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestGetBudget_Handler(t *testing.T) {
+	ctrl, budgetHandler, mockService := SetupBudgetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().FindBudget(gomock.Any(), "budget-1").Return(model.Budget{ID: "budget-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().GetBudget(gomock.Any(), "budget-1").Return(model.BudgetProgress{
+		Budget:    model.Budget{ID: "budget-1", User_ID: "user-1", Amount: model.NewMoney(100000, "IDR")},
+		Remaining: model.NewMoney(-5000, "IDR"),
+	}, nil)
+	mockService.EXPECT().FindBudget(gomock.Any(), "invalid_id").Return(model.Budget{}, service.ErrBudgetNotFound)
+
+	t.Run("Get Budget", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/budgets/budget-1", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.GetBudget(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "budget-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var budget model.BudgetProgress
+		json.Unmarshal(recorder.Body.Bytes(), &budget)
+
+		assert.Equal(t, "budget-1", budget.ID, "Expected the budget's fields at the top level")
+		assert.Equal(t, model.NewMoney(-5000, "IDR"), budget.Remaining)
+	})
+
+	t.Run("Get Budget with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/budgets/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.GetBudget(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestCreateBudget_Handler(t *testing.T) {
+	ctrl, budgetHandler, mockService := SetupBudgetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().CreateBudget(gomock.Any(), "user-1", foodBudget).Return(nil)
+	mockService.EXPECT().CreateBudget(gomock.Any(), "invalid_id", foodBudget).Return(service.ErrUserNotFound)
+	mockService.EXPECT().CreateBudget(gomock.Any(), "user-1", foodBudget).Return(service.ErrBudgetAlreadyExist)
+
+	t.Run("Create Budget", func(t *testing.T) {
+		body, _ := json.Marshal(foodBudget)
+		req, _ := http.NewRequest("POST", "/users/user-1/budgets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.CreateBudget(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
+	})
+
+	t.Run("Create Budget with invalid user id", func(t *testing.T) {
+		body, _ := json.Marshal(foodBudget)
+		req, _ := http.NewRequest("POST", "/users/invalid_id/budgets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.CreateBudget(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Create Budget with existing budget", func(t *testing.T) {
+		body, _ := json.Marshal(foodBudget)
+		req, _ := http.NewRequest("POST", "/users/user-1/budgets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.CreateBudget(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusConflict, recorder.Code, "Expected status Conflict")
+	})
+
+	t.Run("Create Budget with invalid month", func(t *testing.T) {
+		body := []byte(`{"category_id":"cat-food","month":"2024-3","amount":{"amount":"1000.00"}}`)
+		req, _ := http.NewRequest("POST", "/users/user-1/budgets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.CreateBudget(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestUpdateBudget_Handler(t *testing.T) {
+	ctrl, budgetHandler, mockService := SetupBudgetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().FindBudget(gomock.Any(), "budget-1").Return(model.Budget{ID: "budget-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().UpdateBudget(gomock.Any(), "budget-1", foodBudget).Return(nil)
+	mockService.EXPECT().FindBudget(gomock.Any(), "invalid_id").Return(model.Budget{}, service.ErrBudgetNotFound)
+
+	t.Run("Update Budget", func(t *testing.T) {
+		body, _ := json.Marshal(foodBudget)
+		req, _ := http.NewRequest("PUT", "/budgets/budget-1", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.UpdateBudget(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "budget-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Update Budget with invalid id", func(t *testing.T) {
+		body, _ := json.Marshal(foodBudget)
+		req, _ := http.NewRequest("PUT", "/budgets/invalid_id", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.UpdateBudget(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestDeleteBudget_Handler(t *testing.T) {
+	ctrl, budgetHandler, mockService := SetupBudgetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().FindBudget(gomock.Any(), "budget-1").Return(model.Budget{ID: "budget-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().DeleteBudget(gomock.Any(), "budget-1").Return(nil)
+	mockService.EXPECT().FindBudget(gomock.Any(), "invalid_id").Return(model.Budget{}, service.ErrBudgetNotFound)
+
+	t.Run("Delete Budget", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/budgets/budget-1", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.DeleteBudget(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "budget-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Delete Budget with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/budgets/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		budgetHandler.DeleteBudget(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestAccessAnotherUsersBudget_Handler(t *testing.T) {
+	ctrl, budgetHandler, mockService := SetupBudgetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().FindBudget(gomock.Any(), "budget-2").Return(model.Budget{ID: "budget-2", User_ID: "user-2"}, nil).Times(3)
+
+	body, _ := json.Marshal(foodBudget)
+
+	items := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"Get another user's budget", budgetHandler.GetBudget},
+		{"Update another user's budget", budgetHandler.UpdateBudget},
+		{"Delete another user's budget", budgetHandler.DeleteBudget},
+	}
+
+	for _, tt := range items {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/budgets/budget-2", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "budget-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	lists := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"List another user's budgets", budgetHandler.GetUserBudgets},
+		{"Create budget for another user", budgetHandler.CreateBudget},
+	}
+
+	for _, tt := range lists {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/users/user-2/budgets", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"net/http"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/julienschmidt/httprouter"
 	"github.com/varomnrg/money-tracker/model"
 	service "github.com/varomnrg/money-tracker/service/category"
@@ -15,23 +14,34 @@ type CategoryHandler struct {
 	service service.ICategoryService
 }
 
-var validate *validator.Validate
+var ErrForbidden = model.NewError(model.CodeForbidden, "only admins can list all categories")
 
 func NewCategoryHandler(categoryService service.ICategoryService) *CategoryHandler {
 	return &CategoryHandler{service: categoryService}
 }
 
 func (h *CategoryHandler) GetCategories(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
-	categories, err := h.service.GetCategories()
+	if !utils.IsAdmin(r.Context()) {
+		utils.WriteError(w, ErrForbidden)
+		return
+	}
+
+	query, err := utils.ListQuery(r)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	categories, err := h.service.GetCategories(r.Context(), query)
 	if err != nil {
-		http.Error(w, "Internal server error: unable to get all categories", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
 	res, err := json.Marshal(categories)
 
 	if err != nil {
-		http.Error(w, "Internal server error: could not marshal json", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -42,20 +52,26 @@ func (h *CategoryHandler) GetCategories(w http.ResponseWriter, r *http.Request,
 func (h *CategoryHandler) GetUserCategories(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	userID := ps.ByName("userID")
 
-	categories, err := h.service.GetUserCategories(userID)
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	query, err := utils.ListQuery(r)
 	if err != nil {
-		if err == service.ErrUserNotFound {
-			http.Error(w, "User not found", http.StatusNotFound)
-			return
-		}
+		utils.WriteError(w, err)
+		return
+	}
 
-		http.Error(w, "Internal server error: unable to get user categories", http.StatusInternalServerError)
+	categories, err := h.service.GetUserCategories(r.Context(), userID, query)
+	if err != nil {
+		utils.WriteError(w, err)
 		return
 	}
 
 	res, err := json.Marshal(categories)
 	if err != nil {
-		http.Error(w, "Internal server error: could not marshal json", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -66,20 +82,15 @@ func (h *CategoryHandler) GetUserCategories(w http.ResponseWriter, r *http.Reque
 func (h *CategoryHandler) GetCategory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	category, err := h.service.GetCategory(id)
+	category, err := h.authorizeCategory(r, id)
 	if err != nil {
-		if err == service.ErrCategoryNotFound {
-			http.Error(w, "Category not found", http.StatusNotFound)
-			return
-		}
-
-		http.Error(w, "Internal server error: unable to get category", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
 	res, err := json.Marshal(category)
 	if err != nil {
-		http.Error(w, "Internal server error: could not marshal json", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -91,36 +102,17 @@ func (h *CategoryHandler) CreateCategory(w http.ResponseWriter, r *http.Request,
 	category := model.CategoryRequest{}
 	userID := ps.ByName("userID")
 
-	err := json.NewDecoder(r.Body).Decode(&category)
-	if err != nil {
-		http.Error(w, "Internal server error: unable to decode category", http.StatusInternalServerError)
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
 		return
 	}
 
-	validate = validator.New()
-	err = validate.Struct(category)
-	if err != nil {
-		errs := utils.MapErrors(err, validate)
-		
-		utils.JSONErrorMap(w, errs, http.StatusBadRequest)
-
-		http.Error(w, "Internal server error: unable to validate category", http.StatusInternalServerError)
-		return
+	err := utils.DecodeJSON(r, &category)
+	if err == nil {
+		err = h.service.CreateCategory(r.Context(), userID, category)
 	}
-
-	err = h.service.CreateCategory(userID, category)
 	if err != nil {
-		if err == service.ErrUserNotFound {
-			http.Error(w, "User not found", http.StatusNotFound)
-			return
-		}
-
-		if err == service.ErrCategoryAlreadyExist {
-			http.Error(w, "Category already exist", http.StatusBadRequest)
-			return
-		}
-
-		http.Error(w, "Internal server error: unable to create category", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -131,17 +123,31 @@ func (h *CategoryHandler) CreateCategory(w http.ResponseWriter, r *http.Request,
 func (h *CategoryHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	id := ps.ByName("id")
 
-	err := h.service.DeleteCategory(id)
+	_, err := h.authorizeCategory(r, id)
+	if err == nil {
+		err = h.service.DeleteCategory(r.Context(), id)
+	}
 	if err != nil {
-		if err == service.ErrCategoryNotFound {
-			http.Error(w, "Category not found", http.StatusNotFound)
-			return
-		}
-
-		http.Error(w, "Internal server error: unable to delete category", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("Category deleted"))
 }
+
+// authorizeCategory returns the category with the given id if the caller is
+// allowed to access it. Categories owned by someone else are reported as not
+// found so their existence is not leaked.
+func (h *CategoryHandler) authorizeCategory(r *http.Request, id string) (model.Category, error) {
+	category, err := h.service.GetCategory(r.Context(), id)
+	if err != nil {
+		return model.Category{}, err
+	}
+
+	if !utils.CanAccess(r.Context(), category.User_ID) {
+		return model.Category{}, service.ErrCategoryNotFound
+	}
+
+	return category, nil
+}
@@ -14,9 +14,19 @@ import (
 	mock_service "github.com/varomnrg/money-tracker/mocks/service/category"
 	"github.com/varomnrg/money-tracker/model"
 	service "github.com/varomnrg/money-tracker/service/category"
+	"github.com/varomnrg/money-tracker/utils"
 	"go.uber.org/mock/gomock"
 )
 
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
 func SetupCategoryHandler(t *testing.T) (*gomock.Controller, *handler.CategoryHandler, *mock_service.MockICategoryService) {
 	ctrl := gomock.NewController(t)
 	mockService := mock_service.NewMockICategoryService(ctrl)
@@ -30,27 +40,36 @@ func TestGetCategories_Handler(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock GetCategories
-	mockService.EXPECT().GetCategories().Return(
-		[]model.Category{
+	mockService.EXPECT().GetCategories(gomock.Any(), model.ListQuery{Search: "cat"}).Return(
+		model.Page[model.Category]{Items: []model.Category{
 			{ID: "category-1", Name: "category1"},
 			{ID: "category-2", Name: "category2"},
-		},
+		}},
 		nil,
 	)
 
 	t.Run("Get Categories", func(t *testing.T) {
-		req, _ := http.NewRequest("GET", "/categories", nil)
+		req, _ := http.NewRequest("GET", "/categories?search=cat", nil)
 
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.GetCategories(recorder, req, nil)
+		categoryHandler.GetCategories(recorder, withAuthUser(req, admin), nil)
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 
-		var categories []model.Category
+		var categories model.Page[model.Category]
 		json.Unmarshal(recorder.Body.Bytes(), &categories)
 
-		assert.Len(t, categories, 2, "Expected one category returned")
-		assert.Equal(t, "category1", categories[0].Name, "Expected category1 in response array at index 0")
+		assert.Len(t, categories.Items, 2, "Expected two categories returned")
+		assert.Equal(t, "category1", categories.Items[0].Name, "Expected category1 in response array at index 0")
+	})
+
+	t.Run("Get Categories as non-admin", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/categories", nil)
+
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.GetCategories(recorder, withAuthUser(req, owner), nil)
+		assert.Equal(t, http.StatusForbidden, recorder.Code, "Expected status Forbidden")
 	})
 }
 
@@ -59,39 +78,48 @@ func TestGetUserCategories_Handler(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock GetUserCategories
-	mockService.EXPECT().GetUserCategories("user-1").Return(
-		[]model.Category{
+	mockService.EXPECT().GetUserCategories(gomock.Any(), "user-1", model.ListQuery{}).Return(
+		model.Page[model.Category]{Items: []model.Category{
 			{ID: "category-1", Name: "category1", User_ID: "user-1"},
 			{ID: "category-2", Name: "category2", User_ID: "user-1"},
-		},
+		}},
 		nil,
 	)
 
 	// Mock GetUserCategories with invalid id
-	mockService.EXPECT().GetUserCategories("invalid_id").Return([]model.Category{}, service.ErrUserNotFound)
+	mockService.EXPECT().GetUserCategories(gomock.Any(), "invalid_id", model.ListQuery{}).Return(model.Page[model.Category]{}, service.ErrUserNotFound)
 	
 	t.Run("Get User Categories", func(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/categories/user-1", nil)
 
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.GetUserCategories(recorder, req, []httprouter.Param{{Key: "userID", Value: "user-1"}})
+		categoryHandler.GetUserCategories(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 
 		log.Println(recorder.Body.String())
 
-		var categories []model.Category
+		var categories model.Page[model.Category]
 		json.Unmarshal(recorder.Body.Bytes(), &categories)
 
-		assert.Len(t, categories, 2, "Expected one category returned")
-		assert.Equal(t, "category1", categories[0].Name, "Expected category1 in response array at index 0")
+		assert.Len(t, categories.Items, 2, "Expected two categories returned")
+		assert.Equal(t, "category1", categories.Items[0].Name, "Expected category1 in response array at index 0")
 	})
 
 	t.Run("Get User Categories with invalid id", func(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/categories/invalid_id", nil)
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.GetUserCategories(recorder, req, []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+		categoryHandler.GetUserCategories(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Get another user's categories", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/categories/user-2", nil)
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.GetUserCategories(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
 
 		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 	})
@@ -102,15 +130,16 @@ func TestGetCategory_Handler(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock GetCategory
-	mockService.EXPECT().GetCategory("category-1").Return(model.Category{ID: "category-1", Name: "category1"}, nil)
-	mockService.EXPECT().GetCategory("invalid_id").Return(model.Category{}, service.ErrCategoryNotFound)
+	mockService.EXPECT().GetCategory(gomock.Any(), "category-1").Return(model.Category{ID: "category-1", Name: "category1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().GetCategory(gomock.Any(), "invalid_id").Return(model.Category{}, service.ErrCategoryNotFound)
+	mockService.EXPECT().GetCategory(gomock.Any(), "category-2").Return(model.Category{ID: "category-2", Name: "category2", User_ID: "user-2"}, nil).Times(2)
 
 	t.Run("Get Category", func(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/categories/category-1", nil)
 
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.GetCategory(recorder, req, []httprouter.Param{{Key: "id", Value: "category-1"}})
+		categoryHandler.GetCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "category-1"}})
 
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 
@@ -124,10 +153,28 @@ func TestGetCategory_Handler(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/categories/invalid_id", nil)
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.GetCategory(recorder, req, []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+		categoryHandler.GetCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Get another user's category", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/categories/category-2", nil)
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.GetCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "category-2"}})
 
 		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 	})
+
+	t.Run("Get another user's category as admin", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/categories/category-2", nil)
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.GetCategory(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "category-2"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
 }
 
 func TestCreateCategory_Handler(t *testing.T) {
@@ -135,13 +182,13 @@ func TestCreateCategory_Handler(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock CreateCategory
-	mockService.EXPECT().CreateCategory("user-1", model.CategoryRequest{Name: "category1"}).Return(nil)
+	mockService.EXPECT().CreateCategory(gomock.Any(), "user-1", model.CategoryRequest{Name: "category1"}).Return(nil)
 
 	// Mock CreateCategory with invalid user id
-	mockService.EXPECT().CreateCategory("invalid_id", model.CategoryRequest{Name: "category1"}).Return(service.ErrUserNotFound)
+	mockService.EXPECT().CreateCategory(gomock.Any(), "invalid_id", model.CategoryRequest{Name: "category1"}).Return(service.ErrUserNotFound)
 
 	// Mock CreateCategory with existing category
-	mockService.EXPECT().CreateCategory("user-1", model.CategoryRequest{Name: "category1"}).Return(service.ErrCategoryAlreadyExist)
+	mockService.EXPECT().CreateCategory(gomock.Any(), "user-1", model.CategoryRequest{Name: "category1"}).Return(service.ErrCategoryAlreadyExist)
 
 	t.Run("Create Category", func(t *testing.T) {
 		category := model.CategoryRequest{Name: "category1"}
@@ -151,7 +198,7 @@ func TestCreateCategory_Handler(t *testing.T) {
 
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.CreateCategory(recorder, req, []httprouter.Param{{Key: "userID", Value: "user-1"}})
+		categoryHandler.CreateCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
 
 		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
 	})
@@ -164,7 +211,7 @@ func TestCreateCategory_Handler(t *testing.T) {
 
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.CreateCategory(recorder, req, []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+		categoryHandler.CreateCategory(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
 
 		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 	})
@@ -177,9 +224,42 @@ func TestCreateCategory_Handler(t *testing.T) {
 
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.CreateCategory(recorder, req, []httprouter.Param{{Key: "userID", Value: "user-1"}})
+		categoryHandler.CreateCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
 
-		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Conflict")
+		assert.Equal(t, http.StatusConflict, recorder.Code, "Expected status Conflict")
+	})
+
+	t.Run("Create Category with invalid body", func(t *testing.T) {
+		body, _ := json.Marshal(model.CategoryRequest{Name: ""})
+		req, _ := http.NewRequest("POST", "/categories/user-1", bytes.NewReader(body))
+
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.CreateCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+		assert.Equal(t, utils.ProblemContentType, recorder.Header().Get("Content-Type"))
+
+		var problem model.Problem
+		decoder := json.NewDecoder(recorder.Body)
+		assert.NoError(t, decoder.Decode(&problem))
+		assert.False(t, decoder.More(), "Expected a single response body")
+
+		assert.Equal(t, model.CodeValidation, problem.Code)
+		assert.Contains(t, problem.Errors, "Name")
+	})
+
+	t.Run("Create Category for another user", func(t *testing.T) {
+		category := model.CategoryRequest{Name: "category1"}
+
+		body, _ := json.Marshal(category)
+		req, _ := http.NewRequest("POST", "/categories/user-2", bytes.NewReader(body))
+
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.CreateCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 	})
 }
 
@@ -188,17 +268,25 @@ func TestDeleteCategory_Handler(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock DeleteCategory
-	mockService.EXPECT().DeleteCategory("category-1").Return(nil)
+	mockService.EXPECT().GetCategory(gomock.Any(), "category-1").Return(model.Category{ID: "category-1", Name: "category1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().DeleteCategory(gomock.Any(), "category-1").Return(nil)
 
 	// Mock DeleteCategory with invalid id
-	mockService.EXPECT().DeleteCategory("invalid_id").Return(service.ErrCategoryNotFound)
+	mockService.EXPECT().GetCategory(gomock.Any(), "invalid_id").Return(model.Category{}, service.ErrCategoryNotFound)
+
+	// Mock DeleteCategory owned by another user
+	mockService.EXPECT().GetCategory(gomock.Any(), "category-2").Return(model.Category{ID: "category-2", Name: "category2", User_ID: "user-2"}, nil)
+
+	// Mock DeleteCategory still in use
+	mockService.EXPECT().GetCategory(gomock.Any(), "category-3").Return(model.Category{ID: "category-3", Name: "category3", User_ID: "user-1"}, nil)
+	mockService.EXPECT().DeleteCategory(gomock.Any(), "category-3").Return(service.ErrCategoryInUse)
 
 	t.Run("Delete Category", func(t *testing.T) {
 		req, _ := http.NewRequest("DELETE", "/categories/category-1", nil)
 
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.DeleteCategory(recorder, req, []httprouter.Param{{Key: "id", Value: "category-1"}})
+		categoryHandler.DeleteCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "category-1"}})
 
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 	})
@@ -207,8 +295,26 @@ func TestDeleteCategory_Handler(t *testing.T) {
 		req, _ := http.NewRequest("DELETE", "/categories/invalid_id", nil)
 		recorder := httptest.NewRecorder()
 
-		categoryHandler.DeleteCategory(recorder, req, []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+		categoryHandler.DeleteCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
 
 		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 	})
+
+	t.Run("Delete another user's category", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/categories/category-2", nil)
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.DeleteCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "category-2"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Delete Category in use", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/categories/category-3", nil)
+		recorder := httptest.NewRecorder()
+
+		categoryHandler.DeleteCategory(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "category-3"}})
+
+		assert.Equal(t, http.StatusConflict, recorder.Code, "Expected status Conflict")
+	})
 }
\ No newline at end of file
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/notification"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type NotificationHandler struct {
+	service service.INotificationService
+}
+
+var ErrInvalidUnread = model.NewError(model.CodeValidation, "unread must be true or false")
+
+func NewNotificationHandler(notificationService service.INotificationService) *NotificationHandler {
+	return &NotificationHandler{service: notificationService}
+}
+
+// GetUserNotifications lists the user's inbox, newest first. With
+// unread=true it lists only the notifications not yet marked read.
+func (h *NotificationHandler) GetUserNotifications(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	listQuery, err := utils.ListQuery(r)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	query := model.NotificationQuery{ListQuery: listQuery}
+
+	if unread := r.URL.Query().Get("unread"); unread != "" {
+		query.Unread, err = strconv.ParseBool(unread)
+		if err != nil {
+			utils.WriteError(w, ErrInvalidUnread)
+			return
+		}
+	}
+
+	notifications, err := h.service.GetUserNotifications(r.Context(), userID, query)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(notifications)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	_, err := h.authorizeNotification(r, id)
+	if err == nil {
+		err = h.service.MarkRead(r.Context(), id)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Notification marked as read"))
+}
+
+func (h *NotificationHandler) MarkAllRead(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	err := h.service.MarkAllRead(r.Context(), userID)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Notifications marked as read"))
+}
+
+func (h *NotificationHandler) authorizeNotification(r *http.Request, id string) (model.Notification, error) {
+	return utils.Authorize(r.Context(), h.service.GetNotification, id, func(notification model.Notification) string { return notification.User_ID }, service.ErrNotificationNotFound)
+}
@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/notification"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/notification"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/notification"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	other = model.AuthUser{ID: "user-2", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupNotificationHandler(t *testing.T) (*gomock.Controller, *handler.NotificationHandler, *mock_service.MockINotificationService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockINotificationService(ctrl)
+	notificationHandler := handler.NewNotificationHandler(mockService)
+
+	return ctrl, notificationHandler, mockService
+}
+
+func TestGetUserNotifications_Handler(t *testing.T) {
+	ctrl, notificationHandler, mockService := SetupNotificationHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetUserNotifications(gomock.Any(), "user-1", model.NotificationQuery{Unread: true}).Return(
+		model.Page[model.Notification]{Items: []model.Notification{{ID: "ntf-1", User_ID: "user-1", Title: "Food budget for 2024-03 has reached 80%"}}},
+		nil,
+	)
+	mockService.EXPECT().GetUserNotifications(gomock.Any(), "invalid_id", gomock.Any()).Return(model.Page[model.Notification]{}, service.ErrUserNotFound)
+
+	t.Run("Get User Notifications", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/notifications?unread=true", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.GetUserNotifications(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var page model.Page[model.Notification]
+		json.Unmarshal(recorder.Body.Bytes(), &page)
+
+		assert.Len(t, page.Items, 1, "Expected one notification returned")
+	})
+
+	t.Run("Get User Notifications with invalid unread", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/notifications?unread=maybe", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.GetUserNotifications(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Get User Notifications of another user", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/notifications", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.GetUserNotifications(recorder, withAuthUser(req, other), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Get User Notifications with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/invalid_id/notifications", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.GetUserNotifications(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestMarkRead_Handler(t *testing.T) {
+	ctrl, notificationHandler, mockService := SetupNotificationHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetNotification(gomock.Any(), "ntf-1").Return(model.Notification{ID: "ntf-1", User_ID: "user-1"}, nil).Times(2)
+	mockService.EXPECT().MarkRead(gomock.Any(), "ntf-1").Return(nil)
+	mockService.EXPECT().GetNotification(gomock.Any(), "invalid_id").Return(model.Notification{}, service.ErrNotificationNotFound)
+
+	t.Run("Mark Read", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/notifications/ntf-1/read", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.MarkRead(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "ntf-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Mark Read of another user", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/notifications/ntf-1/read", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.MarkRead(recorder, withAuthUser(req, other), []httprouter.Param{{Key: "id", Value: "ntf-1"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Mark Read with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/notifications/invalid_id/read", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.MarkRead(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestMarkAllRead_Handler(t *testing.T) {
+	ctrl, notificationHandler, mockService := SetupNotificationHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().MarkAllRead(gomock.Any(), "user-1").Return(nil)
+
+	t.Run("Mark All Read", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/user-1/notifications/read", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.MarkAllRead(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Mark All Read of another user", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/user-1/notifications/read", nil)
+		recorder := httptest.NewRecorder()
+
+		notificationHandler.MarkAllRead(recorder, withAuthUser(req, other), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
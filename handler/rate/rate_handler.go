@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/rate"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type RateHandler struct {
+	service service.IRateService
+}
+
+func NewRateHandler(rateService service.IRateService) *RateHandler {
+	return &RateHandler{service: rateService}
+}
+
+func (h *RateHandler) GetRates(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	rates, err := h.service.GetRates(r.Context())
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(rates)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *RateHandler) SaveRate(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	rate := model.ExchangeRateRequest{}
+
+	err := utils.DecodeJSON(r, &rate)
+	if err == nil {
+		err = h.service.SaveRate(r.Context(), rate)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("Exchange rate saved"))
+}
+
+// ImportRates accepts a CSV file as the raw request body, e.g.
+//
+//	curl --data-binary @rates.csv -H "Content-Type: text/csv" .../rates/import
+func (h *RateHandler) ImportRates(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	count, err := h.service.ImportRates(r.Context(), r.Body)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(fmt.Sprintf("%d exchange rates imported", count)))
+}
+
+func (h *RateHandler) DeleteRate(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	err := h.service.DeleteRate(r.Context(), id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Exchange rate deleted"))
+}
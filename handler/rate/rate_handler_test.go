@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/rate"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/rate"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/rate"
+	"go.uber.org/mock/gomock"
+)
+
+func SetupRateHandler(t *testing.T) (*gomock.Controller, *handler.RateHandler, *mock_service.MockIRateService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockIRateService(ctrl)
+	rateHandler := handler.NewRateHandler(mockService)
+
+	return ctrl, rateHandler, mockService
+}
+
+func TestGetRates_Handler(t *testing.T) {
+	ctrl, rateHandler, mockService := SetupRateHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetRates(gomock.Any()).Return([]model.ExchangeRate{{ID: "rate-1", Base: "USD", Quote: "IDR", Rate: 15600}}, nil)
+
+	req, _ := http.NewRequest("GET", "/rates", nil)
+	recorder := httptest.NewRecorder()
+
+	rateHandler.GetRates(recorder, req, nil)
+
+	assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+	var rates []model.ExchangeRate
+	json.Unmarshal(recorder.Body.Bytes(), &rates)
+
+	assert.Len(t, rates, 1)
+	assert.Equal(t, float64(15600), rates[0].Rate)
+}
+
+func TestSaveRate_Handler(t *testing.T) {
+	ctrl, rateHandler, mockService := SetupRateHandler(t)
+	defer ctrl.Finish()
+
+	rate := model.ExchangeRateRequest{Base: "USD", Quote: "IDR", Rate: 15600, Date: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)}
+
+	mockService.EXPECT().SaveRate(gomock.Any(), rate).Return(nil)
+
+	t.Run("Save Rate", func(t *testing.T) {
+		body, _ := json.Marshal(rate)
+		req, _ := http.NewRequest("POST", "/rates", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		rateHandler.SaveRate(recorder, req, nil)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
+	})
+
+	t.Run("Save Rate with same currencies", func(t *testing.T) {
+		invalid := rate
+		invalid.Quote = "USD"
+
+		body, _ := json.Marshal(invalid)
+		req, _ := http.NewRequest("POST", "/rates", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		rateHandler.SaveRate(recorder, req, nil)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestImportRates_Handler(t *testing.T) {
+	ctrl, rateHandler, mockService := SetupRateHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().ImportRates(gomock.Any(), gomock.Any()).Return(2, nil)
+	mockService.EXPECT().ImportRates(gomock.Any(), gomock.Any()).Return(0, fmt.Errorf("%w: line 2: rate must be positive", service.ErrInvalidCSV))
+
+	t.Run("Import Rates", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/rates/import", strings.NewReader("date,base,quote,rate\n"))
+		recorder := httptest.NewRecorder()
+
+		rateHandler.ImportRates(recorder, req, nil)
+
+		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
+		assert.Equal(t, "2 exchange rates imported", recorder.Body.String())
+	})
+
+	t.Run("Import Rates with invalid csv", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/rates/import", strings.NewReader("bad"))
+		recorder := httptest.NewRecorder()
+
+		rateHandler.ImportRates(recorder, req, nil)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestDeleteRate_Handler(t *testing.T) {
+	ctrl, rateHandler, mockService := SetupRateHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().DeleteRate(gomock.Any(), "rate-1").Return(nil)
+	mockService.EXPECT().DeleteRate(gomock.Any(), "invalid_id").Return(service.ErrRateNotFound)
+
+	t.Run("Delete Rate", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/rates/rate-1", nil)
+		recorder := httptest.NewRecorder()
+
+		rateHandler.DeleteRate(recorder, req, []httprouter.Param{{Key: "id", Value: "rate-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Delete Rate with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/rates/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		rateHandler.DeleteRate(recorder, req, []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/recurring"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type RecurringHandler struct {
+	service service.IRecurringService
+}
+
+func NewRecurringHandler(recurringService service.IRecurringService) *RecurringHandler {
+	return &RecurringHandler{service: recurringService}
+}
+
+func (h *RecurringHandler) GetUserRecurringTransactions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	recurring, err := h.service.GetUserRecurringTransactions(r.Context(), userID)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(recurring)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *RecurringHandler) GetRecurringTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	recurring, err := h.authorizeRecurring(r, id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(recurring)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *RecurringHandler) CreateRecurringTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+	recurring := model.RecurringTransactionRequest{}
+
+	err := utils.DecodeJSON(r, &recurring)
+	if err == nil {
+		err = h.service.CreateRecurringTransaction(r.Context(), userID, recurring)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("Recurring transaction created"))
+}
+
+// UpdateRecurringTransaction changes the occurrences still to come; the
+// transactions already created are edited on their own.
+func (h *RecurringHandler) UpdateRecurringTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+	recurring := model.RecurringTransactionRequest{}
+
+	err := utils.DecodeJSON(r, &recurring)
+	if err == nil {
+		_, err = h.authorizeRecurring(r, id)
+	}
+	if err == nil {
+		err = h.service.UpdateRecurringTransaction(r.Context(), id, recurring)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Recurring transaction updated"))
+}
+
+func (h *RecurringHandler) DeleteRecurringTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.act(w, r, ps.ByName("id"), h.service.DeleteRecurringTransaction, "Recurring transaction deleted")
+}
+
+func (h *RecurringHandler) PauseRecurringTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.act(w, r, ps.ByName("id"), h.service.PauseRecurringTransaction, "Recurring transaction paused")
+}
+
+// ResumeRecurringTransaction resumes a paused recurring transaction from its
+// first occurrence still to come.
+func (h *RecurringHandler) ResumeRecurringTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.act(w, r, ps.ByName("id"), h.service.ResumeRecurringTransaction, "Recurring transaction resumed")
+}
+
+// SkipRecurringTransaction skips the next occurrence without creating its
+// transaction.
+func (h *RecurringHandler) SkipRecurringTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	h.act(w, r, ps.ByName("id"), h.service.SkipRecurringTransaction, "Next occurrence skipped")
+}
+
+// act runs fn on the recurring transaction with the given id once the caller
+// is authorized for it, and writes message on success.
+func (h *RecurringHandler) act(w http.ResponseWriter, r *http.Request, id string, fn func(ctx context.Context, id string) error, message string) {
+	_, err := h.authorizeRecurring(r, id)
+	if err == nil {
+		err = fn(r.Context(), id)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(message))
+}
+
+func (h *RecurringHandler) authorizeRecurring(r *http.Request, id string) (model.RecurringTransaction, error) {
+	return utils.Authorize(r.Context(), h.service.GetRecurringTransaction, id, func(recurring model.RecurringTransaction) string { return recurring.User_ID }, service.ErrRecurringNotFound)
+}
@@ -0,0 +1,318 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/recurring"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/recurring"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/recurring"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+
+	rent = model.RecurringTransactionRequest{
+		Wallet_ID:   "wallet-1",
+		Category_ID: "cat-rent",
+		Type:        model.TransactionTypeExpense,
+		Amount:      model.MoneyRequest{Amount: "2500000.00", Currency: "IDR"},
+		Description: "Rent",
+		Recurrence: model.Recurrence{
+			Frequency:  model.FrequencyMonthly,
+			Start_Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupRecurringHandler(t *testing.T) (*gomock.Controller, *handler.RecurringHandler, *mock_service.MockIRecurringService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockIRecurringService(ctrl)
+	recurringHandler := handler.NewRecurringHandler(mockService)
+
+	return ctrl, recurringHandler, mockService
+}
+
+func TestGetUserRecurringTransactions_Handler(t *testing.T) {
+	ctrl, recurringHandler, mockService := SetupRecurringHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetUserRecurringTransactions(gomock.Any(), "user-1").Return(
+		[]model.RecurringTransaction{
+			{ID: "rec-1", User_ID: "user-1", Recurrence: model.Recurrence{Frequency: model.FrequencyMonthly}},
+			{ID: "rec-2", User_ID: "user-1", Recurrence: model.Recurrence{Frequency: model.FrequencyWeekly, Weekdays: []string{"MO"}}},
+		},
+		nil,
+	)
+	mockService.EXPECT().GetUserRecurringTransactions(gomock.Any(), "invalid_id").Return(nil, service.ErrUserNotFound)
+
+	t.Run("Get User Recurring Transactions", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/recurring-transactions", nil)
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.GetUserRecurringTransactions(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var recurring []model.RecurringTransaction
+		json.Unmarshal(recorder.Body.Bytes(), &recurring)
+
+		assert.Len(t, recurring, 2, "Expected two recurring transactions returned")
+		assert.Equal(t, model.FrequencyMonthly, recurring[0].Frequency, "Expected the schedule's fields at the top level")
+		assert.Equal(t, []string{"MO"}, recurring[1].Weekdays)
+	})
+
+	t.Run("Get User Recurring Transactions with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/invalid_id/recurring-transactions", nil)
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.GetUserRecurringTransactions(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestGetRecurringTransaction_Handler(t *testing.T) {
+	ctrl, recurringHandler, mockService := SetupRecurringHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(model.RecurringTransaction{ID: "rec-1", User_ID: "user-1", Paused: true}, nil)
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "invalid_id").Return(model.RecurringTransaction{}, service.ErrRecurringNotFound)
+
+	t.Run("Get Recurring Transaction", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/recurring-transactions/rec-1", nil)
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.GetRecurringTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "rec-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var recurring model.RecurringTransaction
+		json.Unmarshal(recorder.Body.Bytes(), &recurring)
+
+		assert.Equal(t, "rec-1", recurring.ID)
+		assert.True(t, recurring.Paused)
+	})
+
+	t.Run("Get Recurring Transaction with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/recurring-transactions/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.GetRecurringTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestCreateRecurringTransaction_Handler(t *testing.T) {
+	ctrl, recurringHandler, mockService := SetupRecurringHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().CreateRecurringTransaction(gomock.Any(), "user-1", rent).Return(nil)
+	mockService.EXPECT().CreateRecurringTransaction(gomock.Any(), "invalid_id", rent).Return(service.ErrUserNotFound)
+	mockService.EXPECT().CreateRecurringTransaction(gomock.Any(), "user-1", rent).Return(service.ErrWalletNotFound)
+
+	t.Run("Create Recurring Transaction", func(t *testing.T) {
+		body, _ := json.Marshal(rent)
+		req, _ := http.NewRequest("POST", "/users/user-1/recurring-transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.CreateRecurringTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
+	})
+
+	t.Run("Create Recurring Transaction with invalid user id", func(t *testing.T) {
+		body, _ := json.Marshal(rent)
+		req, _ := http.NewRequest("POST", "/users/invalid_id/recurring-transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.CreateRecurringTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Create Recurring Transaction with another user's wallet", func(t *testing.T) {
+		body, _ := json.Marshal(rent)
+		req, _ := http.NewRequest("POST", "/users/user-1/recurring-transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.CreateRecurringTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Create Recurring Transaction with invalid frequency", func(t *testing.T) {
+		body := []byte(`{"wallet_id":"wallet-1","category_id":"cat-rent","type":"expense","amount":{"amount":"1000.00"},"frequency":"hourly","start_date":"2024-01-01T00:00:00Z"}`)
+		req, _ := http.NewRequest("POST", "/users/user-1/recurring-transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.CreateRecurringTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Create Recurring Transaction with invalid weekday", func(t *testing.T) {
+		body := []byte(`{"wallet_id":"wallet-1","category_id":"cat-rent","type":"expense","amount":{"amount":"1000.00"},"frequency":"weekly","weekdays":["MON"],"start_date":"2024-01-01T00:00:00Z"}`)
+		req, _ := http.NewRequest("POST", "/users/user-1/recurring-transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.CreateRecurringTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestUpdateRecurringTransaction_Handler(t *testing.T) {
+	ctrl, recurringHandler, mockService := SetupRecurringHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(model.RecurringTransaction{ID: "rec-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().UpdateRecurringTransaction(gomock.Any(), "rec-1", rent).Return(nil)
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "invalid_id").Return(model.RecurringTransaction{}, service.ErrRecurringNotFound)
+
+	t.Run("Update Recurring Transaction", func(t *testing.T) {
+		body, _ := json.Marshal(rent)
+		req, _ := http.NewRequest("PUT", "/recurring-transactions/rec-1", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.UpdateRecurringTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "rec-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Update Recurring Transaction with invalid id", func(t *testing.T) {
+		body, _ := json.Marshal(rent)
+		req, _ := http.NewRequest("PUT", "/recurring-transactions/invalid_id", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.UpdateRecurringTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestDeleteRecurringTransaction_Handler(t *testing.T) {
+	ctrl, recurringHandler, mockService := SetupRecurringHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(model.RecurringTransaction{ID: "rec-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().DeleteRecurringTransaction(gomock.Any(), "rec-1").Return(nil)
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "invalid_id").Return(model.RecurringTransaction{}, service.ErrRecurringNotFound)
+
+	t.Run("Delete Recurring Transaction", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/recurring-transactions/rec-1", nil)
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.DeleteRecurringTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "rec-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Delete Recurring Transaction with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/recurring-transactions/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		recurringHandler.DeleteRecurringTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestPauseResumeSkipRecurringTransaction_Handler(t *testing.T) {
+	ctrl, recurringHandler, mockService := SetupRecurringHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(model.RecurringTransaction{ID: "rec-1", User_ID: "user-1"}, nil).Times(4)
+	mockService.EXPECT().PauseRecurringTransaction(gomock.Any(), "rec-1").Return(nil)
+	mockService.EXPECT().ResumeRecurringTransaction(gomock.Any(), "rec-1").Return(nil)
+	mockService.EXPECT().SkipRecurringTransaction(gomock.Any(), "rec-1").Return(nil)
+	mockService.EXPECT().SkipRecurringTransaction(gomock.Any(), "rec-1").Return(service.ErrRecurringEnded)
+
+	items := []struct {
+		name   string
+		handle httprouter.Handle
+		status int
+	}{
+		{"Pause Recurring Transaction", recurringHandler.PauseRecurringTransaction, http.StatusOK},
+		{"Resume Recurring Transaction", recurringHandler.ResumeRecurringTransaction, http.StatusOK},
+		{"Skip Recurring Transaction", recurringHandler.SkipRecurringTransaction, http.StatusOK},
+		{"Skip ended Recurring Transaction", recurringHandler.SkipRecurringTransaction, http.StatusConflict},
+	}
+
+	for _, tt := range items {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/recurring-transactions/rec-1", nil)
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "rec-1"}})
+
+			assert.Equal(t, tt.status, recorder.Code)
+		})
+	}
+}
+
+func TestAccessAnotherUsersRecurringTransaction_Handler(t *testing.T) {
+	ctrl, recurringHandler, mockService := SetupRecurringHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-2").Return(model.RecurringTransaction{ID: "rec-2", User_ID: "user-2"}, nil).Times(6)
+
+	body, _ := json.Marshal(rent)
+
+	items := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"Get another user's recurring transaction", recurringHandler.GetRecurringTransaction},
+		{"Update another user's recurring transaction", recurringHandler.UpdateRecurringTransaction},
+		{"Delete another user's recurring transaction", recurringHandler.DeleteRecurringTransaction},
+		{"Pause another user's recurring transaction", recurringHandler.PauseRecurringTransaction},
+		{"Resume another user's recurring transaction", recurringHandler.ResumeRecurringTransaction},
+		{"Skip another user's recurring transaction", recurringHandler.SkipRecurringTransaction},
+	}
+
+	for _, tt := range items {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/recurring-transactions/rec-2", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "rec-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	lists := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"List another user's recurring transactions", recurringHandler.GetUserRecurringTransactions},
+		{"Create recurring transaction for another user", recurringHandler.CreateRecurringTransaction},
+	}
+
+	for _, tt := range lists {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/users/user-2/recurring-transactions", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+}
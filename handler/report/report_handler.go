@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/report"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type ReportHandler struct {
+	service service.IReportService
+}
+
+var (
+	ErrInvalidCurrency = model.NewError(model.CodeValidation, "currency is not valid")
+	ErrInvalidMonth    = model.NewError(model.CodeValidation, "month must be in YYYY-MM format")
+	ErrInvalidDate     = model.NewError(model.CodeValidation, "from and to must be in YYYY-MM-DD format")
+)
+
+func NewReportHandler(reportService service.IReportService) *ReportHandler {
+	return &ReportHandler{service: reportService}
+}
+
+// GetSummary reports on the month given as YYYY-MM, or on the days from and
+// to, both inclusive. Without either it reports on the current month.
+func (h *ReportHandler) GetSummary(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+
+	currency := query.Get("currency")
+	if currency == "" {
+		currency = model.DefaultCurrency
+	}
+
+	if validator.New().Var(currency, "iso4217") != nil {
+		utils.WriteError(w, ErrInvalidCurrency)
+		return
+	}
+
+	from, to, err := period(query.Get("month"), query.Get("from"), query.Get("to"))
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	summary, err := h.service.GetSummary(r.Context(), userID, from, to, currency)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(summary)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+// period returns the bounds [from, to) of the requested period in the local
+// time zone.
+func period(month string, fromDate string, toDate string) (time.Time, time.Time, error) {
+	location := utils.GetCurrentTime().Location()
+
+	if fromDate != "" || toDate != "" {
+		from, err := time.ParseInLocation(time.DateOnly, fromDate, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidDate
+		}
+
+		to, err := time.ParseInLocation(time.DateOnly, toDate, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidDate
+		}
+
+		return from, to.AddDate(0, 0, 1), nil
+	}
+
+	start := utils.GetCurrentTime()
+	start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, location)
+
+	if month != "" {
+		var err error
+
+		start, err = time.ParseInLocation("2006-01", month, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidMonth
+		}
+	}
+
+	return start, start.AddDate(0, 1, 0), nil
+}
@@ -0,0 +1,129 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/report"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/report"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/report"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupReportHandler(t *testing.T) (*gomock.Controller, *handler.ReportHandler, *mock_service.MockIReportService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockIReportService(ctrl)
+	reportHandler := handler.NewReportHandler(mockService)
+
+	return ctrl, reportHandler, mockService
+}
+
+func TestGetSummary_Handler(t *testing.T) {
+	ctrl, reportHandler, mockService := SetupReportHandler(t)
+	defer ctrl.Finish()
+
+	location := utils.GetCurrentTime().Location()
+	march := time.Date(2024, time.March, 1, 0, 0, 0, 0, location)
+	now := utils.GetCurrentTime()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location)
+
+	mockService.EXPECT().GetSummary(gomock.Any(), "user-1", march, march.AddDate(0, 1, 0), "USD").Return(model.Summary{
+		Currency: "USD",
+		Income:   model.NewMoney(100000, "USD"),
+		Expense:  model.NewMoney(25000, "USD"),
+		Net:      model.NewMoney(75000, "USD"),
+		Categories: []model.CategorySummary{
+			{Category_ID: "cat-1", Name: "Food", Type: model.TransactionTypeExpense, Total: model.NewMoney(25000, "USD"), Percentage: 100},
+		},
+	}, nil)
+	mockService.EXPECT().GetSummary(gomock.Any(), "user-1", thisMonth, thisMonth.AddDate(0, 1, 0), model.DefaultCurrency).Return(model.Summary{}, nil)
+	mockService.EXPECT().GetSummary(gomock.Any(), "user-1", march, march.AddDate(0, 0, 15), model.DefaultCurrency).Return(model.Summary{}, nil)
+	mockService.EXPECT().GetSummary(gomock.Any(), "invalid_id", gomock.Any(), gomock.Any(), gomock.Any()).Return(model.Summary{}, service.ErrUserNotFound)
+
+	t.Run("Get Summary for a month", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/reports/summary?month=2024-03&currency=USD", nil)
+		recorder := httptest.NewRecorder()
+
+		reportHandler.GetSummary(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var summary model.Summary
+		json.Unmarshal(recorder.Body.Bytes(), &summary)
+
+		assert.Equal(t, "750.00", summary.Net.Decimal())
+		assert.Len(t, summary.Categories, 1)
+	})
+
+	t.Run("Get Summary for the current month", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/reports/summary", nil)
+		recorder := httptest.NewRecorder()
+
+		reportHandler.GetSummary(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Get Summary for a range of days", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/reports/summary?from=2024-03-01&to=2024-03-15", nil)
+		recorder := httptest.NewRecorder()
+
+		reportHandler.GetSummary(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	invalid := []struct {
+		name  string
+		query string
+	}{
+		{"month", "month=March"},
+		{"range without end", "from=2024-03-01"},
+		{"currency", "currency=rupiah"},
+	}
+
+	for _, tt := range invalid {
+		t.Run("Get Summary with invalid "+tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/users/user-1/reports/summary?"+tt.query, nil)
+			recorder := httptest.NewRecorder()
+
+			reportHandler.GetSummary(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+		})
+	}
+
+	t.Run("Get Summary with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/invalid_id/reports/summary", nil)
+		recorder := httptest.NewRecorder()
+
+		reportHandler.GetSummary(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Get another user's summary", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-2/reports/summary", nil)
+		recorder := httptest.NewRecorder()
+
+		reportHandler.GetSummary(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/transaction"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type TransactionHandler struct {
+	service service.ITransactionService
+}
+
+var (
+	ErrInvalidCurrency = model.NewError(model.CodeValidation, "currency is not valid")
+	ErrInvalidDate     = model.NewError(model.CodeValidation, "from and to must be in YYYY-MM-DD format")
+	ErrInvalidAmount   = model.NewError(model.CodeValidation, "min_amount and max_amount must be decimal amounts in currency")
+)
+
+func NewTransactionHandler(transactionService service.ITransactionService) *TransactionHandler {
+	return &TransactionHandler{service: transactionService}
+}
+
+func (h *TransactionHandler) GetUserTransactions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	query, err := transactionQuery(r)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	transactions, err := h.service.GetUserTransactions(r.Context(), userID, query)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(transactions)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *TransactionHandler) GetTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	transaction, err := h.authorizeTransaction(r, id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(transaction)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *TransactionHandler) CreateTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+	transaction := model.TransactionRequest{}
+
+	err := utils.DecodeJSON(r, &transaction)
+	if err == nil {
+		err = h.service.CreateTransaction(r.Context(), userID, transaction)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("Transaction created"))
+}
+
+func (h *TransactionHandler) UpdateTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+	transaction := model.TransactionRequest{}
+
+	err := utils.DecodeJSON(r, &transaction)
+	if err == nil {
+		_, err = h.authorizeTransaction(r, id)
+	}
+	if err == nil {
+		err = h.service.UpdateTransaction(r.Context(), id, transaction)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Transaction updated"))
+}
+
+func (h *TransactionHandler) DeleteTransaction(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	_, err := h.authorizeTransaction(r, id)
+	if err == nil {
+		err = h.service.DeleteTransaction(r.Context(), id)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Transaction deleted"))
+}
+
+func (h *TransactionHandler) authorizeTransaction(r *http.Request, id string) (model.Transaction, error) {
+	return utils.Authorize(r.Context(), h.service.GetTransaction, id, func(transaction model.Transaction) string { return transaction.User_ID }, service.ErrTransactionNotFound)
+}
+
+// transactionQuery reads the listing parameters of GetUserTransactions. The
+// from and to dates are both inclusive and read in the local time zone;
+// min_amount and max_amount are in currency, which defaults to
+// model.DefaultCurrency.
+func transactionQuery(r *http.Request) (model.TransactionQuery, error) {
+	listQuery, err := utils.ListQuery(r)
+	if err != nil {
+		return model.TransactionQuery{}, err
+	}
+
+	values := r.URL.Query()
+	query := model.TransactionQuery{
+		ListQuery:   listQuery,
+		Wallet_ID:   values.Get("wallet_id"),
+		Category_ID: values.Get("category_id"),
+	}
+
+	location := utils.GetCurrentTime().Location()
+
+	if from := values.Get("from"); from != "" {
+		query.From, err = time.ParseInLocation(time.DateOnly, from, location)
+		if err != nil {
+			return model.TransactionQuery{}, ErrInvalidDate
+		}
+	}
+
+	if to := values.Get("to"); to != "" {
+		date, err := time.ParseInLocation(time.DateOnly, to, location)
+		if err != nil {
+			return model.TransactionQuery{}, ErrInvalidDate
+		}
+
+		query.To = date.AddDate(0, 0, 1)
+	}
+
+	currency := values.Get("currency")
+	if currency == "" {
+		currency = model.DefaultCurrency
+	}
+
+	if validator.New().Var(currency, "iso4217") != nil {
+		return model.TransactionQuery{}, ErrInvalidCurrency
+	}
+
+	query.Min_Amount, err = parseAmount(values.Get("min_amount"), currency)
+	if err != nil {
+		return model.TransactionQuery{}, err
+	}
+
+	query.Max_Amount, err = parseAmount(values.Get("max_amount"), currency)
+	if err != nil {
+		return model.TransactionQuery{}, err
+	}
+
+	return query, nil
+}
+
+// parseAmount returns nil for an empty value.
+func parseAmount(value string, currency string) (*model.Money, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := model.ParseMoney(value, currency)
+	if err != nil {
+		return nil, ErrInvalidAmount
+	}
+
+	return &amount, nil
+}
@@ -0,0 +1,316 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/transaction"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/transaction"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/transaction"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupTransactionHandler(t *testing.T) (*gomock.Controller, *handler.TransactionHandler, *mock_service.MockITransactionService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockITransactionService(ctrl)
+	transactionHandler := handler.NewTransactionHandler(mockService)
+
+	return ctrl, transactionHandler, mockService
+}
+
+func newTransactionRequest() model.TransactionRequest {
+	return model.TransactionRequest{
+		Wallet_ID:        "wallet-1",
+		Category_ID:      "cat-1",
+		Type:             model.TransactionTypeExpense,
+		Amount:           model.MoneyRequest{Amount: "25000.00", Currency: "IDR"},
+		Description:      "Lunch",
+		Transaction_Date: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGetUserTransactions_Handler(t *testing.T) {
+	ctrl, transactionHandler, mockService := SetupTransactionHandler(t)
+	defer ctrl.Finish()
+
+	location := utils.GetCurrentTime().Location()
+	min := model.NewMoney(1050, "USD")
+	filtered := model.TransactionQuery{
+		ListQuery:   model.ListQuery{Search: "lunch", Sort: "amount", Limit: 10},
+		From:        time.Date(2024, time.January, 1, 0, 0, 0, 0, location),
+		To:          time.Date(2024, time.February, 1, 0, 0, 0, 0, location),
+		Wallet_ID:   "wallet-1",
+		Category_ID: "cat-1",
+		Min_Amount:  &min,
+	}
+
+	mockService.EXPECT().GetUserTransactions(gomock.Any(), "user-1", model.TransactionQuery{}).Return(model.Page[model.Transaction]{Items: []model.Transaction{{ID: "trx-1", User_ID: "user-1"}}}, nil)
+	mockService.EXPECT().GetUserTransactions(gomock.Any(), "user-1", filtered).Return(model.Page[model.Transaction]{Items: []model.Transaction{}}, nil)
+	mockService.EXPECT().GetUserTransactions(gomock.Any(), "invalid_id", model.TransactionQuery{}).Return(model.Page[model.Transaction]{}, service.ErrUserNotFound)
+
+	t.Run("Get User Transactions", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/transactions", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.GetUserTransactions(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var transactions model.Page[model.Transaction]
+		json.Unmarshal(recorder.Body.Bytes(), &transactions)
+
+		assert.Len(t, transactions.Items, 1, "Expected one transaction returned")
+	})
+
+	t.Run("Get User Transactions with filters", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/transactions?from=2024-01-01&to=2024-01-31&wallet_id=wallet-1&category_id=cat-1&min_amount=10.50&currency=USD&search=lunch&sort=amount&limit=10", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.GetUserTransactions(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+		assert.JSONEq(t, `{"items":[]}`, recorder.Body.String())
+	})
+
+	invalid := []struct {
+		name  string
+		query string
+	}{
+		{"date", "from=01-01-2024"},
+		{"currency", "currency=XXXX"},
+		{"amount", "max_amount=ten"},
+		{"limit", "limit=many"},
+	}
+
+	for _, tt := range invalid {
+		t.Run("Get User Transactions with invalid "+tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/users/user-1/transactions?"+tt.query, nil)
+			recorder := httptest.NewRecorder()
+
+			transactionHandler.GetUserTransactions(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+		})
+	}
+
+	t.Run("Get User Transactions with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/invalid_id/transactions", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.GetUserTransactions(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestGetTransaction_Handler(t *testing.T) {
+	ctrl, transactionHandler, mockService := SetupTransactionHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransaction(gomock.Any(), "trx-1").Return(model.Transaction{ID: "trx-1", User_ID: "user-1", Description: "Lunch"}, nil)
+	mockService.EXPECT().GetTransaction(gomock.Any(), "invalid_id").Return(model.Transaction{}, service.ErrTransactionNotFound)
+
+	t.Run("Get Transaction", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/transactions/trx-1", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.GetTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trx-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var transaction model.Transaction
+		json.Unmarshal(recorder.Body.Bytes(), &transaction)
+
+		assert.Equal(t, "Lunch", transaction.Description)
+	})
+
+	t.Run("Get Transaction with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/transactions/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.GetTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestCreateTransaction_Handler(t *testing.T) {
+	ctrl, transactionHandler, mockService := SetupTransactionHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().CreateTransaction(gomock.Any(), "user-1", newTransactionRequest()).Return(nil)
+	mockService.EXPECT().CreateTransaction(gomock.Any(), "user-1", newTransactionRequest()).Return(service.ErrWalletNotFound)
+
+	t.Run("Create Transaction", func(t *testing.T) {
+		body, _ := json.Marshal(newTransactionRequest())
+		req, _ := http.NewRequest("POST", "/users/user-1/transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.CreateTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
+	})
+
+	t.Run("Create Transaction with unknown wallet", func(t *testing.T) {
+		body, _ := json.Marshal(newTransactionRequest())
+		req, _ := http.NewRequest("POST", "/users/user-1/transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.CreateTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Create Transaction with invalid amount", func(t *testing.T) {
+		body := []byte(`{"wallet_id":"wallet-1","category_id":"cat-1","type":"expense","amount":{"amount":"12.345","currency":"IDR"},"transaction_date":"2024-01-15T12:00:00Z"}`)
+		req, _ := http.NewRequest("POST", "/users/user-1/transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.CreateTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Create Transaction with invalid type", func(t *testing.T) {
+		transaction := newTransactionRequest()
+		transaction.Type = "refund"
+
+		body, _ := json.Marshal(transaction)
+		req, _ := http.NewRequest("POST", "/users/user-1/transactions", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.CreateTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestUpdateTransaction_Handler(t *testing.T) {
+	ctrl, transactionHandler, mockService := SetupTransactionHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransaction(gomock.Any(), "trx-1").Return(model.Transaction{ID: "trx-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().UpdateTransaction(gomock.Any(), "trx-1", newTransactionRequest()).Return(nil)
+	mockService.EXPECT().GetTransaction(gomock.Any(), "invalid_id").Return(model.Transaction{}, service.ErrTransactionNotFound)
+
+	t.Run("Update Transaction", func(t *testing.T) {
+		body, _ := json.Marshal(newTransactionRequest())
+		req, _ := http.NewRequest("PUT", "/transactions/trx-1", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.UpdateTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trx-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Update Transaction with invalid id", func(t *testing.T) {
+		body, _ := json.Marshal(newTransactionRequest())
+		req, _ := http.NewRequest("PUT", "/transactions/invalid_id", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.UpdateTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestDeleteTransaction_Handler(t *testing.T) {
+	ctrl, transactionHandler, mockService := SetupTransactionHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransaction(gomock.Any(), "trx-1").Return(model.Transaction{ID: "trx-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().DeleteTransaction(gomock.Any(), "trx-1").Return(nil)
+	mockService.EXPECT().GetTransaction(gomock.Any(), "invalid_id").Return(model.Transaction{}, service.ErrTransactionNotFound)
+
+	t.Run("Delete Transaction", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/transactions/trx-1", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.DeleteTransaction(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trx-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Delete Transaction with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/transactions/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.DeleteTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestAccessAnotherUsersTransaction_Handler(t *testing.T) {
+	ctrl, transactionHandler, mockService := SetupTransactionHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransaction(gomock.Any(), "trx-2").Return(model.Transaction{ID: "trx-2", User_ID: "user-2"}, nil).Times(4)
+
+	body, _ := json.Marshal(newTransactionRequest())
+
+	items := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"Get another user's transaction", transactionHandler.GetTransaction},
+		{"Update another user's transaction", transactionHandler.UpdateTransaction},
+		{"Delete another user's transaction", transactionHandler.DeleteTransaction},
+	}
+
+	for _, tt := range items {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/transactions/trx-2", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trx-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	lists := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"List another user's transactions", transactionHandler.GetUserTransactions},
+		{"Create transaction for another user", transactionHandler.CreateTransaction},
+	}
+
+	for _, tt := range lists {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/users/user-2/transactions", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	t.Run("Get another user's transaction as admin", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/transactions/trx-2", nil)
+		recorder := httptest.NewRecorder()
+
+		transactionHandler.GetTransaction(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "trx-2"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/transfer"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type TransferHandler struct {
+	service service.ITransferService
+}
+
+func NewTransferHandler(transferService service.ITransferService) *TransferHandler {
+	return &TransferHandler{service: transferService}
+}
+
+func (h *TransferHandler) GetUserTransfers(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	transfers, err := h.service.GetUserTransfers(r.Context(), userID)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(transfers)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *TransferHandler) GetTransfer(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	transfer, err := h.authorizeTransfer(r, id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(transfer)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *TransferHandler) CreateTransfer(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	transfer := model.TransferRequest{}
+
+	err := utils.DecodeJSON(r, &transfer)
+	if err == nil {
+		err = h.service.CreateTransfer(r.Context(), userID, transfer)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("Transfer created"))
+}
+
+func (h *TransferHandler) UpdateTransfer(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+	transfer := model.TransferRequest{}
+
+	err := utils.DecodeJSON(r, &transfer)
+	if err == nil {
+		_, err = h.authorizeTransfer(r, id)
+	}
+	if err == nil {
+		err = h.service.UpdateTransfer(r.Context(), id, transfer)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Transfer updated"))
+}
+
+func (h *TransferHandler) DeleteTransfer(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	_, err := h.authorizeTransfer(r, id)
+	if err == nil {
+		err = h.service.DeleteTransfer(r.Context(), id)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Transfer deleted"))
+}
+
+func (h *TransferHandler) authorizeTransfer(r *http.Request, id string) (model.Transfer, error) {
+	return utils.Authorize(r.Context(), h.service.GetTransfer, id, func(transfer model.Transfer) string { return transfer.User_ID }, service.ErrTransferNotFound)
+}
@@ -0,0 +1,231 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/transfer"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/transfer"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/transfer"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupTransferHandler(t *testing.T) (*gomock.Controller, *handler.TransferHandler, *mock_service.MockITransferService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockITransferService(ctrl)
+	transferHandler := handler.NewTransferHandler(mockService)
+
+	return ctrl, transferHandler, mockService
+}
+
+func newTransferRequest() model.TransferRequest {
+	return model.TransferRequest{
+		From_Wallet_ID: "wallet-bank",
+		To_Wallet_ID:   "wallet-cash",
+		Amount:         model.MoneyRequest{Amount: "500000.00", Currency: "IDR"},
+		Description:    "ATM withdrawal",
+		Transfer_Date:  time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGetTransfer_Handler(t *testing.T) {
+	ctrl, transferHandler, mockService := SetupTransferHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransfer(gomock.Any(), "trf-1").Return(model.Transfer{ID: "trf-1", User_ID: "user-1", Description: "ATM withdrawal"}, nil)
+	mockService.EXPECT().GetTransfer(gomock.Any(), "invalid_id").Return(model.Transfer{}, service.ErrTransferNotFound)
+
+	t.Run("Get Transfer", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/transfers/trf-1", nil)
+		recorder := httptest.NewRecorder()
+
+		transferHandler.GetTransfer(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trf-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var transfer model.Transfer
+		json.Unmarshal(recorder.Body.Bytes(), &transfer)
+
+		assert.Equal(t, "ATM withdrawal", transfer.Description)
+	})
+
+	t.Run("Get Transfer with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/transfers/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		transferHandler.GetTransfer(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestCreateTransfer_Handler(t *testing.T) {
+	ctrl, transferHandler, mockService := SetupTransferHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().CreateTransfer(gomock.Any(), "user-1", newTransferRequest()).Return(nil)
+	mockService.EXPECT().CreateTransfer(gomock.Any(), "user-1", newTransferRequest()).Return(service.ErrWalletNotFound)
+
+	t.Run("Create Transfer", func(t *testing.T) {
+		body, _ := json.Marshal(newTransferRequest())
+		req, _ := http.NewRequest("POST", "/users/user-1/transfers", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transferHandler.CreateTransfer(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
+	})
+
+	t.Run("Create Transfer with unknown wallet", func(t *testing.T) {
+		body, _ := json.Marshal(newTransferRequest())
+		req, _ := http.NewRequest("POST", "/users/user-1/transfers", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transferHandler.CreateTransfer(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Create Transfer to the same wallet", func(t *testing.T) {
+		transfer := newTransferRequest()
+		transfer.To_Wallet_ID = transfer.From_Wallet_ID
+
+		body, _ := json.Marshal(transfer)
+		req, _ := http.NewRequest("POST", "/users/user-1/transfers", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transferHandler.CreateTransfer(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestUpdateTransfer_Handler(t *testing.T) {
+	ctrl, transferHandler, mockService := SetupTransferHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransfer(gomock.Any(), "trf-1").Return(model.Transfer{ID: "trf-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().UpdateTransfer(gomock.Any(), "trf-1", newTransferRequest()).Return(nil)
+	mockService.EXPECT().GetTransfer(gomock.Any(), "invalid_id").Return(model.Transfer{}, service.ErrTransferNotFound)
+
+	t.Run("Update Transfer", func(t *testing.T) {
+		body, _ := json.Marshal(newTransferRequest())
+		req, _ := http.NewRequest("PUT", "/transfers/trf-1", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transferHandler.UpdateTransfer(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trf-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Update Transfer with invalid id", func(t *testing.T) {
+		body, _ := json.Marshal(newTransferRequest())
+		req, _ := http.NewRequest("PUT", "/transfers/invalid_id", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		transferHandler.UpdateTransfer(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestDeleteTransfer_Handler(t *testing.T) {
+	ctrl, transferHandler, mockService := SetupTransferHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransfer(gomock.Any(), "trf-1").Return(model.Transfer{ID: "trf-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().DeleteTransfer(gomock.Any(), "trf-1").Return(nil)
+	mockService.EXPECT().GetTransfer(gomock.Any(), "invalid_id").Return(model.Transfer{}, service.ErrTransferNotFound)
+
+	t.Run("Delete Transfer", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/transfers/trf-1", nil)
+		recorder := httptest.NewRecorder()
+
+		transferHandler.DeleteTransfer(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trf-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Delete Transfer with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/transfers/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		transferHandler.DeleteTransfer(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestAccessAnotherUsersTransfer_Handler(t *testing.T) {
+	ctrl, transferHandler, mockService := SetupTransferHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetTransfer(gomock.Any(), "trf-2").Return(model.Transfer{ID: "trf-2", User_ID: "user-2"}, nil).Times(4)
+
+	body, _ := json.Marshal(newTransferRequest())
+
+	items := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"Get another user's transfer", transferHandler.GetTransfer},
+		{"Update another user's transfer", transferHandler.UpdateTransfer},
+		{"Delete another user's transfer", transferHandler.DeleteTransfer},
+	}
+
+	for _, tt := range items {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/transfers/trf-2", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "trf-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	lists := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"List another user's transfers", transferHandler.GetUserTransfers},
+		{"Create transfer for another user", transferHandler.CreateTransfer},
+	}
+
+	for _, tt := range lists {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/users/user-2/transfers", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	t.Run("Get another user's transfer as admin", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/transfers/trf-2", nil)
+		recorder := httptest.NewRecorder()
+
+		transferHandler.GetTransfer(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "trf-2"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+}
@@ -2,11 +2,8 @@ package handler
 
 import (
 	"encoding/json"
-	"errors"
-	"fmt"
 	"net/http"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/julienschmidt/httprouter"
 	"github.com/varomnrg/money-tracker/model"
 	service "github.com/varomnrg/money-tracker/service/user"
@@ -17,22 +14,33 @@ type UserHandler struct {
 	service service.IUserService
 }
 
-var validate *validator.Validate
+var ErrForbidden = model.NewError(model.CodeForbidden, "only admins can list all users")
 
 func NewUserHandler(userService service.IUserService) *UserHandler {
 	return &UserHandler{service: userService}
 }
 
 func (h *UserHandler) GetUsers(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
-	users, err := h.service.GetUsers()
+	if !utils.IsAdmin(r.Context()) {
+		utils.WriteError(w, ErrForbidden)
+		return
+	}
+
+	query, err := utils.ListQuery(r)
 	if err != nil {
-		http.Error(w, "Unable to get all users", http.StatusInternalServerError)
+		utils.WriteError(w, err)
+		return
+	}
+
+	users, err := h.service.GetUsers(r.Context(), query)
+	if err != nil {
+		utils.WriteError(w, err)
 		return
 	}
 
 	res, err := json.Marshal(users)
 	if err != nil {
-		http.Error(w, "", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -41,23 +49,22 @@ func (h *UserHandler) GetUsers(w http.ResponseWriter, r *http.Request, _ httprou
 }
 
 func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	id := ps.ByName("id")
-
-	user, err := h.service.GetUser(id)
-	if err != nil {
+	id := ps.ByName("userID")
 
-		if errors.Is(err, service.ErrUserNotFound) {
-			utils.JSONError(w, err, http.StatusNotFound)
-			return
-		}
+	if !utils.CanAccess(r.Context(), id) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
 
-		http.Error(w, "Unable to get user", http.StatusInternalServerError)
+	user, err := h.service.GetUser(r.Context(), id)
+	if err != nil {
+		utils.WriteError(w, err)
 		return
 	}
 
 	res, err := json.Marshal(user)
 	if err != nil {
-		http.Error(w, "", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -68,33 +75,12 @@ func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request, ps httprou
 func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	user := model.UserRequest{}
 
-	err := json.NewDecoder(r.Body).Decode(&user)
-	if err != nil {
-		http.Error(w, "Unable to decode user", http.StatusInternalServerError)
-		return
-	}
-
-	validate = validator.New()
-
-	err = validate.Struct(user)
-	if err != nil {
-		errs := utils.MapErrors(err, validate)
-
-		utils.JSONErrorMap(w, errs, http.StatusBadRequest)
-
-		return
+	err := utils.DecodeJSON(r, &user)
+	if err == nil {
+		err = h.service.CreateUser(r.Context(), user)
 	}
-
-	err = h.service.CreateUser(user)
 	if err != nil {
-		if errors.Is(err, service.ErrUsernameAlreadyExist) {
-			utils.JSONError(w, err, http.StatusBadRequest)
-			return
-		}
-
-		fmt.Println(err)
-
-		http.Error(w, "Unable to create user", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -103,34 +89,20 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request, ps http
 }
 
 func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	id := ps.ByName("id")
+	id := ps.ByName("userID")
 	user := model.UserRequest{}
 
-	err := json.NewDecoder(r.Body).Decode(&user)
-	if err != nil {
-		http.Error(w, "Unable to decode user", http.StatusInternalServerError)
+	if !utils.CanAccess(r.Context(), id) {
+		utils.WriteError(w, service.ErrUserNotFound)
 		return
 	}
 
-	validate = validator.New()
-
-	err = validate.Struct(user)
-	if err != nil {
-		errs := utils.MapErrors(err, validate)
-
-		utils.JSONErrorMap(w, errs, http.StatusBadRequest)
-
-		return
+	err := utils.DecodeJSON(r, &user)
+	if err == nil {
+		err = h.service.UpdateUser(r.Context(), id, user)
 	}
-
-	err = h.service.UpdateUser(id, user)
 	if err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			utils.JSONError(w, err, http.StatusNotFound)
-			return
-		}
-
-		http.Error(w, "Unable to update user", http.StatusInternalServerError)
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -139,16 +111,16 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request, ps http
 }
 
 func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	id := ps.ByName("id")
+	id := ps.ByName("userID")
 
-	err := h.service.DeleteUser(id)
-	if err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			utils.JSONError(w, err, http.StatusNotFound)
-			return
-		}
+	if !utils.CanAccess(r.Context(), id) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
 
-		http.Error(w, "Unable to delete user", http.StatusInternalServerError)
+	err := h.service.DeleteUser(r.Context(), id)
+	if err != nil {
+		utils.WriteError(w, err)
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,9 +15,19 @@ import (
 	mock_service "github.com/varomnrg/money-tracker/mocks/service/user"
 	"github.com/varomnrg/money-tracker/model"
 	service "github.com/varomnrg/money-tracker/service/user"
+	"github.com/varomnrg/money-tracker/utils"
 	"go.uber.org/mock/gomock"
 )
 
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
 func SetupUserHandler(t *testing.T) (*gomock.Controller, *handler.UserHandler, *mock_service.MockIUserService) {
 	ctrl := gomock.NewController(t)
 	mockService := mock_service.NewMockIUserService(ctrl)
@@ -29,27 +40,58 @@ func TestGetUsers_Handler(t *testing.T) {
 	ctrl, userHandler, mockService := SetupUserHandler(t)
 	defer ctrl.Finish()
 
-	mockService.EXPECT().GetUsers().Return(
-		[]model.UserResponse{
-			{ID: "user-11", Username: "user1", Email: "user1@example.com", Created_At: time.Now()},
-			{ID: "user-2", Username: "user2", Email: "user2@example.com", Created_At: time.Now()},
+	mockService.EXPECT().GetUsers(gomock.Any(), model.ListQuery{Search: "user", Sort: "-username", Limit: 2}).Return(
+		model.Page[model.UserResponse]{
+			Items: []model.UserResponse{
+				{ID: "user-11", Username: "user1", Email: "user1@example.com", Created_At: time.Now()},
+				{ID: "user-2", Username: "user2", Email: "user2@example.com", Created_At: time.Now()},
+			},
+			Next_Cursor: "next",
 		},
 		nil,
 	)
 
 	t.Run("Get Users", func(t *testing.T) {
-		req, _ := http.NewRequest("GET", "/users", nil)
+		req, _ := http.NewRequest("GET", "/users?search=user&sort=-username&limit=2", nil)
 
 		recorder := httptest.NewRecorder()
 
-		userHandler.GetUsers(recorder, req, nil)
+		userHandler.GetUsers(recorder, withAuthUser(req, admin), nil)
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 
-		var users []model.UserResponse
+		var users model.Page[model.UserResponse]
 		json.Unmarshal(recorder.Body.Bytes(), &users)
 
-		assert.Len(t, users, 2, "Expected one user returned")
-		assert.Equal(t, "user1", users[0].Username, "Expected user1 in response array at index 0")
+		assert.Len(t, users.Items, 2, "Expected two users returned")
+		assert.Equal(t, "user1", users.Items[0].Username, "Expected user1 in response array at index 0")
+		assert.Equal(t, "next", users.Next_Cursor)
+	})
+
+	t.Run("Get Users with invalid cursor", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users?cursor=not-a-cursor", nil)
+
+		recorder := httptest.NewRecorder()
+
+		userHandler.GetUsers(recorder, withAuthUser(req, admin), nil)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Get Users with invalid limit", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users?limit=-1", nil)
+
+		recorder := httptest.NewRecorder()
+
+		userHandler.GetUsers(recorder, withAuthUser(req, admin), nil)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Get Users as non-admin", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users", nil)
+
+		recorder := httptest.NewRecorder()
+
+		userHandler.GetUsers(recorder, withAuthUser(req, owner), nil)
+		assert.Equal(t, http.StatusForbidden, recorder.Code, "Expected status Forbidden")
 	})
 }
 
@@ -57,15 +99,15 @@ func TestGetUser_Handler(t *testing.T) {
 	ctrl, userHandler, mockService := SetupUserHandler(t)
 	defer ctrl.Finish()
 
-	mockService.EXPECT().GetUser("1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@example.com", Created_At: time.Now()}, nil)
-	mockService.EXPECT().GetUser("invalid_id").Return(model.UserResponse{}, service.ErrUserNotFound)
+	mockService.EXPECT().GetUser(gomock.Any(), "1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@example.com", Created_At: time.Now()}, nil)
+	mockService.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, service.ErrUserNotFound)
 
 	t.Run("Get User", func(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/users/user-1", nil)
 
 		recorder := httptest.NewRecorder()
 
-		userHandler.GetUser(recorder, req, []httprouter.Param{{Key: "id", Value: "1"}})
+		userHandler.GetUser(recorder, withAuthUser(req, model.AuthUser{ID: "1", Role: model.RoleUser}), []httprouter.Param{{Key: "userID", Value: "1"}})
 
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 
@@ -79,14 +121,14 @@ func TestGetUser_Handler(t *testing.T) {
 		req, _ := http.NewRequest("GET", "/users/invalid_id", nil)
 		recorder := httptest.NewRecorder()
 
-		userHandler.GetUser(recorder, req, []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+		userHandler.GetUser(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
 
 		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 
-		var errResponse model.ErrorResponse
-		json.Unmarshal(recorder.Body.Bytes(), &errResponse)
+		var problem model.Problem
+		json.Unmarshal(recorder.Body.Bytes(), &problem)
 
-		assert.Equal(t, "user cannot be found", errResponse.Error, "Expected user cannot be found")
+		assert.Equal(t, "user cannot be found", problem.Detail, "Expected user cannot be found")
 	})
 }
 
@@ -94,8 +136,8 @@ func TestCreateUser_Handler(t *testing.T) {
 	ctrl, userHandler, mockService := SetupUserHandler(t)
 	defer ctrl.Finish()
 
-	mockService.EXPECT().CreateUser(model.UserRequest{Username: "user1", Email: "user1@example.com", Password: "password"}).Return(nil)
-	mockService.EXPECT().CreateUser(model.UserRequest{Username: "existingusername", Email: "exist@example.com", Password: "password"}).Return(service.ErrUsernameAlreadyExist)
+	mockService.EXPECT().CreateUser(gomock.Any(), model.UserRequest{Username: "user1", Email: "user1@example.com", Password: "password"}).Return(nil)
+	mockService.EXPECT().CreateUser(gomock.Any(), model.UserRequest{Username: "existingusername", Email: "exist@example.com", Password: "password"}).Return(service.ErrUsernameAlreadyExist)
 
 	t.Run("Create User", func(t *testing.T) {
 		user := model.UserRequest{
@@ -127,22 +169,38 @@ func TestCreateUser_Handler(t *testing.T) {
 
 		userHandler.CreateUser(recorder, req, nil)
 
-		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+		assert.Equal(t, http.StatusConflict, recorder.Code, "Expected status Conflict")
 
-		var errResponse model.ErrorResponse
-		json.Unmarshal(recorder.Body.Bytes(), &errResponse)
+		var problem model.Problem
+		json.Unmarshal(recorder.Body.Bytes(), &problem)
 
-		assert.Equal(t, "username already exist", errResponse.Error, "Expected username already exist")
+		assert.Equal(t, "username already exist", problem.Detail, "Expected username already exist")
 	})
 
+	t.Run("Create User with too long password", func(t *testing.T) {
+		user := model.UserRequest{
+			Username: "user2",
+			Email:    "user2@example.com",
+			Password: strings.Repeat("p", 80),
+		}
+
+		userBytes, _ := json.Marshal(user)
+		req, _ := http.NewRequest("POST", "/users", bytes.NewReader(userBytes))
+
+		recorder := httptest.NewRecorder()
+
+		userHandler.CreateUser(recorder, req, nil)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
 }
 
 func TestUpdateUser_Handler(t *testing.T) {
 	ctrl, userHandler, mockService := SetupUserHandler(t)
 	defer ctrl.Finish()
 
-	mockService.EXPECT().UpdateUser("user-1", model.UserRequest{Username: "user1", Email: "user1@example.com", Password: "password"}).Return(nil)
-	mockService.EXPECT().UpdateUser("invalid_id", model.UserRequest{Username: "user1", Email: "user1@example.com", Password: "password"}).Return(service.ErrUserNotFound)
+	mockService.EXPECT().UpdateUser(gomock.Any(), "user-1", model.UserRequest{Username: "user1", Email: "user1@example.com", Password: "password"}).Return(nil)
+	mockService.EXPECT().UpdateUser(gomock.Any(), "invalid_id", model.UserRequest{Username: "user1", Email: "user1@example.com", Password: "password"}).Return(service.ErrUserNotFound)
 
 	t.Run("Update User", func(t *testing.T) {
 		user := model.UserRequest{
@@ -155,7 +213,7 @@ func TestUpdateUser_Handler(t *testing.T) {
 		req, _ := http.NewRequest("PUT", "/users/user-1", bytes.NewReader(userBytes))
 
 		recorder := httptest.NewRecorder()
-		userHandler.UpdateUser(recorder, req, []httprouter.Param{{Key: "id", Value: "user-1"}})
+		userHandler.UpdateUser(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
 
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 	})
@@ -171,14 +229,14 @@ func TestUpdateUser_Handler(t *testing.T) {
 		req, _ := http.NewRequest("PUT", "/users/invalid_id", bytes.NewReader(userBytes))
 
 		recorder := httptest.NewRecorder()
-		userHandler.UpdateUser(recorder, req, []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+		userHandler.UpdateUser(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
 
 		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 
-		var errResponse model.ErrorResponse
-		json.Unmarshal(recorder.Body.Bytes(), &errResponse)
+		var problem model.Problem
+		json.Unmarshal(recorder.Body.Bytes(), &problem)
 
-		assert.Equal(t, "user cannot be found", errResponse.Error, "Expected user cannot be found")
+		assert.Equal(t, "user cannot be found", problem.Detail, "Expected user cannot be found")
 	})
 }
 
@@ -186,15 +244,15 @@ func TestDeleteUser_Handler(t *testing.T) {
 	ctrl, userHandler, mockService := SetupUserHandler(t)
 	defer ctrl.Finish()
 
-	mockService.EXPECT().DeleteUser("user-1").Return(nil)
-	mockService.EXPECT().DeleteUser("invalid_id").Return(service.ErrUserNotFound)
+	mockService.EXPECT().DeleteUser(gomock.Any(), "user-1").Return(nil)
+	mockService.EXPECT().DeleteUser(gomock.Any(), "invalid_id").Return(service.ErrUserNotFound)
 
 	t.Run("Delete User", func(t *testing.T) {
 
 		req, _ := http.NewRequest("DELETE", "/users/user-1", nil)
 
 		recorder := httptest.NewRecorder()
-		userHandler.DeleteUser(recorder, req, []httprouter.Param{{Key: "id", Value: "user-1"}})
+		userHandler.DeleteUser(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
 
 		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
 	})
@@ -203,13 +261,42 @@ func TestDeleteUser_Handler(t *testing.T) {
 		req, _ := http.NewRequest("DELETE", "/users/invalid_id", nil)
 
 		recorder := httptest.NewRecorder()
-		userHandler.DeleteUser(recorder, req, []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+		userHandler.DeleteUser(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
 
 		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
 
-		var errResponse model.ErrorResponse
-		json.Unmarshal(recorder.Body.Bytes(), &errResponse)
+		var problem model.Problem
+		json.Unmarshal(recorder.Body.Bytes(), &problem)
 
-		assert.Equal(t, "user cannot be found", errResponse.Error, "Expected user cannot be found")
+		assert.Equal(t, "user cannot be found", problem.Detail, "Expected user cannot be found")
 	})
 }
+
+func TestAccessAnotherUser_Handler(t *testing.T) {
+	ctrl, userHandler, _ := SetupUserHandler(t)
+	defer ctrl.Finish()
+
+	params := []httprouter.Param{{Key: "userID", Value: "user-2"}}
+	body, _ := json.Marshal(model.UserRequest{Username: "user2", Email: "user2@example.com", Password: "password"})
+
+	tests := []struct {
+		name   string
+		method string
+		handle httprouter.Handle
+	}{
+		{"Get another user", "GET", userHandler.GetUser},
+		{"Update another user", "PUT", userHandler.UpdateUser},
+		{"Delete another user", "DELETE", userHandler.DeleteUser},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, "/users/user-2", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), params)
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/julienschmidt/httprouter"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/wallet"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type WalletHandler struct {
+	service service.IWalletService
+}
+
+var (
+	ErrInvalidCurrency = model.NewError(model.CodeValidation, "currency is not valid")
+	ErrInvalidDate     = model.NewError(model.CodeValidation, "date must be in YYYY-MM-DD format")
+)
+
+func NewWalletHandler(walletService service.IWalletService) *WalletHandler {
+	return &WalletHandler{service: walletService}
+}
+
+func (h *WalletHandler) GetUserWallets(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+
+	wallets, err := h.service.GetUserWallets(r.Context(), userID)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(wallets)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *WalletHandler) GetWallet(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	wallet, err := h.authorizeWallet(r, id)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(wallet)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *WalletHandler) CreateWallet(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+	wallet := model.WalletRequest{}
+
+	err := utils.DecodeJSON(r, &wallet)
+	if err == nil {
+		err = h.service.CreateWallet(r.Context(), userID, wallet)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte("Wallet created"))
+}
+
+func (h *WalletHandler) UpdateWallet(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+	wallet := model.WalletRequest{}
+
+	err := utils.DecodeJSON(r, &wallet)
+	if err == nil {
+		_, err = h.authorizeWallet(r, id)
+	}
+	if err == nil {
+		err = h.service.UpdateWallet(r.Context(), id, wallet)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Wallet updated"))
+}
+
+func (h *WalletHandler) DeleteWallet(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := ps.ByName("id")
+
+	_, err := h.authorizeWallet(r, id)
+	if err == nil {
+		err = h.service.DeleteWallet(r.Context(), id)
+	}
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Wallet deleted"))
+}
+
+// GetUserNetTotal reports the sum of the user's wallet balances in the
+// currency given by the "currency" query parameter (default
+// model.DefaultCurrency) at the rates in effect on "date" (YYYY-MM-DD,
+// default today).
+func (h *WalletHandler) GetUserNetTotal(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	userID := ps.ByName("userID")
+
+	if !utils.CanAccess(r.Context(), userID) {
+		utils.WriteError(w, service.ErrUserNotFound)
+		return
+	}
+	query := r.URL.Query()
+
+	currency := query.Get("currency")
+	if currency == "" {
+		currency = model.DefaultCurrency
+	}
+
+	if validator.New().Var(currency, "iso4217") != nil {
+		utils.WriteError(w, ErrInvalidCurrency)
+		return
+	}
+
+	date := utils.GetCurrentTime()
+	if query.Get("date") != "" {
+		var err error
+
+		date, err = time.Parse(time.DateOnly, query.Get("date"))
+		if err != nil {
+			utils.WriteError(w, ErrInvalidDate)
+			return
+		}
+	}
+
+	total, err := h.service.GetUserNetTotal(r.Context(), userID, currency, date)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	res, err := json.Marshal(total)
+	if err != nil {
+		utils.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(res)
+}
+
+func (h *WalletHandler) authorizeWallet(r *http.Request, id string) (model.Wallet, error) {
+	return utils.Authorize(r.Context(), h.service.GetWallet, id, func(wallet model.Wallet) string { return wallet.User_ID }, service.ErrWalletNotFound)
+}
@@ -0,0 +1,316 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	handler "github.com/varomnrg/money-tracker/handler/wallet"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/wallet"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/wallet"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	owner = model.AuthUser{ID: "user-1", Role: model.RoleUser}
+	admin = model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}
+)
+
+func withAuthUser(req *http.Request, user model.AuthUser) *http.Request {
+	return req.WithContext(utils.WithAuthUser(req.Context(), user))
+}
+
+func SetupWalletHandler(t *testing.T) (*gomock.Controller, *handler.WalletHandler, *mock_service.MockIWalletService) {
+	ctrl := gomock.NewController(t)
+	mockService := mock_service.NewMockIWalletService(ctrl)
+	walletHandler := handler.NewWalletHandler(mockService)
+
+	return ctrl, walletHandler, mockService
+}
+
+func TestGetUserWallets_Handler(t *testing.T) {
+	ctrl, walletHandler, mockService := SetupWalletHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetUserWallets(gomock.Any(), "user-1").Return(
+		[]model.Wallet{
+			{ID: "wallet-1", Name: "Cash", User_ID: "user-1"},
+			{ID: "wallet-2", Name: "Bank", User_ID: "user-1"},
+		},
+		nil,
+	)
+	mockService.EXPECT().GetUserWallets(gomock.Any(), "invalid_id").Return([]model.Wallet{}, service.ErrUserNotFound)
+
+	t.Run("Get User Wallets", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/wallets", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetUserWallets(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var wallets []model.Wallet
+		json.Unmarshal(recorder.Body.Bytes(), &wallets)
+
+		assert.Len(t, wallets, 2, "Expected two wallets returned")
+		assert.Equal(t, "Cash", wallets[0].Name, "Expected Cash in response array at index 0")
+	})
+
+	t.Run("Get User Wallets with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/invalid_id/wallets", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetUserWallets(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestGetWallet_Handler(t *testing.T) {
+	ctrl, walletHandler, mockService := SetupWalletHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", Name: "Cash", Balance: model.NewMoney(150000, "IDR"), User_ID: "user-1"}, nil)
+	mockService.EXPECT().GetWallet(gomock.Any(), "invalid_id").Return(model.Wallet{}, service.ErrWalletNotFound)
+
+	t.Run("Get Wallet", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/wallets/wallet-1", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetWallet(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "wallet-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+
+		var wallet model.Wallet
+		json.Unmarshal(recorder.Body.Bytes(), &wallet)
+
+		assert.Equal(t, "Cash", wallet.Name, "Expected Cash in response")
+		assert.Equal(t, model.NewMoney(150000, "IDR"), wallet.Balance, "Expected balance in response")
+	})
+
+	t.Run("Get Wallet with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/wallets/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetWallet(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestCreateWallet_Handler(t *testing.T) {
+	ctrl, walletHandler, mockService := SetupWalletHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().CreateWallet(gomock.Any(), "user-1", model.WalletRequest{Name: "Cash"}).Return(nil)
+	mockService.EXPECT().CreateWallet(gomock.Any(), "invalid_id", model.WalletRequest{Name: "Cash"}).Return(service.ErrUserNotFound)
+	mockService.EXPECT().CreateWallet(gomock.Any(), "user-1", model.WalletRequest{Name: "Cash"}).Return(service.ErrWalletAlreadyExist)
+
+	t.Run("Create Wallet", func(t *testing.T) {
+		body, _ := json.Marshal(model.WalletRequest{Name: "Cash"})
+		req, _ := http.NewRequest("POST", "/users/user-1/wallets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		walletHandler.CreateWallet(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusCreated, recorder.Code, "Expected status Created")
+	})
+
+	t.Run("Create Wallet with invalid user id", func(t *testing.T) {
+		body, _ := json.Marshal(model.WalletRequest{Name: "Cash"})
+		req, _ := http.NewRequest("POST", "/users/invalid_id/wallets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		walletHandler.CreateWallet(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "userID", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+
+	t.Run("Create Wallet with existing wallet", func(t *testing.T) {
+		body, _ := json.Marshal(model.WalletRequest{Name: "Cash"})
+		req, _ := http.NewRequest("POST", "/users/user-1/wallets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		walletHandler.CreateWallet(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusConflict, recorder.Code, "Expected status Conflict")
+	})
+
+	t.Run("Create Wallet with invalid body", func(t *testing.T) {
+		body, _ := json.Marshal(model.WalletRequest{Name: ""})
+		req, _ := http.NewRequest("POST", "/users/user-1/wallets", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		walletHandler.CreateWallet(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestUpdateWallet_Handler(t *testing.T) {
+	ctrl, walletHandler, mockService := SetupWalletHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().UpdateWallet(gomock.Any(), "wallet-1", model.WalletRequest{Name: "Pocket"}).Return(nil)
+	mockService.EXPECT().GetWallet(gomock.Any(), "invalid_id").Return(model.Wallet{}, service.ErrWalletNotFound)
+
+	t.Run("Update Wallet", func(t *testing.T) {
+		body, _ := json.Marshal(model.WalletRequest{Name: "Pocket"})
+		req, _ := http.NewRequest("PUT", "/wallets/wallet-1", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		walletHandler.UpdateWallet(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "wallet-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Update Wallet with invalid id", func(t *testing.T) {
+		body, _ := json.Marshal(model.WalletRequest{Name: "Pocket"})
+		req, _ := http.NewRequest("PUT", "/wallets/invalid_id", bytes.NewReader(body))
+		recorder := httptest.NewRecorder()
+
+		walletHandler.UpdateWallet(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestDeleteWallet_Handler(t *testing.T) {
+	ctrl, walletHandler, mockService := SetupWalletHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1"}, nil)
+	mockService.EXPECT().DeleteWallet(gomock.Any(), "wallet-1").Return(nil)
+	mockService.EXPECT().GetWallet(gomock.Any(), "invalid_id").Return(model.Wallet{}, service.ErrWalletNotFound)
+
+	t.Run("Delete Wallet", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/wallets/wallet-1", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.DeleteWallet(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "wallet-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+
+	t.Run("Delete Wallet with invalid id", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/wallets/invalid_id", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.DeleteWallet(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "invalid_id"}})
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+	})
+}
+
+func TestGetUserNetTotal_Handler(t *testing.T) {
+	ctrl, walletHandler, mockService := SetupWalletHandler(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	mockService.EXPECT().GetUserNetTotal(gomock.Any(), "user-1", "USD", date).Return(model.NewMoney(3500, "USD"), nil)
+	mockService.EXPECT().GetUserNetTotal(gomock.Any(), "user-1", "SGD", date).Return(model.Money{}, service.ErrRateNotFound)
+
+	t.Run("Get User Net Total", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/net-total?currency=USD&date=2024-01-31", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetUserNetTotal(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+		assert.JSONEq(t, `{"amount":"35.00","currency":"USD"}`, recorder.Body.String())
+	})
+
+	t.Run("Get User Net Total without exchange rate", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/net-total?currency=SGD&date=2024-01-31", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetUserNetTotal(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Get User Net Total with invalid currency", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/net-total?currency=XYZ", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetUserNetTotal(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+
+	t.Run("Get User Net Total with invalid date", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/user-1/net-total?currency=USD&date=31-01-2024", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetUserNetTotal(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-1"}})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, "Expected status Bad Request")
+	})
+}
+
+func TestAccessAnotherUsersWallet_Handler(t *testing.T) {
+	ctrl, walletHandler, mockService := SetupWalletHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().GetWallet(gomock.Any(), "wallet-2").Return(model.Wallet{ID: "wallet-2", User_ID: "user-2"}, nil).Times(4)
+
+	body, _ := json.Marshal(model.WalletRequest{Name: "Pocket"})
+
+	items := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"Get another user's wallet", walletHandler.GetWallet},
+		{"Update another user's wallet", walletHandler.UpdateWallet},
+		{"Delete another user's wallet", walletHandler.DeleteWallet},
+	}
+
+	for _, tt := range items {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/wallets/wallet-2", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "id", Value: "wallet-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	lists := []struct {
+		name   string
+		handle httprouter.Handle
+	}{
+		{"List another user's wallets", walletHandler.GetUserWallets},
+		{"Create wallet for another user", walletHandler.CreateWallet},
+		{"Get another user's net total", walletHandler.GetUserNetTotal},
+	}
+
+	for _, tt := range lists {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/users/user-2/wallets", bytes.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			tt.handle(recorder, withAuthUser(req, owner), []httprouter.Param{{Key: "userID", Value: "user-2"}})
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code, "Expected status Not Found")
+		})
+	}
+
+	t.Run("Get another user's wallet as admin", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/wallets/wallet-2", nil)
+		recorder := httptest.NewRecorder()
+
+		walletHandler.GetWallet(recorder, withAuthUser(req, admin), []httprouter.Param{{Key: "id", Value: "wallet-2"}})
+
+		assert.Equal(t, http.StatusOK, recorder.Code, "Expected status OK")
+	})
+}
@@ -0,0 +1,152 @@
+// Package migration applies the versioned SQL schema embedded in the binary
+// and records which versions a database is at in schema_migrations.
+package migration
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+//go:embed sql/postgres/*.sql sql/sqlite/*.sql
+var embedded embed.FS
+
+// Dialect names the database a set of migrations is written for. Each
+// dialect has its own directory under sql/ and its own version sequence.
+type Dialect string
+
+const (
+	Postgres Dialect = "postgres"
+	SQLite   Dialect = "sqlite"
+)
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migrations returns the migrations for dialect embedded in the binary,
+// oldest first.
+func Migrations(dialect Dialect) ([]Migration, error) {
+	sub, err := fs.Sub(embedded, path.Join("sql", string(dialect)))
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(sub)
+}
+
+// Parse reads NNNN_name.up.sql and NNNN_name.down.sql pairs from the root of
+// fsys. Every version needs both files and a single name.
+func Parse(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: invalid version", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(".", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+type Direction int
+
+const (
+	Up Direction = iota
+	Down
+)
+
+func (d Direction) String() string {
+	if d == Down {
+		return "down"
+	}
+	return "up"
+}
+
+type Step struct {
+	Migration Migration
+	Direction Direction
+}
+
+// Plan lists the steps that bring a database with the applied versions to
+// target: applied migrations above target are rolled back newest first, then
+// missing migrations up to target are applied oldest first.
+func Plan(migrations []Migration, applied map[int]bool, target int) []Step {
+	steps := []Step{}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		if migrations[i].Version > target && applied[migrations[i].Version] {
+			steps = append(steps, Step{Migration: migrations[i], Direction: Down})
+		}
+	}
+
+	for _, migration := range migrations {
+		if migration.Version <= target && !applied[migration.Version] {
+			steps = append(steps, Step{Migration: migration, Direction: Up})
+		}
+	}
+
+	return steps
+}
+
+// Latest returns the newest version in migrations, or 0 when there are none.
+func Latest(migrations []Migration) int {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
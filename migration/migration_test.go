@@ -0,0 +1,106 @@
+package migration_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/migration"
+)
+
+func TestMigrations_Embedded(t *testing.T) {
+	for _, dialect := range []migration.Dialect{migration.Postgres, migration.SQLite} {
+		t.Run(string(dialect), func(t *testing.T) {
+			migrations, err := migration.Migrations(dialect)
+
+			assert.NoError(t, err)
+			assert.NotEmpty(t, migrations)
+
+			for i, m := range migrations {
+				assert.Equal(t, i+1, m.Version, "versions must be sequential")
+				// Each step already runs in its own transaction.
+				for _, sql := range []string{m.Up, m.Down} {
+					assert.NotContains(t, strings.ToUpper(sql), "BEGIN;")
+					assert.NotContains(t, strings.ToUpper(sql), "COMMIT;")
+				}
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_wallets.up.sql":   {Data: []byte("CREATE TABLE wallets ();")},
+		"0002_wallets.down.sql": {Data: []byte("DROP TABLE wallets;")},
+		"0001_users.up.sql":     {Data: []byte("CREATE TABLE users ();")},
+		"0001_users.down.sql":   {Data: []byte("DROP TABLE users;")},
+		"README.md":             {Data: []byte("ignored")},
+	}
+
+	migrations, err := migration.Parse(fsys)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []migration.Migration{
+		{Version: 1, Name: "users", Up: "CREATE TABLE users ();", Down: "DROP TABLE users;"},
+		{Version: 2, Name: "wallets", Up: "CREATE TABLE wallets ();", Down: "DROP TABLE wallets;"},
+	}, migrations)
+}
+
+func TestParse_MissingDown(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_users.up.sql": {Data: []byte("CREATE TABLE users ();")},
+	}
+
+	_, err := migration.Parse(fsys)
+
+	assert.ErrorContains(t, err, "0001_users")
+}
+
+func TestParse_ConflictingNames(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_users.up.sql":    {Data: []byte("CREATE TABLE users ();")},
+		"0001_people.down.sql": {Data: []byte("DROP TABLE people;")},
+	}
+
+	_, err := migration.Parse(fsys)
+
+	assert.ErrorContains(t, err, "two names")
+}
+
+func TestPlan(t *testing.T) {
+	migrations := []migration.Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+
+	versions := func(steps []migration.Step) []string {
+		out := []string{}
+		for _, step := range steps {
+			out = append(out, step.Direction.String()+string(rune('0'+step.Migration.Version)))
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		applied map[int]bool
+		target  int
+		want    []string
+	}{
+		{"Fresh database", map[int]bool{}, 3, []string{"up1", "up2", "up3"}},
+		{"Up to date", map[int]bool{1: true, 2: true, 3: true}, 3, []string{}},
+		{"Partially applied", map[int]bool{1: true}, 3, []string{"up2", "up3"}},
+		{"Roll back newest first", map[int]bool{1: true, 2: true, 3: true}, 1, []string{"down3", "down2"}},
+		{"Roll back everything", map[int]bool{1: true, 2: true}, 0, []string{"down2", "down1"}},
+		{"Fill a gap", map[int]bool{1: true, 3: true}, 3, []string{"up2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, versions(migration.Plan(migrations, test.applied, test.target)))
+		})
+	}
+}
+
+func TestLatest(t *testing.T) {
+	assert.Equal(t, 0, migration.Latest(nil))
+	assert.Equal(t, 7, migration.Latest([]migration.Migration{{Version: 3}, {Version: 7}}))
+}
@@ -0,0 +1,325 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/varomnrg/money-tracker/utils"
+	"modernc.org/sqlite"
+)
+
+var ErrUnknownVersion = errors.New("unknown migration version")
+
+// lockID serialises migrations between processes, e.g. several instances
+// auto-migrating on startup at once.
+const lockID = 7_265_646_173
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []Migration
+}
+
+// State is a migration together with when it was applied, if it was.
+type State struct {
+	Migration  Migration
+	Applied_At *time.Time
+}
+
+// New returns a migrator for the embedded migrations of the dialect that
+// matches the driver of db.
+func New(db *sql.DB) (*Migrator, error) {
+	dialect := DialectOf(db)
+
+	migrations, err := Migrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithMigrations(db, dialect, migrations), nil
+}
+
+func NewWithMigrations(db *sql.DB, dialect Dialect, migrations []Migration) *Migrator {
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}
+}
+
+// DialectOf reports which dialect the driver of db speaks.
+func DialectOf(db *sql.DB) Dialect {
+	if _, ok := db.Driver().(*sqlite.Driver); ok {
+		return SQLite
+	}
+
+	return Postgres
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) ([]Step, error) {
+	return m.To(ctx, Latest(m.migrations))
+}
+
+// Down rolls back the most recently applied migration.
+func (m *Migrator) Down(ctx context.Context) ([]Step, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, previous := 0, 0
+	for _, migration := range m.migrations {
+		if applied[migration.Version] {
+			latest, previous = migration.Version, latest
+		}
+	}
+
+	if latest == 0 {
+		return []Step{}, nil
+	}
+
+	return m.To(ctx, previous)
+}
+
+// To migrates up or down until exactly the migrations up to version are
+// applied. Version 0 rolls back everything.
+func (m *Migrator) To(ctx context.Context, version int) ([]Step, error) {
+	if version != 0 && !m.known(version) {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	done := []Step{}
+
+	for _, step := range Plan(m.migrations, applied, version) {
+		err := m.apply(ctx, step)
+		if err != nil {
+			return done, fmt.Errorf("migration %d_%s %s: %w", step.Migration.Version, step.Migration.Name, step.Direction, err)
+		}
+		done = append(done, step)
+	}
+
+	return done, nil
+}
+
+// Force records the migrations up to version as applied, and later ones as
+// not applied, without running any SQL. It is meant for databases whose
+// schema was created by hand.
+func (m *Migrator) Force(ctx context.Context, version int) error {
+	if version != 0 && !m.known(version) {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	err := m.ensureTable(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = m.lock(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM schema_migrations")
+	if err != nil {
+		return err
+	}
+
+	now := utils.GetCurrentTime()
+
+	for _, migration := range m.migrations {
+		if migration.Version > version {
+			break
+		}
+
+		_, err = tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)", migration.Version, migration.Name, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Status lists every known migration, oldest first, followed by any applied
+// version this binary does not know about.
+func (m *Migrator) Status(ctx context.Context) ([]State, error) {
+	err := m.ensureTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, name, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	appliedAt := map[int]time.Time{}
+	names := map[int]string{}
+
+	for rows.Next() {
+		var version int
+		var name string
+		var at time.Time
+
+		err := rows.Scan(&version, &name, &at)
+		if err != nil {
+			return nil, err
+		}
+
+		appliedAt[version] = at
+		names[version] = name
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]State, 0, len(m.migrations))
+
+	for _, migration := range m.migrations {
+		state := State{Migration: migration}
+		if at, ok := appliedAt[migration.Version]; ok {
+			state.Applied_At = &at
+			delete(appliedAt, migration.Version)
+		}
+		states = append(states, state)
+	}
+
+	unknown := make([]int, 0, len(appliedAt))
+	for version := range appliedAt {
+		unknown = append(unknown, version)
+	}
+	sort.Ints(unknown)
+
+	for _, version := range unknown {
+		at := appliedAt[version]
+		states = append(states, State{Migration: Migration{Version: version, Name: names[version]}, Applied_At: &at})
+	}
+
+	return states, nil
+}
+
+func (m *Migrator) known(version int) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	timestamp := "TIMESTAMPTZ"
+	if m.dialect == SQLite {
+		timestamp = "TIMESTAMP"
+	}
+
+	_, err := m.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at `+timestamp+` NOT NULL
+)`)
+	return err
+}
+
+// lock makes tx wait for other processes migrating the same database. SQLite
+// has no advisory locks, but a write takes the database write lock, which
+// also lasts until the transaction ends.
+func (m *Migrator) lock(ctx context.Context, tx *sql.Tx) error {
+	if m.dialect == SQLite {
+		_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE FALSE")
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", lockID)
+	return err
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int]bool, error) {
+	err := m.ensureTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	applied := map[int]bool{}
+
+	for rows.Next() {
+		var version int
+		err := rows.Scan(&version)
+		if err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// apply runs one step and records it in a single transaction. Another
+// process may have run the same step since the plan was made, so the step is
+// skipped when the database already reflects it.
+func (m *Migrator) apply(ctx context.Context, step Step) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = m.lock(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	var applied bool
+
+	err = tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)", step.Migration.Version).Scan(&applied)
+	if err != nil {
+		return err
+	}
+
+	if applied == (step.Direction == Up) {
+		return nil
+	}
+
+	if step.Direction == Up {
+		_, err = tx.ExecContext(ctx, step.Migration.Up)
+		if err == nil {
+			_, err = tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)", step.Migration.Version, step.Migration.Name, utils.GetCurrentTime())
+		}
+	} else {
+		_, err = tx.ExecContext(ctx, step.Migration.Down)
+		if err == nil {
+			_, err = tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", step.Migration.Version)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,80 @@
+package migration_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/migration"
+	_ "modernc.org/sqlite"
+)
+
+var ctx = context.Background()
+
+// SetupSqlite opens an empty in-memory SQLite database with the settings
+// app.OpenDatabase uses. The pool keeps a single connection, as the database
+// lives only as long as it does.
+func SetupSqlite(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite", "file::memory:?_pragma=foreign_keys(1)&_time_format=sqlite")
+	require.NoError(t, err)
+
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func tableExists(t *testing.T, db *sql.DB, name string) bool {
+	var count int
+	err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = $1", name).Scan(&count)
+	require.NoError(t, err)
+
+	return count == 1
+}
+
+func TestMigrator_Sqlite(t *testing.T) {
+	db := SetupSqlite(t)
+
+	assert.Equal(t, migration.SQLite, migration.DialectOf(db))
+
+	migrator, err := migration.New(db)
+	require.NoError(t, err)
+
+	steps, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, steps)
+	assert.True(t, tableExists(t, db, "users"))
+
+	steps, err = migrator.Up(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, steps, "Expected nothing left to apply")
+
+	states, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	for _, state := range states {
+		assert.NotNil(t, state.Applied_At, "Expected %04d_%s to be applied", state.Migration.Version, state.Migration.Name)
+	}
+
+	_, err = migrator.To(ctx, 0)
+	require.NoError(t, err)
+	assert.False(t, tableExists(t, db, "users"))
+}
+
+func TestMigrator_SqliteForce(t *testing.T) {
+	db := SetupSqlite(t)
+
+	migrator, err := migration.New(db)
+	require.NoError(t, err)
+
+	require.NoError(t, migrator.Force(ctx, 1))
+
+	states, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, states[0].Applied_At)
+	assert.False(t, tableExists(t, db, "users"), "Expected force to record the version without running it")
+
+	_, err = migrator.To(ctx, 99)
+	assert.ErrorIs(t, err, migration.ErrUnknownVersion)
+}
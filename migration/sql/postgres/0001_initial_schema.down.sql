@@ -0,0 +1,4 @@
+DROP TABLE transactions;
+DROP TABLE wallets;
+DROP TABLE categories;
+DROP TABLE users;
@@ -0,0 +1,40 @@
+-- Creates the tables the application started with. Money columns are still
+-- DOUBLE PRECISION major units here; 0002 converts them to minor units.
+
+CREATE TABLE users (
+	id VARCHAR(50) PRIMARY KEY,
+	username VARCHAR(20) NOT NULL UNIQUE,
+	password VARCHAR(255) NOT NULL,
+	email VARCHAR(255) NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE categories (
+	id VARCHAR(50) PRIMARY KEY,
+	name VARCHAR(20) NOT NULL,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	UNIQUE (user_id, name)
+);
+
+CREATE TABLE wallets (
+	id VARCHAR(50) PRIMARY KEY,
+	name VARCHAR(20) NOT NULL,
+	balance DOUBLE PRECISION NOT NULL DEFAULT 0,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	UNIQUE (user_id, name)
+);
+
+CREATE TABLE transactions (
+	id VARCHAR(50) PRIMARY KEY,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	wallet_id VARCHAR(50) NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+	category_id VARCHAR(50) NOT NULL REFERENCES categories (id),
+	type VARCHAR(20) NOT NULL,
+	amount DOUBLE PRECISION NOT NULL,
+	description VARCHAR(255) NOT NULL DEFAULT '',
+	transaction_date TIMESTAMPTZ NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX transactions_user_id_idx ON transactions (user_id, transaction_date DESC);
+CREATE INDEX transactions_wallet_id_idx ON transactions (wallet_id);
@@ -0,0 +1,5 @@
+ALTER TABLE wallets
+	ALTER COLUMN balance TYPE DOUBLE PRECISION USING balance / 100.0;
+
+ALTER TABLE transactions
+	ALTER COLUMN amount TYPE DOUBLE PRECISION USING amount / 100.0;
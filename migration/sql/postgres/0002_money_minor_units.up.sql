@@ -0,0 +1,22 @@
+-- Converts money columns from floating point to integer minor units.
+--
+-- Wallet balances and transaction amounts used to be stored as DOUBLE
+-- PRECISION major units (e.g. 1250.5 rupiah). model.Money now expects BIGINT
+-- minor units (125050). Every existing row is in the default currency (IDR,
+-- two decimal places), so values are scaled by 100 and rounded once here.
+
+ALTER TABLE wallets
+	ALTER COLUMN balance TYPE BIGINT USING ROUND(balance * 100)::BIGINT,
+	ALTER COLUMN balance SET DEFAULT 0;
+
+ALTER TABLE transactions
+	ALTER COLUMN amount TYPE BIGINT USING ROUND(amount * 100)::BIGINT;
+
+-- Rebuild balances from the ledger so rounding in the old float sums cannot
+-- leave them out of step with their transactions.
+UPDATE wallets w
+SET balance = COALESCE((
+	SELECT SUM(CASE WHEN t.type = 'expense' THEN -t.amount ELSE t.amount END)
+	FROM transactions t
+	WHERE t.wallet_id = w.id
+), 0);
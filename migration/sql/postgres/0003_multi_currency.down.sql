@@ -0,0 +1,12 @@
+-- Amounts are left in whatever currency they were recorded in, so rolling
+-- back is only lossless while every wallet is still in IDR.
+
+DROP TABLE exchange_rates;
+
+ALTER TABLE transactions
+	DROP COLUMN original_currency,
+	DROP COLUMN original_amount,
+	DROP COLUMN currency;
+
+ALTER TABLE wallets
+	DROP COLUMN currency;
@@ -0,0 +1,24 @@
+-- Adds per-wallet currencies, original transaction amounts and the exchange
+-- rate table. Existing wallets and transactions are assumed to be in IDR.
+
+ALTER TABLE wallets
+	ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE transactions
+	ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'IDR',
+	ADD COLUMN original_amount BIGINT,
+	ADD COLUMN original_currency CHAR(3) NOT NULL DEFAULT 'IDR';
+
+UPDATE transactions SET original_amount = amount;
+
+ALTER TABLE transactions
+	ALTER COLUMN original_amount SET NOT NULL;
+
+CREATE TABLE exchange_rates (
+	id VARCHAR(50) PRIMARY KEY,
+	base CHAR(3) NOT NULL,
+	quote CHAR(3) NOT NULL,
+	rate NUMERIC(24, 12) NOT NULL CHECK (rate > 0),
+	date DATE NOT NULL,
+	UNIQUE (base, quote, date)
+);
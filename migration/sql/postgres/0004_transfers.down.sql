@@ -0,0 +1,17 @@
+-- Removes the transfer legs with their transfers and rebuilds wallet
+-- balances from the remaining transactions.
+
+DELETE FROM transactions WHERE transfer_id IS NOT NULL;
+
+ALTER TABLE transactions
+	DROP COLUMN transfer_id,
+	ALTER COLUMN category_id SET NOT NULL;
+
+DROP TABLE transfers;
+
+UPDATE wallets w
+SET balance = COALESCE((
+	SELECT SUM(CASE WHEN t.type = 'expense' THEN -t.amount ELSE t.amount END)
+	FROM transactions t
+	WHERE t.wallet_id = w.id
+), 0);
@@ -0,0 +1,21 @@
+-- Adds transfers between wallets. A transfer owns two transactions (its
+-- legs), which have no category and point back at it through transfer_id.
+
+CREATE TABLE transfers (
+	id VARCHAR(50) PRIMARY KEY,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	from_wallet_id VARCHAR(50) NOT NULL REFERENCES wallets (id),
+	to_wallet_id VARCHAR(50) NOT NULL REFERENCES wallets (id),
+	amount BIGINT NOT NULL CHECK (amount > 0),
+	currency CHAR(3) NOT NULL,
+	to_amount BIGINT NOT NULL CHECK (to_amount > 0),
+	to_currency CHAR(3) NOT NULL,
+	description VARCHAR(255) NOT NULL DEFAULT '',
+	transfer_date TIMESTAMPTZ NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL,
+	CHECK (from_wallet_id <> to_wallet_id)
+);
+
+ALTER TABLE transactions
+	ALTER COLUMN category_id DROP NOT NULL,
+	ADD COLUMN transfer_id VARCHAR(50) REFERENCES transfers (id);
@@ -0,0 +1,7 @@
+-- Adds a role to users. Every existing user becomes a regular user; promote
+-- admins by hand:
+--
+--	UPDATE users SET role = 'admin' WHERE username = '<username>';
+
+ALTER TABLE users
+	ADD COLUMN role VARCHAR(10) NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin'));
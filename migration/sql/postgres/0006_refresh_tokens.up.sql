@@ -0,0 +1,18 @@
+-- Adds refresh tokens. Each login starts a session; every refresh replaces
+-- the session's current token with a new one, and presenting a used token
+-- again revokes the whole session.
+
+CREATE TABLE refresh_tokens (
+	id VARCHAR(50) PRIMARY KEY,
+	session_id VARCHAR(50) NOT NULL,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	token_hash CHAR(64) NOT NULL UNIQUE,
+	user_agent VARCHAR(255) NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL,
+	used_at TIMESTAMPTZ,
+	revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX refresh_tokens_session_id_idx ON refresh_tokens (session_id);
+CREATE INDEX refresh_tokens_user_id_idx ON refresh_tokens (user_id);
@@ -0,0 +1,15 @@
+-- Adds personal API keys. Only the SHA-256 hash of a key is stored; prefix
+-- keeps its first characters so users can tell their keys apart.
+
+CREATE TABLE api_keys (
+	id VARCHAR(50) PRIMARY KEY,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	name VARCHAR(50) NOT NULL,
+	scope VARCHAR(10) NOT NULL CHECK (scope IN ('read', 'read_write')),
+	prefix VARCHAR(20) NOT NULL,
+	key_hash CHAR(64) NOT NULL UNIQUE,
+	created_at TIMESTAMPTZ NOT NULL,
+	last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX api_keys_user_id_idx ON api_keys (user_id);
@@ -0,0 +1,16 @@
+-- Adds monthly budgets. A category has at most one budget per month, kept as
+-- YYYY-MM text so months compare and sort as strings.
+
+CREATE TABLE budgets (
+	id VARCHAR(50) PRIMARY KEY,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	category_id VARCHAR(50) NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+	month CHAR(7) NOT NULL,
+	amount BIGINT NOT NULL CHECK (amount > 0),
+	currency CHAR(3) NOT NULL,
+	rollover BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMPTZ NOT NULL,
+	UNIQUE (category_id, month)
+);
+
+CREATE INDEX budgets_user_id_idx ON budgets (user_id, month);
@@ -0,0 +1,16 @@
+-- Adds the notification inbox. event_key identifies the event a notification
+-- reports, so that each event is notified to a user at most once.
+
+CREATE TABLE notifications (
+	id VARCHAR(50) PRIMARY KEY,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	kind VARCHAR(50) NOT NULL,
+	event_key VARCHAR(200) NOT NULL,
+	title VARCHAR(200) NOT NULL,
+	message TEXT NOT NULL,
+	read_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL,
+	UNIQUE (user_id, event_key)
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, created_at);
@@ -0,0 +1,28 @@
+-- Adds recurring transaction templates. weekdays holds the comma-separated
+-- weekdays of a weekly schedule. next_date is NULL once the schedule has
+-- ended; the scheduler looks up the templates due by it.
+
+CREATE TABLE recurring_transactions (
+	id VARCHAR(50) PRIMARY KEY,
+	user_id VARCHAR(50) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	wallet_id VARCHAR(50) NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+	category_id VARCHAR(50) NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+	type VARCHAR(20) NOT NULL,
+	amount BIGINT NOT NULL CHECK (amount > 0),
+	currency CHAR(3) NOT NULL,
+	description VARCHAR(255) NOT NULL DEFAULT '',
+	frequency VARCHAR(10) NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+	interval_count INTEGER NOT NULL DEFAULT 1 CHECK (interval_count > 0),
+	weekdays VARCHAR(20) NOT NULL DEFAULT '',
+	start_date TIMESTAMPTZ NOT NULL,
+	until TIMESTAMPTZ,
+	count INTEGER NOT NULL DEFAULT 0 CHECK (count >= 0),
+	paused BOOLEAN NOT NULL DEFAULT FALSE,
+	next_date TIMESTAMPTZ,
+	last_date TIMESTAMPTZ,
+	occurrences INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX recurring_transactions_user_id_idx ON recurring_transactions (user_id);
+CREATE INDEX recurring_transactions_next_date_idx ON recurring_transactions (next_date) WHERE NOT paused;
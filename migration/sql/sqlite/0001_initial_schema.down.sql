@@ -0,0 +1,8 @@
+DROP TABLE api_keys;
+DROP TABLE refresh_tokens;
+DROP TABLE exchange_rates;
+DROP TABLE transactions;
+DROP TABLE transfers;
+DROP TABLE wallets;
+DROP TABLE categories;
+DROP TABLE users;
@@ -0,0 +1,100 @@
+-- Creates the whole schema at once. SQLite support started after the
+-- PostgreSQL schema had reached 0007_api_keys, so this matches the result of
+-- running all seven PostgreSQL migrations. Timestamps are stored as UTC text,
+-- which keeps comparing and ordering them as strings correct.
+
+CREATE TABLE users (
+	id TEXT PRIMARY KEY,
+	username TEXT NOT NULL UNIQUE,
+	password TEXT NOT NULL,
+	email TEXT NOT NULL,
+	role TEXT NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin')),
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE categories (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	UNIQUE (user_id, name)
+);
+
+CREATE TABLE wallets (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	currency TEXT NOT NULL DEFAULT 'IDR',
+	balance INTEGER NOT NULL DEFAULT 0,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	UNIQUE (user_id, name)
+);
+
+CREATE TABLE transfers (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	from_wallet_id TEXT NOT NULL REFERENCES wallets (id),
+	to_wallet_id TEXT NOT NULL REFERENCES wallets (id),
+	amount INTEGER NOT NULL CHECK (amount > 0),
+	currency TEXT NOT NULL,
+	to_amount INTEGER NOT NULL CHECK (to_amount > 0),
+	to_currency TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	transfer_date TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CHECK (from_wallet_id <> to_wallet_id)
+);
+
+CREATE TABLE transactions (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	wallet_id TEXT NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+	category_id TEXT REFERENCES categories (id),
+	type TEXT NOT NULL,
+	amount INTEGER NOT NULL,
+	currency TEXT NOT NULL DEFAULT 'IDR',
+	original_amount INTEGER NOT NULL,
+	original_currency TEXT NOT NULL DEFAULT 'IDR',
+	description TEXT NOT NULL DEFAULT '',
+	transaction_date TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	transfer_id TEXT REFERENCES transfers (id)
+);
+
+CREATE INDEX transactions_user_id_idx ON transactions (user_id, transaction_date DESC);
+CREATE INDEX transactions_wallet_id_idx ON transactions (wallet_id);
+
+CREATE TABLE exchange_rates (
+	id TEXT PRIMARY KEY,
+	base TEXT NOT NULL,
+	quote TEXT NOT NULL,
+	rate REAL NOT NULL CHECK (rate > 0),
+	date DATE NOT NULL,
+	UNIQUE (base, quote, date)
+);
+
+CREATE TABLE refresh_tokens (
+	id TEXT PRIMARY KEY,
+	session_id TEXT NOT NULL,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	token_hash TEXT NOT NULL UNIQUE,
+	user_agent TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	used_at TIMESTAMP,
+	revoked_at TIMESTAMP
+);
+
+CREATE INDEX refresh_tokens_session_id_idx ON refresh_tokens (session_id);
+CREATE INDEX refresh_tokens_user_id_idx ON refresh_tokens (user_id);
+
+CREATE TABLE api_keys (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	scope TEXT NOT NULL CHECK (scope IN ('read', 'read_write')),
+	prefix TEXT NOT NULL,
+	key_hash TEXT NOT NULL UNIQUE,
+	created_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP
+);
+
+CREATE INDEX api_keys_user_id_idx ON api_keys (user_id);
@@ -0,0 +1,15 @@
+-- Adds monthly budgets, as PostgreSQL migration 0008_budgets does.
+
+CREATE TABLE budgets (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	category_id TEXT NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+	month TEXT NOT NULL,
+	amount INTEGER NOT NULL CHECK (amount > 0),
+	currency TEXT NOT NULL,
+	rollover INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMP NOT NULL,
+	UNIQUE (category_id, month)
+);
+
+CREATE INDEX budgets_user_id_idx ON budgets (user_id, month);
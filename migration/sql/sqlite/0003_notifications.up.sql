@@ -0,0 +1,16 @@
+-- Adds the notification inbox, as PostgreSQL migration 0009_notifications
+-- does.
+
+CREATE TABLE notifications (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	kind TEXT NOT NULL,
+	event_key TEXT NOT NULL,
+	title TEXT NOT NULL,
+	message TEXT NOT NULL,
+	read_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL,
+	UNIQUE (user_id, event_key)
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, created_at);
@@ -0,0 +1,27 @@
+-- Adds recurring transaction templates, as PostgreSQL migration
+-- 0010_recurring_transactions does.
+
+CREATE TABLE recurring_transactions (
+	id TEXT PRIMARY KEY,
+	user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	wallet_id TEXT NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+	category_id TEXT NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+	type TEXT NOT NULL,
+	amount INTEGER NOT NULL CHECK (amount > 0),
+	currency TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+	interval_count INTEGER NOT NULL DEFAULT 1 CHECK (interval_count > 0),
+	weekdays TEXT NOT NULL DEFAULT '',
+	start_date TIMESTAMP NOT NULL,
+	until TIMESTAMP,
+	count INTEGER NOT NULL DEFAULT 0 CHECK (count >= 0),
+	paused INTEGER NOT NULL DEFAULT 0,
+	next_date TIMESTAMP,
+	last_date TIMESTAMP,
+	occurrences INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX recurring_transactions_user_id_idx ON recurring_transactions (user_id);
+CREATE INDEX recurring_transactions_next_date_idx ON recurring_transactions (next_date) WHERE NOT paused;
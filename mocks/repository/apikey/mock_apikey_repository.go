@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/apikey/apikey_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/apikey/apikey_repository_interface.go -destination mocks/repository/apikey/mock_apikey_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAPIKeyRepository is a mock of IAPIKeyRepository interface.
+type MockIAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAPIKeyRepositoryMockRecorder
+}
+
+// MockIAPIKeyRepositoryMockRecorder is the mock recorder for MockIAPIKeyRepository.
+type MockIAPIKeyRepositoryMockRecorder struct {
+	mock *MockIAPIKeyRepository
+}
+
+// NewMockIAPIKeyRepository creates a new mock instance.
+func NewMockIAPIKeyRepository(ctrl *gomock.Controller) *MockIAPIKeyRepository {
+	mock := &MockIAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAPIKeyRepository) EXPECT() *MockIAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockIAPIKeyRepository) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockIAPIKeyRepositoryMockRecorder) CreateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockIAPIKeyRepository)(nil).CreateAPIKey), ctx, key)
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockIAPIKeyRepository) DeleteAPIKey(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockIAPIKeyRepositoryMockRecorder) DeleteAPIKey(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockIAPIKeyRepository)(nil).DeleteAPIKey), ctx, userID, id)
+}
+
+// GetAPIKeyByHash mocks base method.
+func (m *MockIAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyByHash", ctx, hash)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyByHash indicates an expected call of GetAPIKeyByHash.
+func (mr *MockIAPIKeyRepositoryMockRecorder) GetAPIKeyByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyByHash", reflect.TypeOf((*MockIAPIKeyRepository)(nil).GetAPIKeyByHash), ctx, hash)
+}
+
+// GetUserAPIKeys mocks base method.
+func (m *MockIAPIKeyRepository) GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAPIKeys indicates an expected call of GetUserAPIKeys.
+func (mr *MockIAPIKeyRepositoryMockRecorder) GetUserAPIKeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAPIKeys", reflect.TypeOf((*MockIAPIKeyRepository)(nil).GetUserAPIKeys), ctx, userID)
+}
+
+// UpdateLastUsed mocks base method.
+func (m *MockIAPIKeyRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsed", ctx, id, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastUsed indicates an expected call of UpdateLastUsed.
+func (mr *MockIAPIKeyRepositoryMockRecorder) UpdateLastUsed(ctx, id, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsed", reflect.TypeOf((*MockIAPIKeyRepository)(nil).UpdateLastUsed), ctx, id, usedAt)
+}
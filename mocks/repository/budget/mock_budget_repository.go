@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/budget/budget_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/budget/budget_repository_interface.go -destination mocks/repository/budget/mock_budget_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBudgetRepository is a mock of IBudgetRepository interface.
+type MockIBudgetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBudgetRepositoryMockRecorder
+}
+
+// MockIBudgetRepositoryMockRecorder is the mock recorder for MockIBudgetRepository.
+type MockIBudgetRepositoryMockRecorder struct {
+	mock *MockIBudgetRepository
+}
+
+// NewMockIBudgetRepository creates a new mock instance.
+func NewMockIBudgetRepository(ctrl *gomock.Controller) *MockIBudgetRepository {
+	mock := &MockIBudgetRepository{ctrl: ctrl}
+	mock.recorder = &MockIBudgetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBudgetRepository) EXPECT() *MockIBudgetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBudget mocks base method.
+func (m *MockIBudgetRepository) CreateBudget(ctx context.Context, budget model.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBudget", ctx, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBudget indicates an expected call of CreateBudget.
+func (mr *MockIBudgetRepositoryMockRecorder) CreateBudget(ctx, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudget", reflect.TypeOf((*MockIBudgetRepository)(nil).CreateBudget), ctx, budget)
+}
+
+// DeleteBudget mocks base method.
+func (m *MockIBudgetRepository) DeleteBudget(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockIBudgetRepositoryMockRecorder) DeleteBudget(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockIBudgetRepository)(nil).DeleteBudget), ctx, id)
+}
+
+// GetBudget mocks base method.
+func (m *MockIBudgetRepository) GetBudget(ctx context.Context, id string) (model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudget", ctx, id)
+	ret0, _ := ret[0].(model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudget indicates an expected call of GetBudget.
+func (mr *MockIBudgetRepositoryMockRecorder) GetBudget(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudget", reflect.TypeOf((*MockIBudgetRepository)(nil).GetBudget), ctx, id)
+}
+
+// GetCategoryBudget mocks base method.
+func (m *MockIBudgetRepository) GetCategoryBudget(ctx context.Context, categoryID, month string) (model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryBudget", ctx, categoryID, month)
+	ret0, _ := ret[0].(model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryBudget indicates an expected call of GetCategoryBudget.
+func (mr *MockIBudgetRepositoryMockRecorder) GetCategoryBudget(ctx, categoryID, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBudget", reflect.TypeOf((*MockIBudgetRepository)(nil).GetCategoryBudget), ctx, categoryID, month)
+}
+
+// GetUserBudgets mocks base method.
+func (m *MockIBudgetRepository) GetUserBudgets(ctx context.Context, userID, month string) ([]model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBudgets", ctx, userID, month)
+	ret0, _ := ret[0].([]model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBudgets indicates an expected call of GetUserBudgets.
+func (mr *MockIBudgetRepositoryMockRecorder) GetUserBudgets(ctx, userID, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBudgets", reflect.TypeOf((*MockIBudgetRepository)(nil).GetUserBudgets), ctx, userID, month)
+}
+
+// UpdateBudget mocks base method.
+func (m *MockIBudgetRepository) UpdateBudget(ctx context.Context, id string, budget model.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBudget", ctx, id, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBudget indicates an expected call of UpdateBudget.
+func (mr *MockIBudgetRepositoryMockRecorder) UpdateBudget(ctx, id, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudget", reflect.TypeOf((*MockIBudgetRepository)(nil).UpdateBudget), ctx, id, budget)
+}
@@ -9,6 +9,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/varomnrg/money-tracker/model"
@@ -39,88 +40,88 @@ func (m *MockICategoryRepository) EXPECT() *MockICategoryRepositoryMockRecorder
 }
 
 // CreateCategory mocks base method.
-func (m *MockICategoryRepository) CreateCategory(category model.Category) error {
+func (m *MockICategoryRepository) CreateCategory(ctx context.Context, category model.Category) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCategory", category)
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateCategory indicates an expected call of CreateCategory.
-func (mr *MockICategoryRepositoryMockRecorder) CreateCategory(category any) *gomock.Call {
+func (mr *MockICategoryRepositoryMockRecorder) CreateCategory(ctx, category any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryRepository)(nil).CreateCategory), category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryRepository)(nil).CreateCategory), ctx, category)
 }
 
 // DeleteCategory mocks base method.
-func (m *MockICategoryRepository) DeleteCategory(id string) error {
+func (m *MockICategoryRepository) DeleteCategory(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", id)
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockICategoryRepositoryMockRecorder) DeleteCategory(id any) *gomock.Call {
+func (mr *MockICategoryRepositoryMockRecorder) DeleteCategory(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryRepository)(nil).DeleteCategory), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryRepository)(nil).DeleteCategory), ctx, id)
 }
 
 // GetCategories mocks base method.
-func (m *MockICategoryRepository) GetCategories() ([]model.Category, error) {
+func (m *MockICategoryRepository) GetCategories(ctx context.Context, query model.ListQuery) ([]model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCategories")
+	ret := m.ctrl.Call(m, "GetCategories", ctx, query)
 	ret0, _ := ret[0].([]model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCategories indicates an expected call of GetCategories.
-func (mr *MockICategoryRepositoryMockRecorder) GetCategories() *gomock.Call {
+func (mr *MockICategoryRepositoryMockRecorder) GetCategories(ctx, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategories))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategories), ctx, query)
 }
 
 // GetCategory mocks base method.
-func (m *MockICategoryRepository) GetCategory(id string) (model.Category, error) {
+func (m *MockICategoryRepository) GetCategory(ctx context.Context, id string) (model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCategory", id)
+	ret := m.ctrl.Call(m, "GetCategory", ctx, id)
 	ret0, _ := ret[0].(model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCategory indicates an expected call of GetCategory.
-func (mr *MockICategoryRepositoryMockRecorder) GetCategory(id any) *gomock.Call {
+func (mr *MockICategoryRepositoryMockRecorder) GetCategory(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategory), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategory), ctx, id)
 }
 
 // GetUserCategories mocks base method.
-func (m *MockICategoryRepository) GetUserCategories(userID string) ([]model.Category, error) {
+func (m *MockICategoryRepository) GetUserCategories(ctx context.Context, userID string, query model.ListQuery) ([]model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserCategories", userID)
+	ret := m.ctrl.Call(m, "GetUserCategories", ctx, userID, query)
 	ret0, _ := ret[0].([]model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserCategories indicates an expected call of GetUserCategories.
-func (mr *MockICategoryRepositoryMockRecorder) GetUserCategories(userID any) *gomock.Call {
+func (mr *MockICategoryRepositoryMockRecorder) GetUserCategories(ctx, userID, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategories", reflect.TypeOf((*MockICategoryRepository)(nil).GetUserCategories), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategories", reflect.TypeOf((*MockICategoryRepository)(nil).GetUserCategories), ctx, userID, query)
 }
 
 // IsUserCategoryExist mocks base method.
-func (m *MockICategoryRepository) IsUserCategoryExist(userID, categoryName string) bool {
+func (m *MockICategoryRepository) IsUserCategoryExist(ctx context.Context, userID, categoryName string) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsUserCategoryExist", userID, categoryName)
+	ret := m.ctrl.Call(m, "IsUserCategoryExist", ctx, userID, categoryName)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // IsUserCategoryExist indicates an expected call of IsUserCategoryExist.
-func (mr *MockICategoryRepositoryMockRecorder) IsUserCategoryExist(userID, categoryName any) *gomock.Call {
+func (mr *MockICategoryRepositoryMockRecorder) IsUserCategoryExist(ctx, userID, categoryName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserCategoryExist", reflect.TypeOf((*MockICategoryRepository)(nil).IsUserCategoryExist), userID, categoryName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserCategoryExist", reflect.TypeOf((*MockICategoryRepository)(nil).IsUserCategoryExist), ctx, userID, categoryName)
 }
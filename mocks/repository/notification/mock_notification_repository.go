@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/notification/notification_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/notification/notification_repository_interface.go -destination mocks/repository/notification/mock_notification_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockINotificationRepository is a mock of INotificationRepository interface.
+type MockINotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationRepositoryMockRecorder
+}
+
+// MockINotificationRepositoryMockRecorder is the mock recorder for MockINotificationRepository.
+type MockINotificationRepositoryMockRecorder struct {
+	mock *MockINotificationRepository
+}
+
+// NewMockINotificationRepository creates a new mock instance.
+func NewMockINotificationRepository(ctrl *gomock.Controller) *MockINotificationRepository {
+	mock := &MockINotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockINotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationRepository) EXPECT() *MockINotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateNotification mocks base method.
+func (m *MockINotificationRepository) CreateNotification(ctx context.Context, notification model.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockINotificationRepositoryMockRecorder) CreateNotification(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockINotificationRepository)(nil).CreateNotification), ctx, notification)
+}
+
+// GetNotification mocks base method.
+func (m *MockINotificationRepository) GetNotification(ctx context.Context, id string) (model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotification", ctx, id)
+	ret0, _ := ret[0].(model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotification indicates an expected call of GetNotification.
+func (mr *MockINotificationRepositoryMockRecorder) GetNotification(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockINotificationRepository)(nil).GetNotification), ctx, id)
+}
+
+// GetNotificationByKey mocks base method.
+func (m *MockINotificationRepository) GetNotificationByKey(ctx context.Context, userID, key string) (model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationByKey", ctx, userID, key)
+	ret0, _ := ret[0].(model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationByKey indicates an expected call of GetNotificationByKey.
+func (mr *MockINotificationRepositoryMockRecorder) GetNotificationByKey(ctx, userID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationByKey", reflect.TypeOf((*MockINotificationRepository)(nil).GetNotificationByKey), ctx, userID, key)
+}
+
+// GetUserNotifications mocks base method.
+func (m *MockINotificationRepository) GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) ([]model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserNotifications", ctx, userID, query)
+	ret0, _ := ret[0].([]model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserNotifications indicates an expected call of GetUserNotifications.
+func (mr *MockINotificationRepositoryMockRecorder) GetUserNotifications(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotifications", reflect.TypeOf((*MockINotificationRepository)(nil).GetUserNotifications), ctx, userID, query)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockINotificationRepository) MarkAllRead(ctx context.Context, userID string, readAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID, readAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockINotificationRepositoryMockRecorder) MarkAllRead(ctx, userID, readAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockINotificationRepository)(nil).MarkAllRead), ctx, userID, readAt)
+}
+
+// MarkRead mocks base method.
+func (m *MockINotificationRepository) MarkRead(ctx context.Context, id string, readAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, id, readAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockINotificationRepositoryMockRecorder) MarkRead(ctx, id, readAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockINotificationRepository)(nil).MarkRead), ctx, id, readAt)
+}
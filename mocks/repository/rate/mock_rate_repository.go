@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/rate/rate_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/rate/rate_repository_interface.go -destination mocks/repository/rate/mock_rate_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRateRepository is a mock of IRateRepository interface.
+type MockIRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRateRepositoryMockRecorder
+}
+
+// MockIRateRepositoryMockRecorder is the mock recorder for MockIRateRepository.
+type MockIRateRepositoryMockRecorder struct {
+	mock *MockIRateRepository
+}
+
+// NewMockIRateRepository creates a new mock instance.
+func NewMockIRateRepository(ctrl *gomock.Controller) *MockIRateRepository {
+	mock := &MockIRateRepository{ctrl: ctrl}
+	mock.recorder = &MockIRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRateRepository) EXPECT() *MockIRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteRate mocks base method.
+func (m *MockIRateRepository) DeleteRate(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRate indicates an expected call of DeleteRate.
+func (mr *MockIRateRepositoryMockRecorder) DeleteRate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRate", reflect.TypeOf((*MockIRateRepository)(nil).DeleteRate), ctx, id)
+}
+
+// GetRate mocks base method.
+func (m *MockIRateRepository) GetRate(ctx context.Context, base, quote string, date time.Time) (model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, base, quote, date)
+	ret0, _ := ret[0].(model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockIRateRepositoryMockRecorder) GetRate(ctx, base, quote, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockIRateRepository)(nil).GetRate), ctx, base, quote, date)
+}
+
+// GetRates mocks base method.
+func (m *MockIRateRepository) GetRates(ctx context.Context) ([]model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx)
+	ret0, _ := ret[0].([]model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockIRateRepositoryMockRecorder) GetRates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockIRateRepository)(nil).GetRates), ctx)
+}
+
+// SaveRate mocks base method.
+func (m *MockIRateRepository) SaveRate(ctx context.Context, rate model.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRate indicates an expected call of SaveRate.
+func (mr *MockIRateRepositoryMockRecorder) SaveRate(ctx, rate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRate", reflect.TypeOf((*MockIRateRepository)(nil).SaveRate), ctx, rate)
+}
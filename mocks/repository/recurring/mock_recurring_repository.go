@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/recurring/recurring_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/recurring/recurring_repository_interface.go -destination mocks/repository/recurring/mock_recurring_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRecurringRepository is a mock of IRecurringRepository interface.
+type MockIRecurringRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRecurringRepositoryMockRecorder
+}
+
+// MockIRecurringRepositoryMockRecorder is the mock recorder for MockIRecurringRepository.
+type MockIRecurringRepositoryMockRecorder struct {
+	mock *MockIRecurringRepository
+}
+
+// NewMockIRecurringRepository creates a new mock instance.
+func NewMockIRecurringRepository(ctrl *gomock.Controller) *MockIRecurringRepository {
+	mock := &MockIRecurringRepository{ctrl: ctrl}
+	mock.recorder = &MockIRecurringRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRecurringRepository) EXPECT() *MockIRecurringRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRecurringTransaction mocks base method.
+func (m *MockIRecurringRepository) CreateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurringTransaction", ctx, recurring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecurringTransaction indicates an expected call of CreateRecurringTransaction.
+func (mr *MockIRecurringRepositoryMockRecorder) CreateRecurringTransaction(ctx, recurring any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurringTransaction", reflect.TypeOf((*MockIRecurringRepository)(nil).CreateRecurringTransaction), ctx, recurring)
+}
+
+// DeleteRecurringTransaction mocks base method.
+func (m *MockIRecurringRepository) DeleteRecurringTransaction(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecurringTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecurringTransaction indicates an expected call of DeleteRecurringTransaction.
+func (mr *MockIRecurringRepositoryMockRecorder) DeleteRecurringTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecurringTransaction", reflect.TypeOf((*MockIRecurringRepository)(nil).DeleteRecurringTransaction), ctx, id)
+}
+
+// GetDueRecurringTransactions mocks base method.
+func (m *MockIRecurringRepository) GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueRecurringTransactions", ctx, now)
+	ret0, _ := ret[0].([]model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueRecurringTransactions indicates an expected call of GetDueRecurringTransactions.
+func (mr *MockIRecurringRepositoryMockRecorder) GetDueRecurringTransactions(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueRecurringTransactions", reflect.TypeOf((*MockIRecurringRepository)(nil).GetDueRecurringTransactions), ctx, now)
+}
+
+// GetRecurringTransaction mocks base method.
+func (m *MockIRecurringRepository) GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringTransaction", ctx, id)
+	ret0, _ := ret[0].(model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringTransaction indicates an expected call of GetRecurringTransaction.
+func (mr *MockIRecurringRepositoryMockRecorder) GetRecurringTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringTransaction", reflect.TypeOf((*MockIRecurringRepository)(nil).GetRecurringTransaction), ctx, id)
+}
+
+// GetUserRecurringTransactions mocks base method.
+func (m *MockIRecurringRepository) GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRecurringTransactions", ctx, userID)
+	ret0, _ := ret[0].([]model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRecurringTransactions indicates an expected call of GetUserRecurringTransactions.
+func (mr *MockIRecurringRepositoryMockRecorder) GetUserRecurringTransactions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRecurringTransactions", reflect.TypeOf((*MockIRecurringRepository)(nil).GetUserRecurringTransactions), ctx, userID)
+}
+
+// UpdateRecurringTransaction mocks base method.
+func (m *MockIRecurringRepository) UpdateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction, occurrences int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurringTransaction", ctx, recurring, occurrences)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecurringTransaction indicates an expected call of UpdateRecurringTransaction.
+func (mr *MockIRecurringRepositoryMockRecorder) UpdateRecurringTransaction(ctx, recurring, occurrences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurringTransaction", reflect.TypeOf((*MockIRecurringRepository)(nil).UpdateRecurringTransaction), ctx, recurring, occurrences)
+}
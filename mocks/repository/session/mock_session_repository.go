@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/session/session_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/session/session_repository_interface.go -destination mocks/repository/session/mock_session_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISessionRepository is a mock of ISessionRepository interface.
+type MockISessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionRepositoryMockRecorder
+}
+
+// MockISessionRepositoryMockRecorder is the mock recorder for MockISessionRepository.
+type MockISessionRepositoryMockRecorder struct {
+	mock *MockISessionRepository
+}
+
+// NewMockISessionRepository creates a new mock instance.
+func NewMockISessionRepository(ctrl *gomock.Controller) *MockISessionRepository {
+	mock := &MockISessionRepository{ctrl: ctrl}
+	mock.recorder = &MockISessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionRepository) EXPECT() *MockISessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockISessionRepository) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockISessionRepositoryMockRecorder) CreateRefreshToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockISessionRepository)(nil).CreateRefreshToken), ctx, token)
+}
+
+// GetRefreshTokenByHash mocks base method.
+func (m *MockISessionRepository) GetRefreshTokenByHash(ctx context.Context, hash string) (model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshTokenByHash", ctx, hash)
+	ret0, _ := ret[0].(model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshTokenByHash indicates an expected call of GetRefreshTokenByHash.
+func (mr *MockISessionRepositoryMockRecorder) GetRefreshTokenByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshTokenByHash", reflect.TypeOf((*MockISessionRepository)(nil).GetRefreshTokenByHash), ctx, hash)
+}
+
+// GetUserSessions mocks base method.
+func (m *MockISessionRepository) GetUserSessions(ctx context.Context, userID string, now time.Time) ([]model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", ctx, userID, now)
+	ret0, _ := ret[0].([]model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *MockISessionRepositoryMockRecorder) GetUserSessions(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockISessionRepository)(nil).GetUserSessions), ctx, userID, now)
+}
+
+// RevokeSession mocks base method.
+func (m *MockISessionRepository) RevokeSession(ctx context.Context, userID, sessionID string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockISessionRepositoryMockRecorder) RevokeSession(ctx, userID, sessionID, revokedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockISessionRepository)(nil).RevokeSession), ctx, userID, sessionID, revokedAt)
+}
+
+// UseRefreshToken mocks base method.
+func (m *MockISessionRepository) UseRefreshToken(ctx context.Context, id string, usedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRefreshToken", ctx, id, usedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRefreshToken indicates an expected call of UseRefreshToken.
+func (mr *MockISessionRepositoryMockRecorder) UseRefreshToken(ctx, id, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRefreshToken", reflect.TypeOf((*MockISessionRepository)(nil).UseRefreshToken), ctx, id, usedAt)
+}
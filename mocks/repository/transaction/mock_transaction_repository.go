@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/transaction/transaction_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/transaction/transaction_repository_interface.go -destination mocks/repository/transaction/mock_transaction_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITransactionRepository is a mock of ITransactionRepository interface.
+type MockITransactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransactionRepositoryMockRecorder
+}
+
+// MockITransactionRepositoryMockRecorder is the mock recorder for MockITransactionRepository.
+type MockITransactionRepositoryMockRecorder struct {
+	mock *MockITransactionRepository
+}
+
+// NewMockITransactionRepository creates a new mock instance.
+func NewMockITransactionRepository(ctrl *gomock.Controller) *MockITransactionRepository {
+	mock := &MockITransactionRepository{ctrl: ctrl}
+	mock.recorder = &MockITransactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransactionRepository) EXPECT() *MockITransactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTransaction mocks base method.
+func (m *MockITransactionRepository) CreateTransaction(ctx context.Context, transaction model.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockITransactionRepositoryMockRecorder) CreateTransaction(ctx, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockITransactionRepository)(nil).CreateTransaction), ctx, transaction)
+}
+
+// DeleteTransaction mocks base method.
+func (m *MockITransactionRepository) DeleteTransaction(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockITransactionRepositoryMockRecorder) DeleteTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockITransactionRepository)(nil).DeleteTransaction), ctx, id)
+}
+
+// GetCategoryTotals mocks base method.
+func (m *MockITransactionRepository) GetCategoryTotals(ctx context.Context, userID string, from, to time.Time) ([]model.CategoryTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTotals", ctx, userID, from, to)
+	ret0, _ := ret[0].([]model.CategoryTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTotals indicates an expected call of GetCategoryTotals.
+func (mr *MockITransactionRepositoryMockRecorder) GetCategoryTotals(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTotals", reflect.TypeOf((*MockITransactionRepository)(nil).GetCategoryTotals), ctx, userID, from, to)
+}
+
+// GetTransaction mocks base method.
+func (m *MockITransactionRepository) GetTransaction(ctx context.Context, id string) (model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, id)
+	ret0, _ := ret[0].(model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockITransactionRepositoryMockRecorder) GetTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockITransactionRepository)(nil).GetTransaction), ctx, id)
+}
+
+// GetUserTransactions mocks base method.
+func (m *MockITransactionRepository) GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) ([]model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTransactions", ctx, userID, query)
+	ret0, _ := ret[0].([]model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTransactions indicates an expected call of GetUserTransactions.
+func (mr *MockITransactionRepositoryMockRecorder) GetUserTransactions(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransactions", reflect.TypeOf((*MockITransactionRepository)(nil).GetUserTransactions), ctx, userID, query)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockITransactionRepository) UpdateTransaction(ctx context.Context, id string, transaction model.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, id, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockITransactionRepositoryMockRecorder) UpdateTransaction(ctx, id, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockITransactionRepository)(nil).UpdateTransaction), ctx, id, transaction)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/transfer/transfer_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/transfer/transfer_repository_interface.go -destination mocks/repository/transfer/mock_transfer_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITransferRepository is a mock of ITransferRepository interface.
+type MockITransferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransferRepositoryMockRecorder
+}
+
+// MockITransferRepositoryMockRecorder is the mock recorder for MockITransferRepository.
+type MockITransferRepositoryMockRecorder struct {
+	mock *MockITransferRepository
+}
+
+// NewMockITransferRepository creates a new mock instance.
+func NewMockITransferRepository(ctrl *gomock.Controller) *MockITransferRepository {
+	mock := &MockITransferRepository{ctrl: ctrl}
+	mock.recorder = &MockITransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransferRepository) EXPECT() *MockITransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTransfer mocks base method.
+func (m *MockITransferRepository) CreateTransfer(ctx context.Context, transfer model.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockITransferRepositoryMockRecorder) CreateTransfer(ctx, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockITransferRepository)(nil).CreateTransfer), ctx, transfer)
+}
+
+// DeleteTransfer mocks base method.
+func (m *MockITransferRepository) DeleteTransfer(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransfer", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransfer indicates an expected call of DeleteTransfer.
+func (mr *MockITransferRepositoryMockRecorder) DeleteTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransfer", reflect.TypeOf((*MockITransferRepository)(nil).DeleteTransfer), ctx, id)
+}
+
+// GetTransfer mocks base method.
+func (m *MockITransferRepository) GetTransfer(ctx context.Context, id string) (model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockITransferRepositoryMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockITransferRepository)(nil).GetTransfer), ctx, id)
+}
+
+// GetUserTransfers mocks base method.
+func (m *MockITransferRepository) GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTransfers", ctx, userID)
+	ret0, _ := ret[0].([]model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTransfers indicates an expected call of GetUserTransfers.
+func (mr *MockITransferRepositoryMockRecorder) GetUserTransfers(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransfers", reflect.TypeOf((*MockITransferRepository)(nil).GetUserTransfers), ctx, userID)
+}
+
+// UpdateTransfer mocks base method.
+func (m *MockITransferRepository) UpdateTransfer(ctx context.Context, id string, transfer model.Transfer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransfer", ctx, id, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransfer indicates an expected call of UpdateTransfer.
+func (mr *MockITransferRepositoryMockRecorder) UpdateTransfer(ctx, id, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransfer", reflect.TypeOf((*MockITransferRepository)(nil).UpdateTransfer), ctx, id, transfer)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/unitofwork/unit_of_work_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/unitofwork/unit_of_work_interface.go -destination mocks/repository/unitofwork/mock_unit_of_work.go
+//
+// Package mock_unitofwork is a generated GoMock package.
+package mock_unitofwork
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUnitOfWork is a mock of IUnitOfWork interface.
+type MockIUnitOfWork struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUnitOfWorkMockRecorder
+}
+
+// MockIUnitOfWorkMockRecorder is the mock recorder for MockIUnitOfWork.
+type MockIUnitOfWorkMockRecorder struct {
+	mock *MockIUnitOfWork
+}
+
+// NewMockIUnitOfWork creates a new mock instance.
+func NewMockIUnitOfWork(ctrl *gomock.Controller) *MockIUnitOfWork {
+	mock := &MockIUnitOfWork{ctrl: ctrl}
+	mock.recorder = &MockIUnitOfWorkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUnitOfWork) EXPECT() *MockIUnitOfWorkMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIUnitOfWork) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIUnitOfWorkMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIUnitOfWork)(nil).Do), ctx, fn)
+}
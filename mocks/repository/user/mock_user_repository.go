@@ -1,14 +1,15 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: repository/user_interface.go
+// Source: repository/user/user_repository_interface.go
 //
 // Generated by this command:
 //
-//	mockgen -source repository/user_interface.go -destination repository/mock_user_repository.go -package repository
+//	mockgen -source repository/user/user_repository_interface.go -destination mocks/repository/user/mock_user_repository.go
 //
-// Package repository is a generated GoMock package.
-package repository
+// Package mock_repository is a generated GoMock package.
+package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/varomnrg/money-tracker/model"
@@ -39,87 +40,131 @@ func (m *MockIUserRepository) EXPECT() *MockIUserRepositoryMockRecorder {
 }
 
 // CreateUser mocks base method.
-func (m *MockIUserRepository) CreateUser(movie model.User) error {
+func (m *MockIUserRepository) CreateUser(ctx context.Context, movie model.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", movie)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, movie)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateUser indicates an expected call of CreateUser.
-func (mr *MockIUserRepositoryMockRecorder) CreateUser(movie any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) CreateUser(ctx, movie any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIUserRepository)(nil).CreateUser), movie)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIUserRepository)(nil).CreateUser), ctx, movie)
 }
 
 // DeleteUser mocks base method.
-func (m *MockIUserRepository) DeleteUser(id string) error {
+func (m *MockIUserRepository) DeleteUser(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", id)
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockIUserRepositoryMockRecorder) DeleteUser(id any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIUserRepository)(nil).DeleteUser), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIUserRepository)(nil).DeleteUser), ctx, id)
 }
 
 // GetUser mocks base method.
-func (m *MockIUserRepository) GetUser(id string) (model.UserResponse, error) {
+func (m *MockIUserRepository) GetUser(ctx context.Context, id string) (model.UserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", id)
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
 	ret0, _ := ret[0].(model.UserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUser indicates an expected call of GetUser.
-func (mr *MockIUserRepositoryMockRecorder) GetUser(id any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) GetUser(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockIUserRepository)(nil).GetUser), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockIUserRepository)(nil).GetUser), ctx, id)
+}
+
+// GetUserByUsername mocks base method.
+func (m *MockIUserRepository) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByUsername", ctx, username)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByUsername indicates an expected call of GetUserByUsername.
+func (mr *MockIUserRepositoryMockRecorder) GetUserByUsername(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockIUserRepository)(nil).GetUserByUsername), ctx, username)
 }
 
 // GetUsers mocks base method.
-func (m *MockIUserRepository) GetUsers() ([]model.UserResponse, error) {
+func (m *MockIUserRepository) GetUsers(ctx context.Context, query model.ListQuery) ([]model.UserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsers")
+	ret := m.ctrl.Call(m, "GetUsers", ctx, query)
 	ret0, _ := ret[0].([]model.UserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsers indicates an expected call of GetUsers.
-func (mr *MockIUserRepositoryMockRecorder) GetUsers() *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) GetUsers(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockIUserRepository)(nil).GetUsers), ctx, query)
+}
+
+// GetUsersWithPassword mocks base method.
+func (m *MockIUserRepository) GetUsersWithPassword(ctx context.Context) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersWithPassword", ctx)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersWithPassword indicates an expected call of GetUsersWithPassword.
+func (mr *MockIUserRepositoryMockRecorder) GetUsersWithPassword(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockIUserRepository)(nil).GetUsers))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersWithPassword", reflect.TypeOf((*MockIUserRepository)(nil).GetUsersWithPassword), ctx)
 }
 
 // IsUsernameExist mocks base method.
-func (m *MockIUserRepository) IsUsernameExist(username string) bool {
+func (m *MockIUserRepository) IsUsernameExist(ctx context.Context, username string) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsUsernameExist", username)
+	ret := m.ctrl.Call(m, "IsUsernameExist", ctx, username)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // IsUsernameExist indicates an expected call of IsUsernameExist.
-func (mr *MockIUserRepositoryMockRecorder) IsUsernameExist(username any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) IsUsernameExist(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsernameExist", reflect.TypeOf((*MockIUserRepository)(nil).IsUsernameExist), ctx, username)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockIUserRepository) UpdatePassword(ctx context.Context, id, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockIUserRepositoryMockRecorder) UpdatePassword(ctx, id, password any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsernameExist", reflect.TypeOf((*MockIUserRepository)(nil).IsUsernameExist), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockIUserRepository)(nil).UpdatePassword), ctx, id, password)
 }
 
 // UpdateUser mocks base method.
-func (m *MockIUserRepository) UpdateUser(id string, movie model.UserRequest) error {
+func (m *MockIUserRepository) UpdateUser(ctx context.Context, id string, movie model.UserRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", id, movie)
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, id, movie)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockIUserRepositoryMockRecorder) UpdateUser(id, movie any) *gomock.Call {
+func (mr *MockIUserRepositoryMockRecorder) UpdateUser(ctx, id, movie any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIUserRepository)(nil).UpdateUser), id, movie)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIUserRepository)(nil).UpdateUser), ctx, id, movie)
 }
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository/wallet/wallet_repository_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source repository/wallet/wallet_repository_interface.go -destination mocks/repository/wallet/mock_wallet_repository.go
+//
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWalletRepository is a mock of IWalletRepository interface.
+type MockIWalletRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWalletRepositoryMockRecorder
+}
+
+// MockIWalletRepositoryMockRecorder is the mock recorder for MockIWalletRepository.
+type MockIWalletRepositoryMockRecorder struct {
+	mock *MockIWalletRepository
+}
+
+// NewMockIWalletRepository creates a new mock instance.
+func NewMockIWalletRepository(ctrl *gomock.Controller) *MockIWalletRepository {
+	mock := &MockIWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockIWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWalletRepository) EXPECT() *MockIWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateWallet mocks base method.
+func (m *MockIWalletRepository) CreateWallet(ctx context.Context, wallet model.Wallet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", ctx, wallet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWallet indicates an expected call of CreateWallet.
+func (mr *MockIWalletRepositoryMockRecorder) CreateWallet(ctx, wallet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockIWalletRepository)(nil).CreateWallet), ctx, wallet)
+}
+
+// DeleteWallet mocks base method.
+func (m *MockIWalletRepository) DeleteWallet(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWallet", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWallet indicates an expected call of DeleteWallet.
+func (mr *MockIWalletRepositoryMockRecorder) DeleteWallet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWallet", reflect.TypeOf((*MockIWalletRepository)(nil).DeleteWallet), ctx, id)
+}
+
+// GetUserWallets mocks base method.
+func (m *MockIWalletRepository) GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWallets", ctx, userID)
+	ret0, _ := ret[0].([]model.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWallets indicates an expected call of GetUserWallets.
+func (mr *MockIWalletRepositoryMockRecorder) GetUserWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWallets", reflect.TypeOf((*MockIWalletRepository)(nil).GetUserWallets), ctx, userID)
+}
+
+// GetWallet mocks base method.
+func (m *MockIWalletRepository) GetWallet(ctx context.Context, id string) (model.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet", ctx, id)
+	ret0, _ := ret[0].(model.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockIWalletRepositoryMockRecorder) GetWallet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockIWalletRepository)(nil).GetWallet), ctx, id)
+}
+
+// IsUserWalletExist mocks base method.
+func (m *MockIWalletRepository) IsUserWalletExist(ctx context.Context, userID, walletName string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserWalletExist", ctx, userID, walletName)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsUserWalletExist indicates an expected call of IsUserWalletExist.
+func (mr *MockIWalletRepositoryMockRecorder) IsUserWalletExist(ctx, userID, walletName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserWalletExist", reflect.TypeOf((*MockIWalletRepository)(nil).IsUserWalletExist), ctx, userID, walletName)
+}
+
+// UpdateWallet mocks base method.
+func (m *MockIWalletRepository) UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWallet", ctx, id, wallet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWallet indicates an expected call of UpdateWallet.
+func (mr *MockIWalletRepositoryMockRecorder) UpdateWallet(ctx, id, wallet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWallet", reflect.TypeOf((*MockIWalletRepository)(nil).UpdateWallet), ctx, id, wallet)
+}
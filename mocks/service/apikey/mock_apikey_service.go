@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/apikey/apikey_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/apikey/apikey_service_interface.go -destination mocks/service/apikey/mock_apikey_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAPIKeyService is a mock of IAPIKeyService interface.
+type MockIAPIKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAPIKeyServiceMockRecorder
+}
+
+// MockIAPIKeyServiceMockRecorder is the mock recorder for MockIAPIKeyService.
+type MockIAPIKeyServiceMockRecorder struct {
+	mock *MockIAPIKeyService
+}
+
+// NewMockIAPIKeyService creates a new mock instance.
+func NewMockIAPIKeyService(ctrl *gomock.Controller) *MockIAPIKeyService {
+	mock := &MockIAPIKeyService{ctrl: ctrl}
+	mock.recorder = &MockIAPIKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAPIKeyService) EXPECT() *MockIAPIKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockIAPIKeyService) Authenticate(ctx context.Context, key string) (model.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, key)
+	ret0, _ := ret[0].(model.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIAPIKeyServiceMockRecorder) Authenticate(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIAPIKeyService)(nil).Authenticate), ctx, key)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockIAPIKeyService) CreateAPIKey(ctx context.Context, userID string, key model.APIKeyRequest) (model.APIKeyCreated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, userID, key)
+	ret0, _ := ret[0].(model.APIKeyCreated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockIAPIKeyServiceMockRecorder) CreateAPIKey(ctx, userID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockIAPIKeyService)(nil).CreateAPIKey), ctx, userID, key)
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockIAPIKeyService) DeleteAPIKey(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockIAPIKeyServiceMockRecorder) DeleteAPIKey(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockIAPIKeyService)(nil).DeleteAPIKey), ctx, userID, id)
+}
+
+// GetUserAPIKeys mocks base method.
+func (m *MockIAPIKeyService) GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAPIKeys indicates an expected call of GetUserAPIKeys.
+func (mr *MockIAPIKeyServiceMockRecorder) GetUserAPIKeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAPIKeys", reflect.TypeOf((*MockIAPIKeyService)(nil).GetUserAPIKeys), ctx, userID)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/auth/auth_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/auth/auth_service_interface.go -destination mocks/service/auth/mock_auth_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAuthService is a mock of IAuthService interface.
+type MockIAuthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuthServiceMockRecorder
+}
+
+// MockIAuthServiceMockRecorder is the mock recorder for MockIAuthService.
+type MockIAuthServiceMockRecorder struct {
+	mock *MockIAuthService
+}
+
+// NewMockIAuthService creates a new mock instance.
+func NewMockIAuthService(ctrl *gomock.Controller) *MockIAuthService {
+	mock := &MockIAuthService{ctrl: ctrl}
+	mock.recorder = &MockIAuthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuthService) EXPECT() *MockIAuthServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSessions mocks base method.
+func (m *MockIAuthService) GetSessions(ctx context.Context, userID string) ([]model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, userID)
+	ret0, _ := ret[0].([]model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockIAuthServiceMockRecorder) GetSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockIAuthService)(nil).GetSessions), ctx, userID)
+}
+
+// Login mocks base method.
+func (m *MockIAuthService) Login(ctx context.Context, login model.LoginRequest, userAgent string) (model.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, login, userAgent)
+	ret0, _ := ret[0].(model.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockIAuthServiceMockRecorder) Login(ctx, login, userAgent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthService)(nil).Login), ctx, login, userAgent)
+}
+
+// Logout mocks base method.
+func (m *MockIAuthService) Logout(ctx context.Context, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockIAuthServiceMockRecorder) Logout(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockIAuthService)(nil).Logout), ctx, refreshToken)
+}
+
+// ParseAccessToken mocks base method.
+func (m *MockIAuthService) ParseAccessToken(ctx context.Context, token string) (model.AuthUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseAccessToken", ctx, token)
+	ret0, _ := ret[0].(model.AuthUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseAccessToken indicates an expected call of ParseAccessToken.
+func (mr *MockIAuthServiceMockRecorder) ParseAccessToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseAccessToken", reflect.TypeOf((*MockIAuthService)(nil).ParseAccessToken), ctx, token)
+}
+
+// Refresh mocks base method.
+func (m *MockIAuthService) Refresh(ctx context.Context, refreshToken, userAgent string) (model.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken, userAgent)
+	ret0, _ := ret[0].(model.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockIAuthServiceMockRecorder) Refresh(ctx, refreshToken, userAgent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockIAuthService)(nil).Refresh), ctx, refreshToken, userAgent)
+}
+
+// RevokeSession mocks base method.
+func (m *MockIAuthService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockIAuthServiceMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockIAuthService)(nil).RevokeSession), ctx, userID, sessionID)
+}
@@ -0,0 +1,179 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/budget/budget_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/budget/budget_service_interface.go -destination mocks/service/budget/mock_budget_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBudgetChecker is a mock of IBudgetChecker interface.
+type MockIBudgetChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBudgetCheckerMockRecorder
+}
+
+// MockIBudgetCheckerMockRecorder is the mock recorder for MockIBudgetChecker.
+type MockIBudgetCheckerMockRecorder struct {
+	mock *MockIBudgetChecker
+}
+
+// NewMockIBudgetChecker creates a new mock instance.
+func NewMockIBudgetChecker(ctrl *gomock.Controller) *MockIBudgetChecker {
+	mock := &MockIBudgetChecker{ctrl: ctrl}
+	mock.recorder = &MockIBudgetCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBudgetChecker) EXPECT() *MockIBudgetCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckBudget mocks base method.
+func (m *MockIBudgetChecker) CheckBudget(ctx context.Context, userID, categoryID string, date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBudget", ctx, userID, categoryID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBudget indicates an expected call of CheckBudget.
+func (mr *MockIBudgetCheckerMockRecorder) CheckBudget(ctx, userID, categoryID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBudget", reflect.TypeOf((*MockIBudgetChecker)(nil).CheckBudget), ctx, userID, categoryID, date)
+}
+
+// MockIBudgetService is a mock of IBudgetService interface.
+type MockIBudgetService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBudgetServiceMockRecorder
+}
+
+// MockIBudgetServiceMockRecorder is the mock recorder for MockIBudgetService.
+type MockIBudgetServiceMockRecorder struct {
+	mock *MockIBudgetService
+}
+
+// NewMockIBudgetService creates a new mock instance.
+func NewMockIBudgetService(ctrl *gomock.Controller) *MockIBudgetService {
+	mock := &MockIBudgetService{ctrl: ctrl}
+	mock.recorder = &MockIBudgetServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBudgetService) EXPECT() *MockIBudgetServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckBudget mocks base method.
+func (m *MockIBudgetService) CheckBudget(ctx context.Context, userID, categoryID string, date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBudget", ctx, userID, categoryID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBudget indicates an expected call of CheckBudget.
+func (mr *MockIBudgetServiceMockRecorder) CheckBudget(ctx, userID, categoryID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBudget", reflect.TypeOf((*MockIBudgetService)(nil).CheckBudget), ctx, userID, categoryID, date)
+}
+
+// CreateBudget mocks base method.
+func (m *MockIBudgetService) CreateBudget(ctx context.Context, userID string, budget model.BudgetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBudget", ctx, userID, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBudget indicates an expected call of CreateBudget.
+func (mr *MockIBudgetServiceMockRecorder) CreateBudget(ctx, userID, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudget", reflect.TypeOf((*MockIBudgetService)(nil).CreateBudget), ctx, userID, budget)
+}
+
+// DeleteBudget mocks base method.
+func (m *MockIBudgetService) DeleteBudget(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockIBudgetServiceMockRecorder) DeleteBudget(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockIBudgetService)(nil).DeleteBudget), ctx, id)
+}
+
+// FindBudget mocks base method.
+func (m *MockIBudgetService) FindBudget(ctx context.Context, id string) (model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBudget", ctx, id)
+	ret0, _ := ret[0].(model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBudget indicates an expected call of FindBudget.
+func (mr *MockIBudgetServiceMockRecorder) FindBudget(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBudget", reflect.TypeOf((*MockIBudgetService)(nil).FindBudget), ctx, id)
+}
+
+// GetBudget mocks base method.
+func (m *MockIBudgetService) GetBudget(ctx context.Context, id string) (model.BudgetProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudget", ctx, id)
+	ret0, _ := ret[0].(model.BudgetProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudget indicates an expected call of GetBudget.
+func (mr *MockIBudgetServiceMockRecorder) GetBudget(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudget", reflect.TypeOf((*MockIBudgetService)(nil).GetBudget), ctx, id)
+}
+
+// GetUserBudgets mocks base method.
+func (m *MockIBudgetService) GetUserBudgets(ctx context.Context, userID, month string) ([]model.BudgetProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBudgets", ctx, userID, month)
+	ret0, _ := ret[0].([]model.BudgetProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBudgets indicates an expected call of GetUserBudgets.
+func (mr *MockIBudgetServiceMockRecorder) GetUserBudgets(ctx, userID, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBudgets", reflect.TypeOf((*MockIBudgetService)(nil).GetUserBudgets), ctx, userID, month)
+}
+
+// UpdateBudget mocks base method.
+func (m *MockIBudgetService) UpdateBudget(ctx context.Context, id string, budget model.BudgetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBudget", ctx, id, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBudget indicates an expected call of UpdateBudget.
+func (mr *MockIBudgetServiceMockRecorder) UpdateBudget(ctx, id, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudget", reflect.TypeOf((*MockIBudgetService)(nil).UpdateBudget), ctx, id, budget)
+}
@@ -9,6 +9,7 @@
 package mock_service
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/varomnrg/money-tracker/model"
@@ -39,74 +40,74 @@ func (m *MockICategoryService) EXPECT() *MockICategoryServiceMockRecorder {
 }
 
 // CreateCategory mocks base method.
-func (m *MockICategoryService) CreateCategory(userID string, category model.CategoryRequest) error {
+func (m *MockICategoryService) CreateCategory(ctx context.Context, userID string, category model.CategoryRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCategory", userID, category)
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, userID, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateCategory indicates an expected call of CreateCategory.
-func (mr *MockICategoryServiceMockRecorder) CreateCategory(userID, category any) *gomock.Call {
+func (mr *MockICategoryServiceMockRecorder) CreateCategory(ctx, userID, category any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryService)(nil).CreateCategory), userID, category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryService)(nil).CreateCategory), ctx, userID, category)
 }
 
 // DeleteCategory mocks base method.
-func (m *MockICategoryService) DeleteCategory(id string) error {
+func (m *MockICategoryService) DeleteCategory(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", id)
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockICategoryServiceMockRecorder) DeleteCategory(id any) *gomock.Call {
+func (mr *MockICategoryServiceMockRecorder) DeleteCategory(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryService)(nil).DeleteCategory), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryService)(nil).DeleteCategory), ctx, id)
 }
 
 // GetCategories mocks base method.
-func (m *MockICategoryService) GetCategories() ([]model.Category, error) {
+func (m *MockICategoryService) GetCategories(ctx context.Context, query model.ListQuery) (model.Page[model.Category], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCategories")
-	ret0, _ := ret[0].([]model.Category)
+	ret := m.ctrl.Call(m, "GetCategories", ctx, query)
+	ret0, _ := ret[0].(model.Page[model.Category])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCategories indicates an expected call of GetCategories.
-func (mr *MockICategoryServiceMockRecorder) GetCategories() *gomock.Call {
+func (mr *MockICategoryServiceMockRecorder) GetCategories(ctx, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockICategoryService)(nil).GetCategories))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockICategoryService)(nil).GetCategories), ctx, query)
 }
 
 // GetCategory mocks base method.
-func (m *MockICategoryService) GetCategory(id string) (model.Category, error) {
+func (m *MockICategoryService) GetCategory(ctx context.Context, id string) (model.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCategory", id)
+	ret := m.ctrl.Call(m, "GetCategory", ctx, id)
 	ret0, _ := ret[0].(model.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCategory indicates an expected call of GetCategory.
-func (mr *MockICategoryServiceMockRecorder) GetCategory(id any) *gomock.Call {
+func (mr *MockICategoryServiceMockRecorder) GetCategory(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockICategoryService)(nil).GetCategory), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockICategoryService)(nil).GetCategory), ctx, id)
 }
 
 // GetUserCategories mocks base method.
-func (m *MockICategoryService) GetUserCategories(userID string) ([]model.Category, error) {
+func (m *MockICategoryService) GetUserCategories(ctx context.Context, userID string, query model.ListQuery) (model.Page[model.Category], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserCategories", userID)
-	ret0, _ := ret[0].([]model.Category)
+	ret := m.ctrl.Call(m, "GetUserCategories", ctx, userID, query)
+	ret0, _ := ret[0].(model.Page[model.Category])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserCategories indicates an expected call of GetUserCategories.
-func (mr *MockICategoryServiceMockRecorder) GetUserCategories(userID any) *gomock.Call {
+func (mr *MockICategoryServiceMockRecorder) GetUserCategories(ctx, userID, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategories", reflect.TypeOf((*MockICategoryService)(nil).GetUserCategories), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCategories", reflect.TypeOf((*MockICategoryService)(nil).GetUserCategories), ctx, userID, query)
 }
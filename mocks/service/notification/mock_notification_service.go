@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/notification/notification_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/notification/notification_service_interface.go -destination mocks/service/notification/mock_notification_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockINotifier is a mock of INotifier interface.
+type MockINotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotifierMockRecorder
+}
+
+// MockINotifierMockRecorder is the mock recorder for MockINotifier.
+type MockINotifierMockRecorder struct {
+	mock *MockINotifier
+}
+
+// NewMockINotifier creates a new mock instance.
+func NewMockINotifier(ctrl *gomock.Controller) *MockINotifier {
+	mock := &MockINotifier{ctrl: ctrl}
+	mock.recorder = &MockINotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotifier) EXPECT() *MockINotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockINotifier) Notify(ctx context.Context, notification model.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockINotifierMockRecorder) Notify(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockINotifier)(nil).Notify), ctx, notification)
+}
+
+// MockINotificationService is a mock of INotificationService interface.
+type MockINotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockINotificationServiceMockRecorder
+}
+
+// MockINotificationServiceMockRecorder is the mock recorder for MockINotificationService.
+type MockINotificationServiceMockRecorder struct {
+	mock *MockINotificationService
+}
+
+// NewMockINotificationService creates a new mock instance.
+func NewMockINotificationService(ctrl *gomock.Controller) *MockINotificationService {
+	mock := &MockINotificationService{ctrl: ctrl}
+	mock.recorder = &MockINotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockINotificationService) EXPECT() *MockINotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetNotification mocks base method.
+func (m *MockINotificationService) GetNotification(ctx context.Context, id string) (model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotification", ctx, id)
+	ret0, _ := ret[0].(model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotification indicates an expected call of GetNotification.
+func (mr *MockINotificationServiceMockRecorder) GetNotification(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotification", reflect.TypeOf((*MockINotificationService)(nil).GetNotification), ctx, id)
+}
+
+// GetUserNotifications mocks base method.
+func (m *MockINotificationService) GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) (model.Page[model.Notification], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserNotifications", ctx, userID, query)
+	ret0, _ := ret[0].(model.Page[model.Notification])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserNotifications indicates an expected call of GetUserNotifications.
+func (mr *MockINotificationServiceMockRecorder) GetUserNotifications(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotifications", reflect.TypeOf((*MockINotificationService)(nil).GetUserNotifications), ctx, userID, query)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockINotificationService) MarkAllRead(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockINotificationServiceMockRecorder) MarkAllRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockINotificationService)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockINotificationService) MarkRead(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockINotificationServiceMockRecorder) MarkRead(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockINotificationService)(nil).MarkRead), ctx, id)
+}
+
+// Notify mocks base method.
+func (m *MockINotificationService) Notify(ctx context.Context, notification model.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockINotificationServiceMockRecorder) Notify(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockINotificationService)(nil).Notify), ctx, notification)
+}
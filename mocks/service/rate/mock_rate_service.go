@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/rate/rate_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/rate/rate_service_interface.go -destination mocks/service/rate/mock_rate_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIConverter is a mock of IConverter interface.
+type MockIConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIConverterMockRecorder
+}
+
+// MockIConverterMockRecorder is the mock recorder for MockIConverter.
+type MockIConverterMockRecorder struct {
+	mock *MockIConverter
+}
+
+// NewMockIConverter creates a new mock instance.
+func NewMockIConverter(ctrl *gomock.Controller) *MockIConverter {
+	mock := &MockIConverter{ctrl: ctrl}
+	mock.recorder = &MockIConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIConverter) EXPECT() *MockIConverterMockRecorder {
+	return m.recorder
+}
+
+// Convert mocks base method.
+func (m *MockIConverter) Convert(ctx context.Context, amount model.Money, currency string, date time.Time) (model.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Convert", ctx, amount, currency, date)
+	ret0, _ := ret[0].(model.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Convert indicates an expected call of Convert.
+func (mr *MockIConverterMockRecorder) Convert(ctx, amount, currency, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockIConverter)(nil).Convert), ctx, amount, currency, date)
+}
+
+// MockIRateService is a mock of IRateService interface.
+type MockIRateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRateServiceMockRecorder
+}
+
+// MockIRateServiceMockRecorder is the mock recorder for MockIRateService.
+type MockIRateServiceMockRecorder struct {
+	mock *MockIRateService
+}
+
+// NewMockIRateService creates a new mock instance.
+func NewMockIRateService(ctrl *gomock.Controller) *MockIRateService {
+	mock := &MockIRateService{ctrl: ctrl}
+	mock.recorder = &MockIRateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRateService) EXPECT() *MockIRateServiceMockRecorder {
+	return m.recorder
+}
+
+// Convert mocks base method.
+func (m *MockIRateService) Convert(ctx context.Context, amount model.Money, currency string, date time.Time) (model.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Convert", ctx, amount, currency, date)
+	ret0, _ := ret[0].(model.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Convert indicates an expected call of Convert.
+func (mr *MockIRateServiceMockRecorder) Convert(ctx, amount, currency, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockIRateService)(nil).Convert), ctx, amount, currency, date)
+}
+
+// DeleteRate mocks base method.
+func (m *MockIRateService) DeleteRate(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRate indicates an expected call of DeleteRate.
+func (mr *MockIRateServiceMockRecorder) DeleteRate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRate", reflect.TypeOf((*MockIRateService)(nil).DeleteRate), ctx, id)
+}
+
+// GetRates mocks base method.
+func (m *MockIRateService) GetRates(ctx context.Context) ([]model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx)
+	ret0, _ := ret[0].([]model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockIRateServiceMockRecorder) GetRates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockIRateService)(nil).GetRates), ctx)
+}
+
+// ImportRates mocks base method.
+func (m *MockIRateService) ImportRates(ctx context.Context, csv io.Reader) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRates", ctx, csv)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportRates indicates an expected call of ImportRates.
+func (mr *MockIRateServiceMockRecorder) ImportRates(ctx, csv any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRates", reflect.TypeOf((*MockIRateService)(nil).ImportRates), ctx, csv)
+}
+
+// SaveRate mocks base method.
+func (m *MockIRateService) SaveRate(ctx context.Context, rate model.ExchangeRateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRate indicates an expected call of SaveRate.
+func (mr *MockIRateServiceMockRecorder) SaveRate(ctx, rate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRate", reflect.TypeOf((*MockIRateService)(nil).SaveRate), ctx, rate)
+}
@@ -0,0 +1,208 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/recurring/recurring_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/recurring/recurring_service_interface.go -destination mocks/service/recurring/mock_recurring_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMaterializer is a mock of IMaterializer interface.
+type MockIMaterializer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMaterializerMockRecorder
+}
+
+// MockIMaterializerMockRecorder is the mock recorder for MockIMaterializer.
+type MockIMaterializerMockRecorder struct {
+	mock *MockIMaterializer
+}
+
+// NewMockIMaterializer creates a new mock instance.
+func NewMockIMaterializer(ctrl *gomock.Controller) *MockIMaterializer {
+	mock := &MockIMaterializer{ctrl: ctrl}
+	mock.recorder = &MockIMaterializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMaterializer) EXPECT() *MockIMaterializerMockRecorder {
+	return m.recorder
+}
+
+// MaterializeDue mocks base method.
+func (m *MockIMaterializer) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeDue", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeDue indicates an expected call of MaterializeDue.
+func (mr *MockIMaterializerMockRecorder) MaterializeDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeDue", reflect.TypeOf((*MockIMaterializer)(nil).MaterializeDue), ctx, now)
+}
+
+// MockIRecurringService is a mock of IRecurringService interface.
+type MockIRecurringService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRecurringServiceMockRecorder
+}
+
+// MockIRecurringServiceMockRecorder is the mock recorder for MockIRecurringService.
+type MockIRecurringServiceMockRecorder struct {
+	mock *MockIRecurringService
+}
+
+// NewMockIRecurringService creates a new mock instance.
+func NewMockIRecurringService(ctrl *gomock.Controller) *MockIRecurringService {
+	mock := &MockIRecurringService{ctrl: ctrl}
+	mock.recorder = &MockIRecurringServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRecurringService) EXPECT() *MockIRecurringServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateRecurringTransaction mocks base method.
+func (m *MockIRecurringService) CreateRecurringTransaction(ctx context.Context, userID string, recurring model.RecurringTransactionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurringTransaction", ctx, userID, recurring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecurringTransaction indicates an expected call of CreateRecurringTransaction.
+func (mr *MockIRecurringServiceMockRecorder) CreateRecurringTransaction(ctx, userID, recurring any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurringTransaction", reflect.TypeOf((*MockIRecurringService)(nil).CreateRecurringTransaction), ctx, userID, recurring)
+}
+
+// DeleteRecurringTransaction mocks base method.
+func (m *MockIRecurringService) DeleteRecurringTransaction(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecurringTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecurringTransaction indicates an expected call of DeleteRecurringTransaction.
+func (mr *MockIRecurringServiceMockRecorder) DeleteRecurringTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecurringTransaction", reflect.TypeOf((*MockIRecurringService)(nil).DeleteRecurringTransaction), ctx, id)
+}
+
+// GetRecurringTransaction mocks base method.
+func (m *MockIRecurringService) GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringTransaction", ctx, id)
+	ret0, _ := ret[0].(model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringTransaction indicates an expected call of GetRecurringTransaction.
+func (mr *MockIRecurringServiceMockRecorder) GetRecurringTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringTransaction", reflect.TypeOf((*MockIRecurringService)(nil).GetRecurringTransaction), ctx, id)
+}
+
+// GetUserRecurringTransactions mocks base method.
+func (m *MockIRecurringService) GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRecurringTransactions", ctx, userID)
+	ret0, _ := ret[0].([]model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRecurringTransactions indicates an expected call of GetUserRecurringTransactions.
+func (mr *MockIRecurringServiceMockRecorder) GetUserRecurringTransactions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRecurringTransactions", reflect.TypeOf((*MockIRecurringService)(nil).GetUserRecurringTransactions), ctx, userID)
+}
+
+// MaterializeDue mocks base method.
+func (m *MockIRecurringService) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeDue", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeDue indicates an expected call of MaterializeDue.
+func (mr *MockIRecurringServiceMockRecorder) MaterializeDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeDue", reflect.TypeOf((*MockIRecurringService)(nil).MaterializeDue), ctx, now)
+}
+
+// PauseRecurringTransaction mocks base method.
+func (m *MockIRecurringService) PauseRecurringTransaction(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseRecurringTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseRecurringTransaction indicates an expected call of PauseRecurringTransaction.
+func (mr *MockIRecurringServiceMockRecorder) PauseRecurringTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseRecurringTransaction", reflect.TypeOf((*MockIRecurringService)(nil).PauseRecurringTransaction), ctx, id)
+}
+
+// ResumeRecurringTransaction mocks base method.
+func (m *MockIRecurringService) ResumeRecurringTransaction(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeRecurringTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeRecurringTransaction indicates an expected call of ResumeRecurringTransaction.
+func (mr *MockIRecurringServiceMockRecorder) ResumeRecurringTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeRecurringTransaction", reflect.TypeOf((*MockIRecurringService)(nil).ResumeRecurringTransaction), ctx, id)
+}
+
+// SkipRecurringTransaction mocks base method.
+func (m *MockIRecurringService) SkipRecurringTransaction(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipRecurringTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SkipRecurringTransaction indicates an expected call of SkipRecurringTransaction.
+func (mr *MockIRecurringServiceMockRecorder) SkipRecurringTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipRecurringTransaction", reflect.TypeOf((*MockIRecurringService)(nil).SkipRecurringTransaction), ctx, id)
+}
+
+// UpdateRecurringTransaction mocks base method.
+func (m *MockIRecurringService) UpdateRecurringTransaction(ctx context.Context, id string, recurring model.RecurringTransactionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurringTransaction", ctx, id, recurring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecurringTransaction indicates an expected call of UpdateRecurringTransaction.
+func (mr *MockIRecurringServiceMockRecorder) UpdateRecurringTransaction(ctx, id, recurring any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurringTransaction", reflect.TypeOf((*MockIRecurringService)(nil).UpdateRecurringTransaction), ctx, id, recurring)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/report/report_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/report/report_service_interface.go -destination mocks/service/report/mock_report_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIReportService is a mock of IReportService interface.
+type MockIReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReportServiceMockRecorder
+}
+
+// MockIReportServiceMockRecorder is the mock recorder for MockIReportService.
+type MockIReportServiceMockRecorder struct {
+	mock *MockIReportService
+}
+
+// NewMockIReportService creates a new mock instance.
+func NewMockIReportService(ctrl *gomock.Controller) *MockIReportService {
+	mock := &MockIReportService{ctrl: ctrl}
+	mock.recorder = &MockIReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReportService) EXPECT() *MockIReportServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSummary mocks base method.
+func (m *MockIReportService) GetSummary(ctx context.Context, userID string, from, to time.Time, currency string) (model.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummary", ctx, userID, from, to, currency)
+	ret0, _ := ret[0].(model.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockIReportServiceMockRecorder) GetSummary(ctx, userID, from, to, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockIReportService)(nil).GetSummary), ctx, userID, from, to, currency)
+}
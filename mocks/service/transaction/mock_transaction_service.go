@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/transaction/transaction_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/transaction/transaction_service_interface.go -destination mocks/service/transaction/mock_transaction_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITransactionCreator is a mock of ITransactionCreator interface.
+type MockITransactionCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransactionCreatorMockRecorder
+}
+
+// MockITransactionCreatorMockRecorder is the mock recorder for MockITransactionCreator.
+type MockITransactionCreatorMockRecorder struct {
+	mock *MockITransactionCreator
+}
+
+// NewMockITransactionCreator creates a new mock instance.
+func NewMockITransactionCreator(ctrl *gomock.Controller) *MockITransactionCreator {
+	mock := &MockITransactionCreator{ctrl: ctrl}
+	mock.recorder = &MockITransactionCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransactionCreator) EXPECT() *MockITransactionCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateTransaction mocks base method.
+func (m *MockITransactionCreator) CreateTransaction(ctx context.Context, userID string, transaction model.TransactionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, userID, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockITransactionCreatorMockRecorder) CreateTransaction(ctx, userID, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockITransactionCreator)(nil).CreateTransaction), ctx, userID, transaction)
+}
+
+// MockITransactionService is a mock of ITransactionService interface.
+type MockITransactionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransactionServiceMockRecorder
+}
+
+// MockITransactionServiceMockRecorder is the mock recorder for MockITransactionService.
+type MockITransactionServiceMockRecorder struct {
+	mock *MockITransactionService
+}
+
+// NewMockITransactionService creates a new mock instance.
+func NewMockITransactionService(ctrl *gomock.Controller) *MockITransactionService {
+	mock := &MockITransactionService{ctrl: ctrl}
+	mock.recorder = &MockITransactionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransactionService) EXPECT() *MockITransactionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTransaction mocks base method.
+func (m *MockITransactionService) CreateTransaction(ctx context.Context, userID string, transaction model.TransactionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, userID, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockITransactionServiceMockRecorder) CreateTransaction(ctx, userID, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockITransactionService)(nil).CreateTransaction), ctx, userID, transaction)
+}
+
+// DeleteTransaction mocks base method.
+func (m *MockITransactionService) DeleteTransaction(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockITransactionServiceMockRecorder) DeleteTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockITransactionService)(nil).DeleteTransaction), ctx, id)
+}
+
+// GetTransaction mocks base method.
+func (m *MockITransactionService) GetTransaction(ctx context.Context, id string) (model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, id)
+	ret0, _ := ret[0].(model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockITransactionServiceMockRecorder) GetTransaction(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockITransactionService)(nil).GetTransaction), ctx, id)
+}
+
+// GetUserTransactions mocks base method.
+func (m *MockITransactionService) GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) (model.Page[model.Transaction], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTransactions", ctx, userID, query)
+	ret0, _ := ret[0].(model.Page[model.Transaction])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTransactions indicates an expected call of GetUserTransactions.
+func (mr *MockITransactionServiceMockRecorder) GetUserTransactions(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransactions", reflect.TypeOf((*MockITransactionService)(nil).GetUserTransactions), ctx, userID, query)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockITransactionService) UpdateTransaction(ctx context.Context, id string, transaction model.TransactionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, id, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockITransactionServiceMockRecorder) UpdateTransaction(ctx, id, transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockITransactionService)(nil).UpdateTransaction), ctx, id, transaction)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/transfer/transfer_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/transfer/transfer_service_interface.go -destination mocks/service/transfer/mock_transfer_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITransferService is a mock of ITransferService interface.
+type MockITransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransferServiceMockRecorder
+}
+
+// MockITransferServiceMockRecorder is the mock recorder for MockITransferService.
+type MockITransferServiceMockRecorder struct {
+	mock *MockITransferService
+}
+
+// NewMockITransferService creates a new mock instance.
+func NewMockITransferService(ctrl *gomock.Controller) *MockITransferService {
+	mock := &MockITransferService{ctrl: ctrl}
+	mock.recorder = &MockITransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransferService) EXPECT() *MockITransferServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTransfer mocks base method.
+func (m *MockITransferService) CreateTransfer(ctx context.Context, userID string, transfer model.TransferRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, userID, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockITransferServiceMockRecorder) CreateTransfer(ctx, userID, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockITransferService)(nil).CreateTransfer), ctx, userID, transfer)
+}
+
+// DeleteTransfer mocks base method.
+func (m *MockITransferService) DeleteTransfer(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransfer", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransfer indicates an expected call of DeleteTransfer.
+func (mr *MockITransferServiceMockRecorder) DeleteTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransfer", reflect.TypeOf((*MockITransferService)(nil).DeleteTransfer), ctx, id)
+}
+
+// GetTransfer mocks base method.
+func (m *MockITransferService) GetTransfer(ctx context.Context, id string) (model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockITransferServiceMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockITransferService)(nil).GetTransfer), ctx, id)
+}
+
+// GetUserTransfers mocks base method.
+func (m *MockITransferService) GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTransfers", ctx, userID)
+	ret0, _ := ret[0].([]model.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTransfers indicates an expected call of GetUserTransfers.
+func (mr *MockITransferServiceMockRecorder) GetUserTransfers(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTransfers", reflect.TypeOf((*MockITransferService)(nil).GetUserTransfers), ctx, userID)
+}
+
+// UpdateTransfer mocks base method.
+func (m *MockITransferService) UpdateTransfer(ctx context.Context, id string, transfer model.TransferRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransfer", ctx, id, transfer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransfer indicates an expected call of UpdateTransfer.
+func (mr *MockITransferServiceMockRecorder) UpdateTransfer(ctx, id, transfer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransfer", reflect.TypeOf((*MockITransferService)(nil).UpdateTransfer), ctx, id, transfer)
+}
@@ -1,14 +1,15 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: service/user_interface.go
+// Source: service/user/user_service_interface.go
 //
 // Generated by this command:
 //
-//	mockgen -source service/user_interface.go -destination service/mock_user_service.go -package service
+//	mockgen -source service/user/user_service_interface.go -destination mocks/service/user/mock_user_service.go
 //
-// Package service is a generated GoMock package.
-package service
+// Package mock_service is a generated GoMock package.
+package mock_service
 
 import (
+	context "context"
 	reflect "reflect"
 
 	model "github.com/varomnrg/money-tracker/model"
@@ -39,73 +40,88 @@ func (m *MockIUserService) EXPECT() *MockIUserServiceMockRecorder {
 }
 
 // CreateUser mocks base method.
-func (m *MockIUserService) CreateUser(movie model.UserRequest) error {
+func (m *MockIUserService) CreateUser(ctx context.Context, movie model.UserRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", movie)
+	ret := m.ctrl.Call(m, "CreateUser", ctx, movie)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateUser indicates an expected call of CreateUser.
-func (mr *MockIUserServiceMockRecorder) CreateUser(movie any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) CreateUser(ctx, movie any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIUserService)(nil).CreateUser), movie)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIUserService)(nil).CreateUser), ctx, movie)
 }
 
 // DeleteUser mocks base method.
-func (m *MockIUserService) DeleteUser(id string) error {
+func (m *MockIUserService) DeleteUser(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", id)
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockIUserServiceMockRecorder) DeleteUser(id any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIUserService)(nil).DeleteUser), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIUserService)(nil).DeleteUser), ctx, id)
 }
 
 // GetUser mocks base method.
-func (m *MockIUserService) GetUser(id string) (model.UserResponse, error) {
+func (m *MockIUserService) GetUser(ctx context.Context, id string) (model.UserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", id)
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
 	ret0, _ := ret[0].(model.UserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUser indicates an expected call of GetUser.
-func (mr *MockIUserServiceMockRecorder) GetUser(id any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) GetUser(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockIUserService)(nil).GetUser), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockIUserService)(nil).GetUser), ctx, id)
 }
 
 // GetUsers mocks base method.
-func (m *MockIUserService) GetUsers() ([]model.UserResponse, error) {
+func (m *MockIUserService) GetUsers(ctx context.Context, query model.ListQuery) (model.Page[model.UserResponse], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsers")
-	ret0, _ := ret[0].([]model.UserResponse)
+	ret := m.ctrl.Call(m, "GetUsers", ctx, query)
+	ret0, _ := ret[0].(model.Page[model.UserResponse])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUsers indicates an expected call of GetUsers.
-func (mr *MockIUserServiceMockRecorder) GetUsers() *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) GetUsers(ctx, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockIUserService)(nil).GetUsers))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockIUserService)(nil).GetUsers), ctx, query)
 }
 
 // UpdateUser mocks base method.
-func (m *MockIUserService) UpdateUser(id string, movie model.UserRequest) error {
+func (m *MockIUserService) UpdateUser(ctx context.Context, id string, movie model.UserRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", id, movie)
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, id, movie)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockIUserServiceMockRecorder) UpdateUser(id, movie any) *gomock.Call {
+func (mr *MockIUserServiceMockRecorder) UpdateUser(ctx, id, movie any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIUserService)(nil).UpdateUser), id, movie)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockIUserService)(nil).UpdateUser), ctx, id, movie)
+}
+
+// VerifyCredentials mocks base method.
+func (m *MockIUserService) VerifyCredentials(ctx context.Context, username, password string) (model.UserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCredentials", ctx, username, password)
+	ret0, _ := ret[0].(model.UserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyCredentials indicates an expected call of VerifyCredentials.
+func (mr *MockIUserServiceMockRecorder) VerifyCredentials(ctx, username, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCredentials", reflect.TypeOf((*MockIUserService)(nil).VerifyCredentials), ctx, username, password)
 }
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/wallet/wallet_service_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source service/wallet/wallet_service_interface.go -destination mocks/service/wallet/mock_wallet_service.go
+//
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/varomnrg/money-tracker/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIWalletService is a mock of IWalletService interface.
+type MockIWalletService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWalletServiceMockRecorder
+}
+
+// MockIWalletServiceMockRecorder is the mock recorder for MockIWalletService.
+type MockIWalletServiceMockRecorder struct {
+	mock *MockIWalletService
+}
+
+// NewMockIWalletService creates a new mock instance.
+func NewMockIWalletService(ctrl *gomock.Controller) *MockIWalletService {
+	mock := &MockIWalletService{ctrl: ctrl}
+	mock.recorder = &MockIWalletServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWalletService) EXPECT() *MockIWalletServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateWallet mocks base method.
+func (m *MockIWalletService) CreateWallet(ctx context.Context, userID string, wallet model.WalletRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", ctx, userID, wallet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWallet indicates an expected call of CreateWallet.
+func (mr *MockIWalletServiceMockRecorder) CreateWallet(ctx, userID, wallet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockIWalletService)(nil).CreateWallet), ctx, userID, wallet)
+}
+
+// DeleteWallet mocks base method.
+func (m *MockIWalletService) DeleteWallet(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWallet", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWallet indicates an expected call of DeleteWallet.
+func (mr *MockIWalletServiceMockRecorder) DeleteWallet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWallet", reflect.TypeOf((*MockIWalletService)(nil).DeleteWallet), ctx, id)
+}
+
+// GetUserNetTotal mocks base method.
+func (m *MockIWalletService) GetUserNetTotal(ctx context.Context, userID, currency string, date time.Time) (model.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserNetTotal", ctx, userID, currency, date)
+	ret0, _ := ret[0].(model.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserNetTotal indicates an expected call of GetUserNetTotal.
+func (mr *MockIWalletServiceMockRecorder) GetUserNetTotal(ctx, userID, currency, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNetTotal", reflect.TypeOf((*MockIWalletService)(nil).GetUserNetTotal), ctx, userID, currency, date)
+}
+
+// GetUserWallets mocks base method.
+func (m *MockIWalletService) GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWallets", ctx, userID)
+	ret0, _ := ret[0].([]model.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWallets indicates an expected call of GetUserWallets.
+func (mr *MockIWalletServiceMockRecorder) GetUserWallets(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWallets", reflect.TypeOf((*MockIWalletService)(nil).GetUserWallets), ctx, userID)
+}
+
+// GetWallet mocks base method.
+func (m *MockIWalletService) GetWallet(ctx context.Context, id string) (model.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet", ctx, id)
+	ret0, _ := ret[0].(model.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockIWalletServiceMockRecorder) GetWallet(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockIWalletService)(nil).GetWallet), ctx, id)
+}
+
+// UpdateWallet mocks base method.
+func (m *MockIWalletService) UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWallet", ctx, id, wallet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWallet indicates an expected call of UpdateWallet.
+func (mr *MockIWalletServiceMockRecorder) UpdateWallet(ctx, id, wallet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWallet", reflect.TypeOf((*MockIWalletService)(nil).UpdateWallet), ctx, id, wallet)
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+const (
+	ScopeRead      = "read"
+	ScopeReadWrite = "read_write"
+)
+
+type APIKey struct {
+	ID           string     `json:"id"`
+	User_ID      string     `json:"user_id"`
+	Name         string     `json:"name"`
+	Scope        string     `json:"scope"`
+	Prefix       string     `json:"prefix"`
+	Key_Hash     string     `json:"-"`
+	Created_At   time.Time  `json:"created_at"`
+	Last_Used_At *time.Time `json:"last_used_at"`
+}
+
+type APIKeyRequest struct {
+	Name  string `json:"name" validate:"required,min=3,max=50"`
+	Scope string `json:"scope" validate:"required,oneof=read read_write"`
+}
+
+// APIKeyCreated is returned once when a key is created. The plaintext key is
+// not stored and cannot be shown again.
+type APIKeyCreated struct {
+	APIKey
+	Key string `json:"key"`
+}
@@ -0,0 +1,41 @@
+package model
+
+type LoginRequest struct {
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+type TokenResponse struct {
+	Access_Token  string `json:"access_token"`
+	Token_Type    string `json:"token_type"`
+	Expires_In    int64  `json:"expires_in"`
+	Refresh_Token string `json:"refresh_token"`
+}
+
+// AuthUser is the authenticated caller of a request. Scope is only set when
+// the caller authenticated with an API key.
+type AuthUser struct {
+	ID    string
+	Role  string
+	Scope string
+}
+
+func (u AuthUser) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+func (u AuthUser) IsAPIKey() bool {
+	return u.Scope != ""
+}
+
+// CanWrite reports whether the caller may change data. Read-only API keys
+// cannot.
+func (u AuthUser) CanWrite() bool {
+	return u.Scope != ScopeRead
+}
+
+// CanAccess reports whether the caller may read or change a resource owned by
+// ownerID. Admins can access every resource.
+func (u AuthUser) CanAccess(ownerID string) bool {
+	return u.IsAdmin() || (u.ID != "" && u.ID == ownerID)
+}
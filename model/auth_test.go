@@ -0,0 +1,28 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+func TestAuthUserCanAccess(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    model.AuthUser
+		ownerID string
+		want    bool
+	}{
+		{"Owner", model.AuthUser{ID: "user-1", Role: model.RoleUser}, "user-1", true},
+		{"Another user", model.AuthUser{ID: "user-1", Role: model.RoleUser}, "user-2", false},
+		{"Admin", model.AuthUser{ID: "user-admin", Role: model.RoleAdmin}, "user-2", true},
+		{"Anonymous", model.AuthUser{}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.user.CanAccess(tt.ownerID))
+		})
+	}
+}
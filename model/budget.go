@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// Budget limits a user's spending in one category during one calendar month.
+type Budget struct {
+	ID          string `json:"id"`
+	User_ID     string `json:"user_id"`
+	Category_ID string `json:"category_id"`
+	// Month is the budgeted month as YYYY-MM.
+	Month  string `json:"month"`
+	Amount Money  `json:"amount"`
+	// Rollover adds what is left of the category's budget for the previous
+	// month, if there is one, to this month's amount.
+	Rollover   bool      `json:"rollover"`
+	Created_At time.Time `json:"created_at"`
+}
+
+// BudgetRequest creates or updates a budget. The currency of Amount defaults
+// to DefaultCurrency.
+type BudgetRequest struct {
+	Category_ID string `json:"category_id" validate:"required"`
+	Month       string `json:"month" validate:"required,datetime=2006-01"`
+	Amount      Money  `json:"amount"`
+	Rollover    bool   `json:"rollover"`
+}
+
+// BudgetProgress is a budget with the spending in its month, all in the
+// budget's currency. Available is Amount plus Carried_Over; Remaining is
+// negative once the budget is overspent.
+type BudgetProgress struct {
+	Budget
+	Carried_Over Money   `json:"carried_over"`
+	Available    Money   `json:"available"`
+	Spent        Money   `json:"spent"`
+	Remaining    Money   `json:"remaining"`
+	Percentage   float64 `json:"percentage"`
+	// Unavailable says why the spending could not be measured, such as a
+	// missing exchange rate. The amounts above are zero when it is set.
+	Unavailable string `json:"unavailable,omitempty"`
+}
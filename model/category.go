@@ -9,4 +9,11 @@ type Category struct {
 type CategoryRequest struct {
 	Name string `json:"name" validate:"required,min=3,max=20,alphanum"`
 }
-	
\ No newline at end of file
+
+// CategorySorts lists the orders categories can be listed in. The first is
+// the default.
+var CategorySorts = []string{"name"}
+
+func (c Category) Cursor(sort string) Cursor {
+	return Cursor{Sort: sort, Key: c.Name, ID: c.ID}
+}
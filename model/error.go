@@ -1,5 +1,88 @@
 package model
 
-type ErrorResponse struct {
-	Error string `json:"error"`
+import "errors"
+
+// ErrorCode classifies an Error by what went wrong rather than where, so
+// that callers can react to a failure without knowing which package raised
+// it.
+type ErrorCode string
+
+const (
+	CodeValidation   ErrorCode = "validation"
+	CodeUnauthorized ErrorCode = "unauthorized"
+	CodeForbidden    ErrorCode = "forbidden"
+	CodeNotFound     ErrorCode = "not_found"
+	CodeConflict     ErrorCode = "conflict"
+	CodeInternal     ErrorCode = "internal"
+)
+
+// Error is a failure that can be reported to the client as it is. Services
+// declare their sentinel errors with NewError and callers match them with
+// errors.Is as before.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	// Fields maps request fields to what is wrong with them. It is only set
+	// on validation errors.
+	Fields map[string]string
+}
+
+// Kind sentinels match every Error with the same code, e.g.
+// errors.Is(err, ErrNotFound) holds for any not-found error.
+var (
+	ErrValidation   = &Error{Code: CodeValidation}
+	ErrUnauthorized = &Error{Code: CodeUnauthorized}
+	ErrForbidden    = &Error{Code: CodeForbidden}
+	ErrNotFound     = &Error{Code: CodeNotFound}
+	ErrConflict     = &Error{Code: CodeConflict}
+)
+
+// Every storage backend reports a write that breaks a constraint of the
+// schema with one of these, whatever its own error was.
+var (
+	ErrDuplicateKey = NewError(CodeConflict, "resource already exists")
+	ErrForeignKey   = NewError(CodeConflict, "resource is in use or refers to a missing resource")
+)
+
+func NewError(code ErrorCode, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// NewValidationError returns a validation error listing the invalid fields.
+func NewValidationError(fields map[string]string) *Error {
+	return &Error{Code: CodeValidation, Message: "request is not valid", Fields: fields}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is the kind sentinel for e's code.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+
+	return ok && t.Message == "" && t.Code == e.Code
+}
+
+// CodeOf returns the code of the first Error in err's chain, or
+// CodeInternal when there is none.
+func CodeOf(err error) ErrorCode {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+
+	return CodeInternal
+}
+
+// Problem is an error response in the RFC 7807 problem details format,
+// extended with the error code and, for validation errors, the invalid
+// fields.
+type Problem struct {
+	Type   string            `json:"type"`
+	Title  string            `json:"title"`
+	Status int               `json:"status"`
+	Detail string            `json:"detail"`
+	Code   ErrorCode         `json:"code"`
+	Errors map[string]string `json:"errors,omitempty"`
 }
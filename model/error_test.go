@@ -0,0 +1,26 @@
+package model_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+func TestError_Is(t *testing.T) {
+	errNotFound := model.NewError(model.CodeNotFound, "wallet cannot be found")
+	wrapped := fmt.Errorf("get wallet: %w", errNotFound)
+
+	assert.ErrorIs(t, wrapped, errNotFound)
+	assert.ErrorIs(t, wrapped, model.ErrNotFound, "Expected a match on the kind sentinel")
+	assert.NotErrorIs(t, wrapped, model.ErrConflict)
+	assert.NotErrorIs(t, wrapped, model.NewError(model.CodeNotFound, "user cannot be found"), "Expected errors with the same code to stay distinct")
+}
+
+func TestCodeOf(t *testing.T) {
+	assert.Equal(t, model.CodeValidation, model.CodeOf(fmt.Errorf("%w: USD and IDR", model.ErrCurrencyMismatch)))
+	assert.Equal(t, model.CodeInternal, model.CodeOf(errors.New("connection refused")))
+	assert.Equal(t, model.CodeInternal, model.CodeOf(nil))
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// ExchangeRate states that one unit of Base is worth Rate units of Quote from
+// Date onwards, until a newer rate for the same pair takes over.
+type ExchangeRate struct {
+	ID    string    `json:"id"`
+	Base  string    `json:"base"`
+	Quote string    `json:"quote"`
+	Rate  float64   `json:"rate"`
+	Date  time.Time `json:"date"`
+}
+
+type ExchangeRateRequest struct {
+	Base  string    `json:"base" validate:"required,iso4217"`
+	Quote string    `json:"quote" validate:"required,iso4217,nefield=Base"`
+	Rate  float64   `json:"rate" validate:"required,gt=0"`
+	Date  time.Time `json:"date" validate:"required"`
+}
@@ -0,0 +1,165 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+var ErrInvalidCursor = NewError(CodeValidation, "cursor is not valid")
+
+// ListQuery selects one page of a listing. Sort names the field to order by,
+// prefixed with "-" for descending order; ties are broken by ID in the same
+// direction. Search matches a case-insensitive substring of the listing's
+// text fields.
+type ListQuery struct {
+	Search string
+	Sort   string
+	// Limit caps the number of items returned; zero means no cap.
+	Limit int
+	// After continues the listing past the item it points at.
+	After *Cursor
+}
+
+// SortField splits Sort into the field name and whether the order is
+// descending.
+func (q ListQuery) SortField() (string, bool) {
+	if field, ok := strings.CutPrefix(q.Sort, "-"); ok {
+		return field, true
+	}
+
+	return q.Sort, false
+}
+
+// Prepare fills in defaults and checks the query against the fields a
+// listing can be sorted by. The first of sorts is the default order.
+func (q *ListQuery) Prepare(sorts ...string) error {
+	if q.Sort == "" {
+		q.Sort = sorts[0]
+	}
+
+	field, _ := q.SortField()
+	if !slices.ContainsFunc(sorts, func(sort string) bool { return strings.TrimPrefix(sort, "-") == field }) {
+		return NewError(CodeValidation, fmt.Sprintf("sort must be one of [%s], optionally prefixed with -", sortFields(sorts)))
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = DefaultPageSize
+	}
+
+	if q.Limit > MaxPageSize {
+		return NewError(CodeValidation, fmt.Sprintf("limit must be at most %d", MaxPageSize))
+	}
+
+	if q.After != nil && q.After.Sort != q.Sort {
+		return ErrInvalidCursor
+	}
+
+	return nil
+}
+
+func sortFields(sorts []string) string {
+	fields := make([]string, 0, len(sorts))
+
+	for _, sort := range sorts {
+		fields = append(fields, strings.TrimPrefix(sort, "-"))
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// Cursor points at the last item of a page by its sort key and ID. Clients
+// see it only as an opaque string.
+type Cursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   string `json:"i"`
+}
+
+func (c Cursor) String() string {
+	b, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParseCursor decodes a cursor produced by Cursor.String.
+func ParseCursor(value string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := Cursor{}
+
+	err = json.Unmarshal(b, &cursor)
+	if err != nil || cursor.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// TimeKey returns the cursor's sort key as a time.
+func (c Cursor) TimeKey() (time.Time, error) {
+	key, err := time.Parse(time.RFC3339Nano, c.Key)
+	if err != nil {
+		return time.Time{}, ErrInvalidCursor
+	}
+
+	return key, nil
+}
+
+// IntKey returns the cursor's sort key as an integer.
+func (c Cursor) IntKey() (int64, error) {
+	key, err := strconv.ParseInt(c.Key, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	return key, nil
+}
+
+// Listed is implemented by items that can be paged through.
+type Listed interface {
+	// Cursor returns the cursor pointing at the item in a listing ordered by
+	// sort.
+	Cursor(sort string) Cursor
+}
+
+// Page is one page of a listing. Next_Cursor is empty on the last page.
+type Page[T any] struct {
+	Items       []T    `json:"items"`
+	Next_Cursor string `json:"next_cursor,omitempty"`
+}
+
+// Lookahead returns the query to fetch a page with: it asks for one item
+// more than Limit so that NewPage can tell whether a next page exists.
+func (q ListQuery) Lookahead() ListQuery {
+	q.Limit++
+
+	return q
+}
+
+// NewPage builds a page from the items fetched with query.Lookahead.
+func NewPage[T Listed](items []T, query ListQuery) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+
+	if len(items) <= query.Limit {
+		return Page[T]{Items: items}
+	}
+
+	items = items[:query.Limit]
+
+	return Page[T]{Items: items, Next_Cursor: items[len(items)-1].Cursor(query.Sort).String()}
+}
@@ -0,0 +1,63 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := model.Cursor{Sort: "-date", Key: "2024-01-02T10:00:00Z", ID: "trx-1"}
+
+	parsed, err := model.ParseCursor(cursor.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *parsed)
+
+	for _, value := range []string{"", "not base64!", "bm90IGpzb24", cursorWithoutID()} {
+		_, err := model.ParseCursor(value)
+		assert.ErrorIs(t, err, model.ErrInvalidCursor, value)
+	}
+}
+
+func cursorWithoutID() string {
+	return model.Cursor{Sort: "name", Key: "Food"}.String()
+}
+
+func TestListQuery_Prepare(t *testing.T) {
+	query := model.ListQuery{}
+	require.NoError(t, query.Prepare(model.TransactionSorts...))
+	assert.Equal(t, model.ListQuery{Sort: "-date", Limit: model.DefaultPageSize}, query)
+
+	query = model.ListQuery{Sort: "date", Limit: 10}
+	require.NoError(t, query.Prepare(model.TransactionSorts...), "Expected either direction to be allowed")
+
+	after := model.Cursor{Sort: "-amount", ID: "trx-1"}
+	invalid := []model.ListQuery{
+		{Sort: "description"},
+		{Limit: model.MaxPageSize + 1},
+		{Sort: "amount", After: &after},
+	}
+
+	for _, query := range invalid {
+		assert.ErrorIs(t, query.Prepare(model.TransactionSorts...), model.ErrValidation, query)
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	categories := []model.Category{{ID: "cat-1", Name: "Food"}, {ID: "cat-2", Name: "Fuel"}, {ID: "cat-3", Name: "Rent"}}
+	query := model.ListQuery{Sort: "name", Limit: 2}
+
+	page := model.NewPage(categories, query)
+
+	assert.Equal(t, categories[:2], page.Items)
+	assert.Equal(t, categories[1].Cursor("name").String(), page.Next_Cursor)
+
+	last := model.NewPage(categories[2:], query)
+
+	assert.Equal(t, categories[2:], last.Items)
+	assert.Empty(t, last.Next_Cursor)
+	assert.NotNil(t, model.NewPage[model.Category](nil, query).Items, "Expected an empty page to list no items rather than null")
+}
@@ -0,0 +1,300 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is used for amounts that do not state a currency.
+const DefaultCurrency = "IDR"
+
+var (
+	ErrCurrencyMismatch = NewError(CodeValidation, "currency mismatch")
+	ErrInvalidAmount    = NewError(CodeValidation, "amount is not a valid decimal")
+)
+
+// currencyExponents holds the number of minor-unit digits for ISO 4217
+// currencies that do not use the usual two.
+var currencyExponents = map[string]int{
+	"BHD": 3,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// CurrencyExponent returns the number of decimal places used by currency.
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+
+	return 2
+}
+
+// Money is an exact monetary amount stored as an integer number of minor
+// units (e.g. cents) of an ISO 4217 currency.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney parses a decimal string such as "-1250.5" into minor units of
+// currency. Fractions finer than the currency's minor unit are rejected.
+func ParseMoney(value string, currency string) (Money, error) {
+	exponent := CurrencyExponent(currency)
+
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+
+	whole, fraction, hasPoint := strings.Cut(value, ".")
+	if whole == "" && fraction == "" || hasPoint && fraction == "" || len(fraction) > exponent {
+		return Money{}, ErrInvalidAmount
+	}
+
+	digits := whole + fraction + strings.Repeat("0", exponent-len(fraction))
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Money{}, ErrInvalidAmount
+		}
+	}
+
+	amount, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, ErrInvalidAmount
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) Neg() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Add returns m + o. A zero value without a currency adopts the currency of
+// the other operand, so totals can start from Money{}.
+func (m Money) Add(o Money) (Money, error) {
+	switch {
+	case m.Currency == "" && m.Amount == 0:
+		m.Currency = o.Currency
+	case o.Currency == "" && o.Amount == 0:
+		o.Currency = m.Currency
+	}
+
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(o Money) (Money, error) {
+	return m.Add(o.Neg())
+}
+
+// Convert multiplies m by rate and expresses the result in currency, rounding
+// half away from zero to the nearest minor unit of currency.
+func (m Money) Convert(rate float64, currency string) Money {
+	value := new(big.Rat).SetFrac(big.NewInt(m.Amount), pow10(CurrencyExponent(m.Currency)))
+	value.Mul(value, new(big.Rat).SetFloat64(rate))
+	value.Mul(value, new(big.Rat).SetInt(pow10(CurrencyExponent(currency))))
+
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(value.Denom()) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+
+	return Money{Amount: quotient.Int64(), Currency: currency}
+}
+
+// Decimal formats the amount as a decimal string, e.g. "-1250.50".
+func (m Money) Decimal() string {
+	exponent := CurrencyExponent(m.Currency)
+
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+
+	digits := strconv.FormatUint(absInt64(amount), 10)
+	if exponent == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+
+	point := len(digits) - exponent
+
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+type moneyJSON struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	amount, err := json.Marshal(m.Decimal())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(moneyJSON{Amount: amount, Currency: m.Currency})
+}
+
+// UnmarshalJSON accepts the amount either as a decimal string or as a bare
+// JSON number; both are parsed exactly without going through float64.
+// Without a currency, the amount is read in DefaultCurrency's minor units.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var request MoneyRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return err
+	}
+
+	parsed, err := request.Money(DefaultCurrency)
+	if err != nil {
+		return err
+	}
+
+	m.Amount = parsed.Amount
+	m.Currency = request.Currency
+
+	return nil
+}
+
+// MoneyRequest is an amount as entered by a client. The currency may be left
+// to the server, and the number of minor units depends on it, so the amount
+// is kept as a decimal until the currency is known.
+type MoneyRequest struct {
+	Amount   string
+	Currency string
+}
+
+// NewMoneyRequest returns the request that enters m.
+func NewMoneyRequest(m Money) MoneyRequest {
+	return MoneyRequest{Amount: m.Decimal(), Currency: m.Currency}
+}
+
+// Money parses the amount in the request's currency, or in currency if the
+// request has none.
+func (r MoneyRequest) Money(currency string) (Money, error) {
+	if r.Currency != "" {
+		currency = r.Currency
+	}
+
+	return ParseMoney(r.Amount, currency)
+}
+
+func (r MoneyRequest) MarshalJSON() ([]byte, error) {
+	amount, err := json.Marshal(r.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(moneyJSON{Amount: amount, Currency: r.Currency})
+}
+
+// UnmarshalJSON accepts the amount either as a decimal string or as a bare
+// JSON number, keeping its digits as they are. An amount with a currency is
+// checked straight away; one without waits for Money.
+func (r *MoneyRequest) UnmarshalJSON(data []byte) error {
+	var raw moneyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if len(raw.Amount) == 0 {
+		return ErrInvalidAmount
+	}
+
+	amount := string(raw.Amount)
+	if strings.HasPrefix(amount, `"`) {
+		if err := json.Unmarshal(raw.Amount, &amount); err != nil {
+			return err
+		}
+	}
+
+	if raw.Currency != "" {
+		if _, err := ParseMoney(amount, raw.Currency); err != nil {
+			return err
+		}
+	}
+
+	r.Amount = amount
+	r.Currency = raw.Currency
+
+	return nil
+}
+
+// Value stores the amount as an integer number of minor units. The currency
+// lives in its own column.
+func (m Money) Value() (driver.Value, error) {
+	return m.Amount, nil
+}
+
+// Scan reads an integer number of minor units, leaving Currency untouched.
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case int64:
+		m.Amount = v
+	case []byte:
+		return m.Scan(string(v))
+	case string:
+		amount, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("scan money: %w", err)
+		}
+		m.Amount = amount
+	default:
+		return fmt.Errorf("scan money: unsupported type %T", src)
+	}
+
+	return nil
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func absInt64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-v)
+	}
+
+	return uint64(v)
+}
@@ -0,0 +1,204 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		currency string
+		want     int64
+		wantErr  bool
+	}{
+		{name: "whole number", value: "1250", currency: "IDR", want: 125000},
+		{name: "fraction", value: "1250.5", currency: "IDR", want: 125050},
+		{name: "negative", value: "-0.01", currency: "USD", want: -1},
+		{name: "leading point", value: ".25", currency: "USD", want: 25},
+		{name: "zero exponent", value: "1500", currency: "JPY", want: 1500},
+		{name: "three decimals", value: "1.005", currency: "KWD", want: 1005},
+		{name: "too precise", value: "1.005", currency: "USD", wantErr: true},
+		{name: "fraction for zero exponent", value: "1.5", currency: "JPY", wantErr: true},
+		{name: "empty", value: "", currency: "IDR", wantErr: true},
+		{name: "trailing point", value: "1.", currency: "IDR", wantErr: true},
+		{name: "letters", value: "12a", currency: "IDR", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			money, err := model.ParseMoney(tt.value, tt.currency)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidAmount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, money.Amount)
+			assert.Equal(t, tt.currency, money.Currency)
+		})
+	}
+}
+
+func TestMoneyDecimal(t *testing.T) {
+	assert.Equal(t, "1250.50", model.NewMoney(125050, "IDR").Decimal())
+	assert.Equal(t, "-0.05", model.NewMoney(-5, "USD").Decimal())
+	assert.Equal(t, "0.00", model.NewMoney(0, "SGD").Decimal())
+	assert.Equal(t, "1500", model.NewMoney(1500, "JPY").Decimal())
+	assert.Equal(t, "1.005", model.NewMoney(1005, "KWD").Decimal())
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	t.Run("Add same currency", func(t *testing.T) {
+		total, err := model.NewMoney(10, "IDR").Add(model.NewMoney(20, "IDR"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(30, "IDR"), total)
+	})
+
+	t.Run("Add to zero value", func(t *testing.T) {
+		total, err := model.Money{}.Add(model.NewMoney(20, "USD"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(20, "USD"), total)
+	})
+
+	t.Run("Add different currency", func(t *testing.T) {
+		_, err := model.NewMoney(10, "IDR").Add(model.NewMoney(20, "USD"))
+
+		assert.ErrorIs(t, err, model.ErrCurrencyMismatch)
+	})
+
+	t.Run("Sub", func(t *testing.T) {
+		total, err := model.NewMoney(10, "IDR").Sub(model.NewMoney(25, "IDR"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(-15, "IDR"), total)
+		assert.True(t, total.IsNegative())
+	})
+
+	t.Run("Summing many small amounts is exact", func(t *testing.T) {
+		total := model.Money{}
+		for i := 0; i < 10000; i++ {
+			total, _ = total.Add(model.NewMoney(10, "IDR"))
+		}
+
+		assert.Equal(t, "1000.00", total.Decimal())
+	})
+}
+
+func TestMoneyConvert(t *testing.T) {
+	tests := []struct {
+		name  string
+		money model.Money
+		rate  float64
+		to    string
+		want  model.Money
+	}{
+		{name: "USD to IDR", money: model.NewMoney(1050, "USD"), rate: 15600, to: "IDR", want: model.NewMoney(16380000, "IDR")},
+		{name: "IDR to USD rounds half up", money: model.NewMoney(1000000, "IDR"), rate: 0.000064, to: "USD", want: model.NewMoney(64, "USD")},
+		{name: "SGD to JPY", money: model.NewMoney(250, "SGD"), rate: 110.5, to: "JPY", want: model.NewMoney(276, "JPY")},
+		{name: "negative rounds away from zero", money: model.NewMoney(-5, "USD"), rate: 0.5, to: "EUR", want: model.NewMoney(-3, "EUR")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.money.Convert(tt.rate, tt.to))
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	t.Run("Marshal", func(t *testing.T) {
+		data, err := json.Marshal(model.NewMoney(125050, "IDR"))
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"amount":"1250.50","currency":"IDR"}`, string(data))
+	})
+
+	t.Run("Unmarshal decimal string", func(t *testing.T) {
+		var money model.Money
+		err := json.Unmarshal([]byte(`{"amount":"19.99","currency":"USD"}`), &money)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(1999, "USD"), money)
+	})
+
+	t.Run("Unmarshal number", func(t *testing.T) {
+		var money model.Money
+		err := json.Unmarshal([]byte(`{"amount":0.1,"currency":"USD"}`), &money)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(10, "USD"), money)
+	})
+
+	t.Run("Unmarshal invalid amount", func(t *testing.T) {
+		var money model.Money
+		err := json.Unmarshal([]byte(`{"amount":"abc","currency":"USD"}`), &money)
+
+		assert.ErrorIs(t, err, model.ErrInvalidAmount)
+	})
+}
+
+func TestMoneyRequest(t *testing.T) {
+	t.Run("Unmarshal keeps the digits", func(t *testing.T) {
+		var request model.MoneyRequest
+		err := json.Unmarshal([]byte(`{"amount":1500}`), &request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.MoneyRequest{Amount: "1500"}, request)
+	})
+
+	t.Run("Money in the currency known later", func(t *testing.T) {
+		jpy, err := model.MoneyRequest{Amount: "1500"}.Money("JPY")
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(1500, "JPY"), jpy)
+
+		kwd, err := model.MoneyRequest{Amount: "1.5"}.Money("KWD")
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(1500, "KWD"), kwd)
+
+		_, err = model.MoneyRequest{Amount: "1.5"}.Money("JPY")
+		assert.ErrorIs(t, err, model.ErrInvalidAmount, "Expected JPY to have no minor units")
+	})
+
+	t.Run("Money in its own currency", func(t *testing.T) {
+		usd, err := model.MoneyRequest{Amount: "19.99", Currency: "USD"}.Money("JPY")
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(1999, "USD"), usd)
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		request := model.NewMoneyRequest(model.NewMoney(125050, "IDR"))
+		data, err := json.Marshal(request)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"amount":"1250.50","currency":"IDR"}`, string(data))
+
+		var decoded model.MoneyRequest
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, request, decoded)
+	})
+}
+
+func TestMoneySQL(t *testing.T) {
+	value, err := model.NewMoney(125050, "IDR").Value()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(125050), value)
+
+	money := model.Money{Currency: "IDR"}
+
+	assert.NoError(t, money.Scan(int64(42)))
+	assert.Equal(t, model.NewMoney(42, "IDR"), money)
+
+	assert.NoError(t, money.Scan([]byte("-7")))
+	assert.Equal(t, int64(-7), money.Amount)
+
+	assert.Error(t, money.Scan(1.5))
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// NotificationBudgetThreshold reports that spending in a category reached a
+// share of its monthly budget.
+const NotificationBudgetThreshold = "budget_threshold"
+
+// Notification is a message in a user's inbox.
+type Notification struct {
+	ID      string `json:"id"`
+	User_ID string `json:"user_id"`
+	Kind    string `json:"kind"`
+	// Key identifies the event the notification reports. A user is notified
+	// of each event at most once.
+	Key        string     `json:"-"`
+	Title      string     `json:"title"`
+	Message    string     `json:"message"`
+	Read_At    *time.Time `json:"read_at"`
+	Created_At time.Time  `json:"created_at"`
+}
+
+// NotificationSorts lists the orders notifications can be listed in. The
+// first is the default.
+var NotificationSorts = []string{"-created_at"}
+
+// NotificationQuery selects a page of a user's notifications. Search matches
+// the title and the message.
+type NotificationQuery struct {
+	ListQuery
+	Unread bool
+}
+
+func (n Notification) Cursor(sort string) Cursor {
+	return Cursor{Sort: sort, Key: n.Created_At.UTC().Format(time.RFC3339Nano), ID: n.ID}
+}
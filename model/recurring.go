@@ -0,0 +1,199 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+	FrequencyYearly  = "yearly"
+)
+
+// Weekdays maps the weekday names of Recurrence.Weekdays, as used by
+// iCalendar, to time.Weekday.
+var Weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Recurrence is a schedule modelled on the iCalendar RRULE: it repeats every
+// Interval days, weeks, months or years from Start_Date until Count
+// occurrences have passed or Until is reached, whichever comes first.
+// Occurrences keep the time of day of Start_Date. Monthly and yearly
+// occurrences fall on the day of month of Start_Date, or on the last day of
+// months too short for it.
+type Recurrence struct {
+	Frequency string `json:"frequency" validate:"required,oneof=daily weekly monthly yearly"`
+	// Interval is the number of periods between occurrences; 0 means 1.
+	Interval int `json:"interval" validate:"gte=0,lte=1000"`
+	// Weekdays lists the days of the week a weekly schedule falls on, such
+	// as ["MO", "TH"]. Empty means the weekday of Start_Date.
+	Weekdays   []string   `json:"weekdays,omitempty" validate:"dive,oneof=MO TU WE TH FR SA SU"`
+	Start_Date time.Time  `json:"start_date" validate:"required"`
+	Until      *time.Time `json:"until,omitempty"`
+	// Count limits the number of occurrences, skipped ones included; 0
+	// means no limit.
+	Count int `json:"count,omitempty" validate:"gte=0"`
+}
+
+// RecurringTransaction is a template the scheduler turns into a transaction
+// on every occurrence of its schedule.
+type RecurringTransaction struct {
+	ID          string `json:"id"`
+	User_ID     string `json:"user_id"`
+	Wallet_ID   string `json:"wallet_id"`
+	Category_ID string `json:"category_id"`
+	Type        string `json:"type"`
+	// Amount is converted into the wallet's currency at the rate in effect
+	// on each occurrence, as for a TransactionRequest.
+	Amount      Money  `json:"amount"`
+	Description string `json:"description"`
+	Recurrence
+	// Paused templates are left alone by the scheduler.
+	Paused bool `json:"paused"`
+	// Next_Date is the occurrence due next, or nil once the schedule has
+	// ended. Last_Date is the latest occurrence materialized or skipped, and
+	// Occurrences counts them.
+	Next_Date   *time.Time `json:"next_date"`
+	Last_Date   *time.Time `json:"last_date"`
+	Occurrences int        `json:"occurrences"`
+	Created_At  time.Time  `json:"created_at"`
+}
+
+// RecurringTransactionRequest creates or updates a recurring transaction.
+// Updates apply to the occurrences not yet materialized.
+type RecurringTransactionRequest struct {
+	Wallet_ID   string       `json:"wallet_id" validate:"required"`
+	Category_ID string       `json:"category_id" validate:"required"`
+	Type        string       `json:"type" validate:"required,oneof=income expense"`
+	Amount      MoneyRequest `json:"amount"`
+	Description string       `json:"description" validate:"max=255"`
+	Recurrence
+}
+
+// Next returns the first occurrence after last, or the first occurrence of
+// all when last is nil, given that occurred occurrences have already passed.
+// It returns nil once the schedule has ended. Calendar arithmetic is done in
+// the location of Start_Date.
+func (r Recurrence) Next(last *time.Time, occurred int) *time.Time {
+	if r.Count > 0 && occurred >= r.Count {
+		return nil
+	}
+
+	var next time.Time
+	if last == nil {
+		next = r.from(r.Start_Date, true)
+	} else {
+		next = r.from(last.In(r.Start_Date.Location()), false)
+	}
+
+	if r.Until != nil && next.After(*r.Until) {
+		return nil
+	}
+
+	return &next
+}
+
+// from returns the first occurrence after t, or at t when inclusive.
+func (r Recurrence) from(t time.Time, inclusive bool) time.Time {
+	interval := max(r.Interval, 1)
+
+	follows := func(occurrence time.Time) bool {
+		return occurrence.After(t) || inclusive && occurrence.Equal(t)
+	}
+
+	if r.Frequency == FrequencyWeekly && len(r.Weekdays) > 0 {
+		return r.fromWeekdays(t, interval, follows)
+	}
+
+	var (
+		step    int
+		elapsed int
+		nth     func(k int) time.Time
+	)
+
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly:
+		step = interval
+		if r.Frequency == FrequencyWeekly {
+			step *= 7
+		}
+		elapsed = daysBetween(r.Start_Date, t)
+		nth = func(k int) time.Time { return r.Start_Date.AddDate(0, 0, k*step) }
+	default:
+		step = interval
+		if r.Frequency == FrequencyYearly {
+			step *= 12
+		}
+		elapsed = (t.Year()-r.Start_Date.Year())*12 + int(t.Month()-r.Start_Date.Month())
+		nth = func(k int) time.Time { return addMonths(r.Start_Date, k*step) }
+	}
+
+	// Start one occurrence early, as elapsed ignores the time of day.
+	k := max(elapsed/step-1, 0)
+	for !follows(nth(k)) {
+		k++
+	}
+
+	return nth(k)
+}
+
+// fromWeekdays is from for weekly schedules on given weekdays. Weeks start
+// on Monday, and every interval-th week from the week of Start_Date is
+// included.
+func (r Recurrence) fromWeekdays(t time.Time, interval int, follows func(time.Time) bool) time.Time {
+	weekStart := r.Start_Date.AddDate(0, 0, -((int(r.Start_Date.Weekday()) + 6) % 7))
+
+	week := max(daysBetween(weekStart, t)/7, 0)
+	week -= week % interval
+
+	for ; ; week += interval {
+		for day := 0; day < 7; day++ {
+			occurrence := weekStart.AddDate(0, 0, week*7+day)
+
+			if occurrence.Before(r.Start_Date) || !slices.ContainsFunc(r.Weekdays, func(name string) bool { return Weekdays[name] == occurrence.Weekday() }) {
+				continue
+			}
+
+			if follows(occurrence) {
+				return occurrence
+			}
+		}
+	}
+}
+
+// daysBetween counts the calendar days from a to b in the location of a.
+func daysBetween(a time.Time, b time.Time) int {
+	b = b.In(a.Location())
+
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// addMonths adds months to t, moving to the last day of the resulting month
+// when it is shorter than the day of t.
+func addMonths(t time.Time, months int) time.Time {
+	month := int(t.Month()) - 1 + months
+	year := t.Year() + month/12
+	month %= 12
+
+	if month < 0 {
+		month += 12
+		year--
+	}
+
+	lastDay := time.Date(year, time.Month(month+2), 0, 0, 0, 0, 0, time.UTC).Day()
+	hour, minute, second := t.Clock()
+
+	return time.Date(year, time.Month(month+1), min(t.Day(), lastDay), hour, minute, second, t.Nanosecond(), t.Location())
+}
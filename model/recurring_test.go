@@ -0,0 +1,110 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+var jakarta = time.FixedZone("Asia/Jakarta", 7*60*60)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, jakarta)
+}
+
+// occurrences lists the first n occurrences of recurrence, fewer when it
+// ends sooner.
+func occurrences(recurrence model.Recurrence, n int) []time.Time {
+	var (
+		dates []time.Time
+		last  *time.Time
+	)
+
+	for len(dates) < n {
+		next := recurrence.Next(last, len(dates))
+		if next == nil {
+			break
+		}
+
+		dates = append(dates, *next)
+		last = next
+	}
+
+	return dates
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	until := date(2024, time.January, 20)
+
+	tests := []struct {
+		name       string
+		recurrence model.Recurrence
+		want       []time.Time
+	}{
+		{
+			name:       "daily",
+			recurrence: model.Recurrence{Frequency: model.FrequencyDaily, Start_Date: date(2024, time.February, 28)},
+			want:       []time.Time{date(2024, time.February, 28), date(2024, time.February, 29), date(2024, time.March, 1), date(2024, time.March, 2)},
+		},
+		{
+			name:       "every other week",
+			recurrence: model.Recurrence{Frequency: model.FrequencyWeekly, Interval: 2, Start_Date: date(2024, time.January, 3)},
+			want:       []time.Time{date(2024, time.January, 3), date(2024, time.January, 17), date(2024, time.January, 31), date(2024, time.February, 14)},
+		},
+		{
+			name:       "weekly on weekdays",
+			recurrence: model.Recurrence{Frequency: model.FrequencyWeekly, Weekdays: []string{"MO", "TH"}, Start_Date: date(2024, time.January, 3)},
+			want:       []time.Time{date(2024, time.January, 4), date(2024, time.January, 8), date(2024, time.January, 11), date(2024, time.January, 15)},
+		},
+		{
+			name:       "every other week on weekdays",
+			recurrence: model.Recurrence{Frequency: model.FrequencyWeekly, Interval: 2, Weekdays: []string{"MO", "SU"}, Start_Date: date(2024, time.January, 3)},
+			want:       []time.Time{date(2024, time.January, 7), date(2024, time.January, 15), date(2024, time.January, 21), date(2024, time.January, 29)},
+		},
+		{
+			name:       "monthly on the last days of the month",
+			recurrence: model.Recurrence{Frequency: model.FrequencyMonthly, Start_Date: date(2024, time.January, 31)},
+			want:       []time.Time{date(2024, time.January, 31), date(2024, time.February, 29), date(2024, time.March, 31), date(2024, time.April, 30)},
+		},
+		{
+			name:       "quarterly across years",
+			recurrence: model.Recurrence{Frequency: model.FrequencyMonthly, Interval: 3, Start_Date: date(2024, time.November, 15)},
+			want:       []time.Time{date(2024, time.November, 15), date(2025, time.February, 15), date(2025, time.May, 15), date(2025, time.August, 15)},
+		},
+		{
+			name:       "yearly on a leap day",
+			recurrence: model.Recurrence{Frequency: model.FrequencyYearly, Start_Date: date(2024, time.February, 29)},
+			want:       []time.Time{date(2024, time.February, 29), date(2025, time.February, 28), date(2026, time.February, 28), date(2027, time.February, 28)},
+		},
+		{
+			name:       "with count",
+			recurrence: model.Recurrence{Frequency: model.FrequencyDaily, Count: 2, Start_Date: date(2024, time.January, 1)},
+			want:       []time.Time{date(2024, time.January, 1), date(2024, time.January, 2)},
+		},
+		{
+			name:       "until",
+			recurrence: model.Recurrence{Frequency: model.FrequencyWeekly, Start_Date: date(2024, time.January, 6), Until: &until},
+			want:       []time.Time{date(2024, time.January, 6), date(2024, time.January, 13), date(2024, time.January, 20)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, occurrences(tt.recurrence, 4))
+		})
+	}
+}
+
+func TestRecurrence_NextAfterDate(t *testing.T) {
+	rent := model.Recurrence{Frequency: model.FrequencyMonthly, Start_Date: date(2020, time.January, 1)}
+	last := date(2024, time.March, 1)
+
+	assert.Equal(t, date(2024, time.April, 1), *rent.Next(&last, 51), "Expected the occurrence after last, however far from the start")
+
+	utc := date(2024, time.March, 10).UTC()
+	rent.Start_Date = date(2024, time.January, 10)
+
+	assert.Equal(t, date(2024, time.April, 10), *rent.Next(&utc, 3), "Expected last to be compared in the location of the start")
+}
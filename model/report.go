@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// CategoryTotal is the sum of a user's income or expense transactions in one
+// category and currency over a period.
+type CategoryTotal struct {
+	Category_ID   string
+	Category_Name string
+	Type          string
+	Total         Money
+}
+
+// Summary reports a user's income and expenses over [From, To), converted
+// into Currency. Transfers between the user's own wallets are left out.
+type Summary struct {
+	From       time.Time         `json:"from"`
+	To         time.Time         `json:"to"`
+	Currency   string            `json:"currency"`
+	Income     Money             `json:"income"`
+	Expense    Money             `json:"expense"`
+	Net        Money             `json:"net"`
+	Categories []CategorySummary `json:"categories"`
+}
+
+// CategorySummary is one category's part of a Summary. Percentage is its
+// share of the summary's income or expense total, depending on Type.
+type CategorySummary struct {
+	Category_ID string  `json:"category_id"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Total       Money   `json:"total"`
+	Percentage  float64 `json:"percentage"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// RefreshToken is one link in a session's chain of refresh tokens. Only the
+// SHA-256 hash of the token is stored. A token is exchanged for a new one of
+// the same session exactly once; presenting it again marks the session as
+// compromised.
+type RefreshToken struct {
+	ID         string
+	Session_ID string
+	User_ID    string
+	Token_Hash string
+	User_Agent string
+	Created_At time.Time
+	Expires_At time.Time
+	Used_At    *time.Time
+	Revoked_At *time.Time
+}
+
+// Session is a login on one device, represented by its current refresh token.
+type Session struct {
+	ID           string    `json:"id"`
+	User_Agent   string    `json:"user_agent"`
+	Created_At   time.Time `json:"created_at"`
+	Last_Used_At time.Time `json:"last_used_at"`
+	Expires_At   time.Time `json:"expires_at"`
+}
+
+type RefreshRequest struct {
+	Refresh_Token string `json:"refresh_token" validate:"required"`
+}
@@ -1,14 +1,92 @@
 package model
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TransactionTypeIncome  = "income"
+	TransactionTypeExpense = "expense"
+
+	// Transfer legs move money between two wallets of the same user and are
+	// neither income nor expense.
+	TransactionTypeTransferOut = "transfer_out"
+	TransactionTypeTransferIn  = "transfer_in"
+)
 
 type Transaction struct {
 	ID               string    `json:"id"`
 	User_ID          string    `json:"user_id"`
 	Wallet_ID        string    `json:"wallet_id"`
 	Category_ID      string    `json:"category_id"`
-	Amount           float64   `json:"amount"`
+	Type             string    `json:"type"`
+	Amount           Money     `json:"amount"`
+	Original_Amount  Money     `json:"original_amount"`
 	Description      string    `json:"description"`
 	Transaction_Date time.Time `json:"transaction_date"`
 	Created_At       time.Time `json:"created_at"`
+	Transfer_ID      string    `json:"transfer_id,omitempty"`
+}
+
+// TransactionRequest creates or updates a transaction. Amount may be in any
+// currency; it is converted into the wallet's currency at the rate in effect
+// on Transaction_Date and kept as Original_Amount.
+type TransactionRequest struct {
+	Wallet_ID        string       `json:"wallet_id" validate:"required"`
+	Category_ID      string       `json:"category_id" validate:"required"`
+	Type             string       `json:"type" validate:"required,oneof=income expense"`
+	Amount           MoneyRequest `json:"amount"`
+	Description      string       `json:"description" validate:"max=255"`
+	Transaction_Date time.Time    `json:"transaction_date" validate:"required"`
+}
+
+// BalanceDelta returns the amount the transaction adds to its wallet balance:
+// positive for income and incoming transfers, negative for expenses and
+// outgoing transfers.
+func (t Transaction) BalanceDelta() Money {
+	if t.Type == TransactionTypeExpense || t.Type == TransactionTypeTransferOut {
+		return t.Amount.Neg()
+	}
+
+	return t.Amount
+}
+
+// IsTransfer reports whether the transaction is one leg of a Transfer. Such
+// transactions are excluded from income and expense reporting.
+func (t Transaction) IsTransfer() bool {
+	return t.Transfer_ID != ""
+}
+
+// TransactionSorts lists the orders transactions can be listed in. The first
+// is the default.
+var TransactionSorts = []string{"-date", "amount"}
+
+// TransactionQuery selects a page of a user's transactions. Zero-valued
+// filters match everything. Search matches the description.
+type TransactionQuery struct {
+	ListQuery
+	// From and To bound Transaction_Date to [From, To).
+	From        time.Time
+	To          time.Time
+	Wallet_ID   string
+	Category_ID string
+	// Min_Amount and Max_Amount bound Amount inclusively and only match
+	// transactions in their currency.
+	Min_Amount *Money
+	Max_Amount *Money
+}
+
+func (t Transaction) Cursor(sort string) Cursor {
+	cursor := Cursor{Sort: sort, ID: t.ID}
+
+	switch strings.TrimPrefix(sort, "-") {
+	case "amount":
+		cursor.Key = strconv.FormatInt(t.Amount.Amount, 10)
+	default:
+		cursor.Key = t.Transaction_Date.UTC().Format(time.RFC3339Nano)
+	}
+
+	return cursor
 }
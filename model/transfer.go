@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+// Transfer moves money between two wallets owned by the same user. It is
+// recorded as a linked pair of transactions: a transfer_out leg on the source
+// wallet for Amount and a transfer_in leg on the destination wallet for
+// To_Amount. The amounts differ only when the wallets use different
+// currencies.
+type Transfer struct {
+	ID             string    `json:"id"`
+	User_ID        string    `json:"user_id"`
+	From_Wallet_ID string    `json:"from_wallet_id"`
+	To_Wallet_ID   string    `json:"to_wallet_id"`
+	Amount         Money     `json:"amount"`
+	To_Amount      Money     `json:"to_amount"`
+	Description    string    `json:"description"`
+	Transfer_Date  time.Time `json:"transfer_date"`
+	Created_At     time.Time `json:"created_at"`
+}
+
+// TransferRequest creates or updates a transfer. Amount is in the source
+// wallet's currency. To_Amount may be given for transfers across currencies
+// to record the rate actually applied; otherwise the stored exchange rate for
+// Transfer_Date is used.
+type TransferRequest struct {
+	From_Wallet_ID string        `json:"from_wallet_id" validate:"required"`
+	To_Wallet_ID   string        `json:"to_wallet_id" validate:"required,nefield=From_Wallet_ID"`
+	Amount         MoneyRequest  `json:"amount"`
+	To_Amount      *MoneyRequest `json:"to_amount,omitempty"`
+	Description    string        `json:"description" validate:"max=255"`
+	Transfer_Date  time.Time     `json:"transfer_date" validate:"required"`
+}
+
+// Legs returns the debit and credit transactions that record the transfer.
+// Their IDs are derived from the transfer ID so both legs can always be found
+// from the transfer.
+func (t Transfer) Legs() (Transaction, Transaction) {
+	debit := Transaction{
+		ID:               t.ID + "-out",
+		User_ID:          t.User_ID,
+		Wallet_ID:        t.From_Wallet_ID,
+		Type:             TransactionTypeTransferOut,
+		Amount:           t.Amount,
+		Original_Amount:  t.Amount,
+		Description:      t.Description,
+		Transaction_Date: t.Transfer_Date,
+		Created_At:       t.Created_At,
+		Transfer_ID:      t.ID,
+	}
+
+	credit := Transaction{
+		ID:               t.ID + "-in",
+		User_ID:          t.User_ID,
+		Wallet_ID:        t.To_Wallet_ID,
+		Type:             TransactionTypeTransferIn,
+		Amount:           t.To_Amount,
+		Original_Amount:  t.Amount,
+		Description:      t.Description,
+		Transaction_Date: t.Transfer_Date,
+		Created_At:       t.Created_At,
+		Transfer_ID:      t.ID,
+	}
+
+	return debit, credit
+}
@@ -1,12 +1,21 @@
 package model
 
-import "time"
+import (
+	"strings"
+	"time"
+)
+
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
 
 type User struct {
 	ID         string    `json:"id"`
 	Username   string    `json:"username"`
 	Password   string    `json:"password"`
 	Email      string    `json:"email"`
+	Role       string    `json:"role"`
 	Created_At time.Time `json:"created_at"`
 }
 
@@ -14,11 +23,29 @@ type UserResponse struct {
 	ID         string    `json:"id"`
 	Username   string    `json:"username"`
 	Email      string    `json:"email"`
+	Role       string    `json:"role"`
 	Created_At time.Time `json:"created_at"`
 }
 
 type UserRequest struct {
 	Username string `json:"username" validate:"required,min=3,max=20,alphanum"`
-	Password string `json:"password" validate:"required,min=8"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
 	Email    string `json:"email" validate:"required,email"`
 }
+
+// UserSorts lists the orders users can be listed in. The first is the
+// default.
+var UserSorts = []string{"created_at", "username"}
+
+func (u UserResponse) Cursor(sort string) Cursor {
+	cursor := Cursor{Sort: sort, ID: u.ID}
+
+	switch strings.TrimPrefix(sort, "-") {
+	case "username":
+		cursor.Key = u.Username
+	default:
+		cursor.Key = u.Created_At.UTC().Format(time.RFC3339Nano)
+	}
+
+	return cursor
+}
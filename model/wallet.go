@@ -1,8 +1,16 @@
 package model
 
 type Wallet struct {
-	ID      string  `json:"id"`
-	Name    string  `json:"name"`
-	Balance float64 `json:"balance"`
-	User_ID string  `json:"user_id"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Currency string `json:"currency"`
+	Balance  Money  `json:"balance"`
+	User_ID  string `json:"user_id"`
+}
+
+// WalletRequest creates or renames a wallet. Currency is only used when the
+// wallet is created and defaults to DefaultCurrency.
+type WalletRequest struct {
+	Name     string `json:"name" validate:"required,min=3,max=20"`
+	Currency string `json:"currency" validate:"omitempty,iso4217"`
 }
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryAPIKeyRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryAPIKeyRepository(store *memory.Store) *memoryAPIKeyRepository {
+	return &memoryAPIKeyRepository{
+		store: store,
+	}
+}
+
+func (m *memoryAPIKeyRepository) GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	keys := make([]model.APIKey, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, key := range memory.Values(t.APIKeys) {
+			if key.User_ID == userID {
+				keys = append(keys, key)
+			}
+		}
+	})
+
+	slices.SortStableFunc(keys, func(a, b model.APIKey) int {
+		return a.Created_At.Compare(b.Created_At)
+	})
+
+	return keys, nil
+}
+
+func (m *memoryAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error) {
+	var (
+		key   model.APIKey
+		found bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, candidate := range t.APIKeys {
+			if candidate.Key_Hash == hash {
+				key, found = candidate, true
+				return
+			}
+		}
+	})
+
+	if !found {
+		return model.APIKey{}, sql.ErrNoRows
+	}
+
+	return key, nil
+}
+
+func (m *memoryAPIKeyRepository) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.APIKeys[key.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		for _, existing := range t.APIKeys {
+			if existing.Key_Hash == key.Key_Hash {
+				return memory.ErrDuplicateKey
+			}
+		}
+
+		if _, ok := t.Users[key.User_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		key.Last_Used_At = nil
+		memory.Put(t, t.APIKeys, key.ID, key)
+
+		return nil
+	})
+}
+
+func (m *memoryAPIKeyRepository) DeleteAPIKey(ctx context.Context, userID string, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		key, ok := t.APIKeys[id]
+		if !ok || key.User_ID != userID {
+			return sql.ErrNoRows
+		}
+
+		memory.Delete(t, t.APIKeys, id)
+
+		return nil
+	})
+}
+
+func (m *memoryAPIKeyRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		key, ok := t.APIKeys[id]
+		if ok {
+			key.Last_Used_At = &usedAt
+			memory.Put(t, t.APIKeys, id, key)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlAPIKeyRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlAPIKeyRepository(connectionPool *sql.DB) *postgresqlAPIKeyRepository {
+	return &postgresqlAPIKeyRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlAPIKeyRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+const selectAPIKey = "SELECT id, user_id, name, scope, prefix, key_hash, created_at, last_used_at FROM api_keys"
+
+func scanAPIKey(row interface{ Scan(...any) error }, key *model.APIKey) error {
+	return row.Scan(&key.ID, &key.User_ID, &key.Name, &key.Scope, &key.Prefix, &key.Key_Hash, &key.Created_At, &key.Last_Used_At)
+}
+
+func (p *postgresqlAPIKeyRepository) GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	rows, err := p.db(ctx).QueryContext(ctx, selectAPIKey+" WHERE user_id = $1 ORDER BY created_at", userID)
+
+	if err != nil {
+		return []model.APIKey{}, err
+	}
+
+	defer rows.Close()
+
+	keys := make([]model.APIKey, 0)
+
+	for rows.Next() {
+		key := model.APIKey{}
+		err := scanAPIKey(rows, &key)
+		if err != nil {
+			return keys, err
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (p *postgresqlAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error) {
+	key := model.APIKey{}
+
+	err := scanAPIKey(p.db(ctx).QueryRowContext(ctx, selectAPIKey+" WHERE key_hash = $1", hash), &key)
+
+	if err != nil {
+		return key, err
+	}
+
+	return key, nil
+}
+
+func (p *postgresqlAPIKeyRepository) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO api_keys (id, user_id, name, scope, prefix, key_hash, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		key.ID, key.User_ID, key.Name, key.Scope, key.Prefix, key.Key_Hash, key.Created_At,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlAPIKeyRepository) DeleteAPIKey(ctx context.Context, userID string, id string) error {
+	result, err := p.db(ctx).ExecContext(ctx, "DELETE FROM api_keys WHERE id = $1 AND user_id = $2", id, userID)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (p *postgresqlAPIKeyRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	_, err := p.db(ctx).ExecContext(ctx, "UPDATE api_keys SET last_used_at = $1 WHERE id = $2", usedAt, id)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
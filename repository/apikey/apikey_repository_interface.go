@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IAPIKeyRepository interface {
+	GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error)
+	CreateAPIKey(ctx context.Context, key model.APIKey) error
+	DeleteAPIKey(ctx context.Context, userID string, id string) error
+	UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteAPIKeyRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteAPIKeyRepository(connectionPool *sql.DB) *sqliteAPIKeyRepository {
+	return &sqliteAPIKeyRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteAPIKeyRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteAPIKeyRepository) GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	rows, err := s.db(ctx).QueryContext(ctx, selectAPIKey+" WHERE user_id = $1 ORDER BY created_at", userID)
+
+	if err != nil {
+		return []model.APIKey{}, err
+	}
+
+	defer rows.Close()
+
+	keys := make([]model.APIKey, 0)
+
+	for rows.Next() {
+		key := model.APIKey{}
+		err := scanAPIKey(rows, &key)
+		if err != nil {
+			return keys, err
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (s *sqliteAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error) {
+	key := model.APIKey{}
+
+	err := scanAPIKey(s.db(ctx).QueryRowContext(ctx, selectAPIKey+" WHERE key_hash = $1", hash), &key)
+
+	if err != nil {
+		return key, err
+	}
+
+	return key, nil
+}
+
+func (s *sqliteAPIKeyRepository) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO api_keys (id, user_id, name, scope, prefix, key_hash, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		key.ID, key.User_ID, key.Name, key.Scope, key.Prefix, key.Key_Hash, key.Created_At.UTC(),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteAPIKeyRepository) DeleteAPIKey(ctx context.Context, userID string, id string) error {
+	result, err := s.db(ctx).ExecContext(ctx, "DELETE FROM api_keys WHERE id = $1 AND user_id = $2", id, userID)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (s *sqliteAPIKeyRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	_, err := s.db(ctx).ExecContext(ctx, "UPDATE api_keys SET last_used_at = $1 WHERE id = $2", usedAt.UTC(), id)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
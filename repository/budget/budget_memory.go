@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryBudgetRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryBudgetRepository(store *memory.Store) *memoryBudgetRepository {
+	return &memoryBudgetRepository{
+		store: store,
+	}
+}
+
+func (m *memoryBudgetRepository) GetUserBudgets(ctx context.Context, userID string, month string) ([]model.Budget, error) {
+	budgets := make([]model.Budget, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, budget := range t.Budgets {
+			if budget.User_ID == userID && budget.Month == month {
+				budgets = append(budgets, budget)
+			}
+		}
+	})
+
+	slices.SortFunc(budgets, func(a, b model.Budget) int { return cmp.Compare(a.ID, b.ID) })
+
+	return budgets, nil
+}
+
+func (m *memoryBudgetRepository) GetBudget(ctx context.Context, id string) (model.Budget, error) {
+	var (
+		budget model.Budget
+		ok     bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		budget, ok = t.Budgets[id]
+	})
+
+	if !ok {
+		return model.Budget{}, sql.ErrNoRows
+	}
+
+	return budget, nil
+}
+
+func (m *memoryBudgetRepository) GetCategoryBudget(ctx context.Context, categoryID string, month string) (model.Budget, error) {
+	var (
+		budget model.Budget
+		ok     bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		budget, ok = categoryBudget(t, categoryID, month)
+	})
+
+	if !ok {
+		return model.Budget{}, sql.ErrNoRows
+	}
+
+	return budget, nil
+}
+
+func categoryBudget(t *memory.Tables, categoryID string, month string) (model.Budget, bool) {
+	for _, budget := range t.Budgets {
+		if budget.Category_ID == categoryID && budget.Month == month {
+			return budget, true
+		}
+	}
+
+	return model.Budget{}, false
+}
+
+func (m *memoryBudgetRepository) CreateBudget(ctx context.Context, budget model.Budget) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Budgets[budget.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := categoryBudget(t, budget.Category_ID, budget.Month); ok {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := t.Users[budget.User_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		if _, ok := t.Categories[budget.Category_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		memory.Put(t, t.Budgets, budget.ID, budget)
+
+		return nil
+	})
+}
+
+func (m *memoryBudgetRepository) UpdateBudget(ctx context.Context, id string, budget model.Budget) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		existing, ok := t.Budgets[id]
+		if !ok {
+			return nil
+		}
+
+		if other, ok := categoryBudget(t, budget.Category_ID, budget.Month); ok && other.ID != id {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := t.Categories[budget.Category_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		existing.Category_ID = budget.Category_ID
+		existing.Month = budget.Month
+		existing.Amount = budget.Amount
+		existing.Rollover = budget.Rollover
+		memory.Put(t, t.Budgets, id, existing)
+
+		return nil
+	})
+}
+
+func (m *memoryBudgetRepository) DeleteBudget(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		memory.Delete(t, t.Budgets, id)
+
+		return nil
+	})
+}
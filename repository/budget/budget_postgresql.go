@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlBudgetRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlBudgetRepository(connectionPool *sql.DB) *postgresqlBudgetRepository {
+	return &postgresqlBudgetRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlBudgetRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+const selectBudget = "SELECT id, user_id, category_id, month, amount, currency, rollover, created_at FROM budgets"
+
+func scanBudget(row interface{ Scan(...any) error }, budget *model.Budget) error {
+	err := row.Scan(&budget.ID, &budget.User_ID, &budget.Category_ID, &budget.Month, &budget.Amount, &budget.Amount.Currency, &budget.Rollover, &budget.Created_At)
+	if err != nil {
+		return err
+	}
+
+	budget.Created_At = budget.Created_At.UTC()
+
+	return nil
+}
+
+func queryBudgets(ctx context.Context, db unitofwork.DBTX, query string, args ...any) ([]model.Budget, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return []model.Budget{}, err
+	}
+
+	defer rows.Close()
+
+	budgets := make([]model.Budget, 0)
+
+	for rows.Next() {
+		budget := model.Budget{}
+		err := scanBudget(rows, &budget)
+		if err != nil {
+			return budgets, err
+		}
+		budgets = append(budgets, budget)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+func (p *postgresqlBudgetRepository) GetUserBudgets(ctx context.Context, userID string, month string) ([]model.Budget, error) {
+	return queryBudgets(ctx, p.db(ctx), selectBudget+" WHERE user_id = $1 AND month = $2 ORDER BY id", userID, month)
+}
+
+func (p *postgresqlBudgetRepository) GetBudget(ctx context.Context, id string) (model.Budget, error) {
+	budget := model.Budget{}
+
+	err := scanBudget(p.db(ctx).QueryRowContext(ctx, selectBudget+" WHERE id = $1", id), &budget)
+
+	return budget, err
+}
+
+func (p *postgresqlBudgetRepository) GetCategoryBudget(ctx context.Context, categoryID string, month string) (model.Budget, error) {
+	budget := model.Budget{}
+
+	err := scanBudget(p.db(ctx).QueryRowContext(ctx, selectBudget+" WHERE category_id = $1 AND month = $2", categoryID, month), &budget)
+
+	return budget, err
+}
+
+func (p *postgresqlBudgetRepository) CreateBudget(ctx context.Context, budget model.Budget) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO budgets (id, user_id, category_id, month, amount, currency, rollover, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		budget.ID, budget.User_ID, budget.Category_ID, budget.Month, budget.Amount, budget.Amount.Currency, budget.Rollover, budget.Created_At.UTC(),
+	)
+
+	return err
+}
+
+func (p *postgresqlBudgetRepository) UpdateBudget(ctx context.Context, id string, budget model.Budget) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"UPDATE budgets SET category_id = $1, month = $2, amount = $3, currency = $4, rollover = $5 WHERE id = $6",
+		budget.Category_ID, budget.Month, budget.Amount, budget.Amount.Currency, budget.Rollover, id,
+	)
+
+	return err
+}
+
+func (p *postgresqlBudgetRepository) DeleteBudget(ctx context.Context, id string) error {
+	_, err := p.db(ctx).ExecContext(ctx, "DELETE FROM budgets WHERE id = $1", id)
+
+	return err
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IBudgetRepository interface {
+	// GetUserBudgets returns the user's budgets for month, given as YYYY-MM.
+	GetUserBudgets(ctx context.Context, userID string, month string) ([]model.Budget, error)
+	GetBudget(ctx context.Context, id string) (model.Budget, error)
+	// GetCategoryBudget returns the category's budget for month, or
+	// sql.ErrNoRows when it has none.
+	GetCategoryBudget(ctx context.Context, categoryID string, month string) (model.Budget, error)
+	CreateBudget(ctx context.Context, budget model.Budget) error
+	UpdateBudget(ctx context.Context, id string, budget model.Budget) error
+	DeleteBudget(ctx context.Context, id string) error
+}
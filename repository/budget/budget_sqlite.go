@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteBudgetRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteBudgetRepository(connectionPool *sql.DB) *sqliteBudgetRepository {
+	return &sqliteBudgetRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteBudgetRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteBudgetRepository) GetUserBudgets(ctx context.Context, userID string, month string) ([]model.Budget, error) {
+	return queryBudgets(ctx, s.db(ctx), selectBudget+" WHERE user_id = $1 AND month = $2 ORDER BY id", userID, month)
+}
+
+func (s *sqliteBudgetRepository) GetBudget(ctx context.Context, id string) (model.Budget, error) {
+	budget := model.Budget{}
+
+	err := scanBudget(s.db(ctx).QueryRowContext(ctx, selectBudget+" WHERE id = $1", id), &budget)
+
+	return budget, err
+}
+
+func (s *sqliteBudgetRepository) GetCategoryBudget(ctx context.Context, categoryID string, month string) (model.Budget, error) {
+	budget := model.Budget{}
+
+	err := scanBudget(s.db(ctx).QueryRowContext(ctx, selectBudget+" WHERE category_id = $1 AND month = $2", categoryID, month), &budget)
+
+	return budget, err
+}
+
+func (s *sqliteBudgetRepository) CreateBudget(ctx context.Context, budget model.Budget) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO budgets (id, user_id, category_id, month, amount, currency, rollover, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		budget.ID, budget.User_ID, budget.Category_ID, budget.Month, budget.Amount, budget.Amount.Currency, budget.Rollover, budget.Created_At.UTC(),
+	)
+
+	return err
+}
+
+func (s *sqliteBudgetRepository) UpdateBudget(ctx context.Context, id string, budget model.Budget) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"UPDATE budgets SET category_id = $1, month = $2, amount = $3, currency = $4, rollover = $5 WHERE id = $6",
+		budget.Category_ID, budget.Month, budget.Amount, budget.Amount.Currency, budget.Rollover, id,
+	)
+
+	return err
+}
+
+func (s *sqliteBudgetRepository) DeleteBudget(ctx context.Context, id string) error {
+	_, err := s.db(ctx).ExecContext(ctx, "DELETE FROM budgets WHERE id = $1", id)
+
+	return err
+}
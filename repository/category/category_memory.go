@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryCategoryRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryCategoryRepository(store *memory.Store) *memoryCategoryRepository {
+	return &memoryCategoryRepository{
+		store: store,
+	}
+}
+
+func (m *memoryCategoryRepository) GetCategories(ctx context.Context, query model.ListQuery) ([]model.Category, error) {
+	return m.listCategories(ctx, func(model.Category) bool { return true }, query), nil
+}
+
+func (m *memoryCategoryRepository) GetUserCategories(ctx context.Context, userID string, query model.ListQuery) ([]model.Category, error) {
+	return m.listCategories(ctx, func(category model.Category) bool { return category.User_ID == userID }, query), nil
+}
+
+func (m *memoryCategoryRepository) listCategories(ctx context.Context, match func(model.Category) bool, query model.ListQuery) []model.Category {
+	categories := make([]model.Category, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, category := range t.Categories {
+			if match(category) && memory.Contains(query.Search, category.Name) {
+				categories = append(categories, category)
+			}
+		}
+	})
+
+	_, desc := query.SortField()
+	compare := memory.Order(desc, func(a, b model.Category) int {
+		return cmp.Compare(a.Name, b.Name)
+	}, func(c model.Category) string { return c.ID })
+
+	var after *model.Category
+
+	if query.After != nil {
+		after = &model.Category{ID: query.After.ID, Name: query.After.Key}
+	}
+
+	return memory.Page(categories, compare, after, query.Limit)
+}
+
+func (m *memoryCategoryRepository) GetCategory(ctx context.Context, id string) (model.Category, error) {
+	var (
+		category model.Category
+		ok       bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		category, ok = t.Categories[id]
+	})
+
+	if !ok {
+		return model.Category{}, sql.ErrNoRows
+	}
+
+	return category, nil
+}
+
+func (m *memoryCategoryRepository) CreateCategory(ctx context.Context, category model.Category) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Categories[category.ID]; ok || categoryNameTaken(t, category.User_ID, category.Name) {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := t.Users[category.User_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		memory.Put(t, t.Categories, category.ID, category)
+
+		return nil
+	})
+}
+
+func (m *memoryCategoryRepository) DeleteCategory(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		for _, transaction := range t.Transactions {
+			if transaction.Category_ID == id {
+				return memory.ErrForeignKey
+			}
+		}
+
+		memory.Delete(t, t.Categories, id)
+
+		for key, budget := range t.Budgets {
+			if budget.Category_ID == id {
+				memory.Delete(t, t.Budgets, key)
+			}
+		}
+
+		for key, recurring := range t.Recurring {
+			if recurring.Category_ID == id {
+				memory.Delete(t, t.Recurring, key)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (m *memoryCategoryRepository) IsUserCategoryExist(ctx context.Context, userID string, categoryName string) bool {
+	var exist bool
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		exist = categoryNameTaken(t, userID, categoryName)
+	})
+
+	return exist
+}
+
+func categoryNameTaken(t *memory.Tables, userID string, name string) bool {
+	for _, category := range t.Categories {
+		if category.User_ID == userID && category.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,65 +1,54 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
-	"log"
 
 	_ "github.com/lib/pq"
 	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
 )
 
 type postgresqlCategoryRepository struct {
 	connectionPool *sql.DB
 }
 
-func NewPostgresqlCategoryRepository(DB_URL string) *postgresqlCategoryRepository {
-	connString := DB_URL
-
-	connectionPool, err := sql.Open("postgres", connString)
-
-	if err != nil {
-		log.Fatal(err)
+func NewPostgresqlCategoryRepository(connectionPool *sql.DB) *postgresqlCategoryRepository {
+	return &postgresqlCategoryRepository{
+		connectionPool: connectionPool,
 	}
+}
 
-	err = connectionPool.Ping()
+func (p *postgresqlCategoryRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
 
-	if err != nil {
-		log.Fatal(err)
-	}
+var categoryColumns = map[string]listing.Column{
+	"name": {Name: "name", Key: listing.Text},
+}
 
-	fmt.Println("Successfully connected to database!")
+func (p *postgresqlCategoryRepository) GetCategories(ctx context.Context, query model.ListQuery) ([]model.Category, error){
+	return p.listCategories(ctx, listing.NewPostgresqlBuilder(), query)
+}
 
-	return &postgresqlCategoryRepository{
-		connectionPool: connectionPool,
-	}
+func (p *postgresqlCategoryRepository) GetUserCategories(ctx context.Context, userID string, query model.ListQuery) ([]model.Category, error){
+	builder := listing.NewPostgresqlBuilder()
+	builder.Where("user_id = ?", userID)
+
+	return p.listCategories(ctx, builder, query)
 }
 
-func (p *postgresqlCategoryRepository) GetCategories() ([]model.Category, error){
-	rows, err := p.connectionPool.Query("SELECT id, name, user_id FROM categories")
-	
-	if err  != nil {
-		return []model.Category{}, err
-	}
-	
-	defer rows.Close()
+func (p *postgresqlCategoryRepository) listCategories(ctx context.Context, builder *listing.Builder, query model.ListQuery) ([]model.Category, error){
+	builder.Search(query.Search, "name")
 
-	categories := make([]model.Category, 0)
+	statement, args, err := builder.Query("SELECT id, name, user_id FROM categories", categoryColumns, query)
 
-	for rows.Next(){
-		category := model.Category{}
-		err := rows.Scan(&category.ID, &category.Name, &category.User_ID)
-		if err != nil {
-			return categories, err
-		}
-		categories = append(categories, category)
+	if err != nil {
+		return []model.Category{}, err
 	}
-	
-	return categories, nil
-}
 
-func (p *postgresqlCategoryRepository) GetUserCategories(userID string) ([]model.Category, error){
-	rows, err := p.connectionPool.Query("SELECT id, name, user_id FROM categories WHERE user_id = $1", userID)
+	rows, err := p.db(ctx).QueryContext(ctx, statement, args...)
 
 	if err != nil {
 		return []model.Category{}, err
@@ -78,13 +67,17 @@ func (p *postgresqlCategoryRepository) GetUserCategories(userID string) ([]model
 		categories = append(categories, category)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
 	return categories, nil
 }
 
-func (p *postgresqlCategoryRepository) GetCategory(id string) (model.Category, error){
+func (p *postgresqlCategoryRepository) GetCategory(ctx context.Context, id string) (model.Category, error){
 	category := model.Category{}
 
-	row := p.connectionPool.QueryRow("SELECT id, name, user_id FROM categories WHERE id = $1", id)
+	row := p.db(ctx).QueryRowContext(ctx, "SELECT id, name, user_id FROM categories WHERE id = $1"+unitofwork.LockForShare(ctx), id)
 
 	err := row.Scan(&category.ID, &category.Name, &category.User_ID)
 
@@ -95,8 +88,9 @@ func (p *postgresqlCategoryRepository) GetCategory(id string) (model.Category, e
 	return category, nil
 }
 
-func (p *postgresqlCategoryRepository) CreateCategory(category model.Category) error{
-	_, err := p.connectionPool.Exec(
+func (p *postgresqlCategoryRepository) CreateCategory(ctx context.Context, category model.Category) error{
+	_, err := p.db(ctx).ExecContext(
+		ctx,
 		"INSERT INTO categories (id, name, user_id) VALUES ($1, $2, $3)",
 		category.ID, category.Name, category.User_ID,
 	)
@@ -108,8 +102,9 @@ func (p *postgresqlCategoryRepository) CreateCategory(category model.Category) e
 	return nil
 }
 
-func (p *postgresqlCategoryRepository) DeleteCategory(id string) error{
-	_, err := p.connectionPool.Exec(
+func (p *postgresqlCategoryRepository) DeleteCategory(ctx context.Context, id string) error{
+	_, err := p.db(ctx).ExecContext(
+		ctx,
 		"DELETE FROM categories WHERE id = $1",
 		id,
 	)
@@ -121,8 +116,9 @@ func (p *postgresqlCategoryRepository) DeleteCategory(id string) error{
 	return nil
 }
 
-func (p *postgresqlCategoryRepository) IsUserCategoryExist(userID string, categoryName string) bool{
-	row := p.connectionPool.QueryRow(
+func (p *postgresqlCategoryRepository) IsUserCategoryExist(ctx context.Context, userID string, categoryName string) bool{
+	row := p.db(ctx).QueryRowContext(
+		ctx,
 		"SELECT id FROM categories WHERE user_id = $1 AND name = $2", 
 		userID, categoryName,
 	)
@@ -1,12 +1,21 @@
 package repository
 
-import "github.com/varomnrg/money-tracker/model"
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
 
 type ICategoryRepository interface {
-	GetCategories() ([]model.Category, error)
-	GetUserCategories(userID string) ([]model.Category, error)
-	GetCategory(id string) (model.Category, error)
-	CreateCategory(category model.Category) error
-	DeleteCategory(id string) error
-	IsUserCategoryExist(userID string, categoryName string) bool
+	// GetCategories and GetUserCategories return the categories matching
+	// query, whose sort must be one of model.CategorySorts. Search matches
+	// category names.
+	GetCategories(ctx context.Context, query model.ListQuery) ([]model.Category, error)
+	GetUserCategories(ctx context.Context, userID string, query model.ListQuery) ([]model.Category, error)
+	// Inside a unit of work the row cannot be deleted by others until the
+	// transaction ends.
+	GetCategory(ctx context.Context, id string) (model.Category, error)
+	CreateCategory(ctx context.Context, category model.Category) error
+	DeleteCategory(ctx context.Context, id string) error
+	IsUserCategoryExist(ctx context.Context, userID string, categoryName string) bool
 }
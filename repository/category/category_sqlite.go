@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteCategoryRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteCategoryRepository(connectionPool *sql.DB) *sqliteCategoryRepository {
+	return &sqliteCategoryRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteCategoryRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteCategoryRepository) GetCategories(ctx context.Context, query model.ListQuery) ([]model.Category, error) {
+	return s.listCategories(ctx, listing.NewSqliteBuilder(), query)
+}
+
+func (s *sqliteCategoryRepository) GetUserCategories(ctx context.Context, userID string, query model.ListQuery) ([]model.Category, error) {
+	builder := listing.NewSqliteBuilder()
+	builder.Where("user_id = ?", userID)
+
+	return s.listCategories(ctx, builder, query)
+}
+
+func (s *sqliteCategoryRepository) listCategories(ctx context.Context, builder *listing.Builder, query model.ListQuery) ([]model.Category, error) {
+	builder.Search(query.Search, "name")
+
+	statement, args, err := builder.Query("SELECT id, name, user_id FROM categories", categoryColumns, query)
+
+	if err != nil {
+		return []model.Category{}, err
+	}
+
+	rows, err := s.db(ctx).QueryContext(ctx, statement, args...)
+
+	if err != nil {
+		return []model.Category{}, err
+	}
+
+	defer rows.Close()
+
+	categories := make([]model.Category, 0)
+
+	for rows.Next() {
+		category := model.Category{}
+		err := rows.Scan(&category.ID, &category.Name, &category.User_ID)
+		if err != nil {
+			return categories, err
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (s *sqliteCategoryRepository) GetCategory(ctx context.Context, id string) (model.Category, error) {
+	category := model.Category{}
+
+	row := s.db(ctx).QueryRowContext(ctx, "SELECT id, name, user_id FROM categories WHERE id = $1", id)
+
+	err := row.Scan(&category.ID, &category.Name, &category.User_ID)
+
+	if err != nil {
+		return category, err
+	}
+
+	return category, nil
+}
+
+func (s *sqliteCategoryRepository) CreateCategory(ctx context.Context, category model.Category) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO categories (id, name, user_id) VALUES ($1, $2, $3)",
+		category.ID, category.Name, category.User_ID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteCategoryRepository) DeleteCategory(ctx context.Context, id string) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"DELETE FROM categories WHERE id = $1",
+		id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteCategoryRepository) IsUserCategoryExist(ctx context.Context, userID string, categoryName string) bool {
+	row := s.db(ctx).QueryRowContext(
+		ctx,
+		"SELECT id FROM categories WHERE user_id = $1 AND name = $2",
+		userID, categoryName,
+	)
+
+	category := model.Category{}
+
+	err := row.Scan(&category.ID)
+
+	return err == nil
+}
@@ -0,0 +1,85 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// APIKey checks IAPIKeyRepository.
+func APIKey(t *testing.T, factory Factory) {
+	key := func(userID string, id string, day int) model.APIKey {
+		return model.APIKey{
+			ID:         id,
+			User_ID:    userID,
+			Name:       "key " + id,
+			Scope:      model.ScopeRead,
+			Prefix:     "mt_" + id,
+			Key_Hash:   "hash-of-" + id,
+			Created_At: at(day),
+		}
+	}
+
+	t.Run("GetUserAPIKeys lists the user's keys oldest first", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		require.NoError(t, repos.APIKey.CreateAPIKey(ctx, key(alice.ID, "key-2", 3)))
+		require.NoError(t, repos.APIKey.CreateAPIKey(ctx, key(alice.ID, "key-1", 2)))
+		require.NoError(t, repos.APIKey.CreateAPIKey(ctx, key(bob.ID, "key-3", 2)))
+
+		keys, err := repos.APIKey.GetUserAPIKeys(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		assert.Equal(t, "key-1", keys[0].ID)
+		assert.Equal(t, "key-2", keys[1].ID)
+		assert.Nil(t, keys[0].Last_Used_At)
+	})
+
+	t.Run("GetAPIKeyByHash", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		created := key(user.ID, "key-1", 2)
+		require.NoError(t, repos.APIKey.CreateAPIKey(ctx, created))
+
+		got, err := repos.APIKey.GetAPIKeyByHash(ctx, created.Key_Hash)
+		require.NoError(t, err)
+		got.Created_At = got.Created_At.UTC()
+		assert.Equal(t, created, got)
+
+		_, err = repos.APIKey.GetAPIKeyByHash(ctx, "hash-missing")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("UpdateLastUsed", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		created := key(user.ID, "key-1", 2)
+		require.NoError(t, repos.APIKey.CreateAPIKey(ctx, created))
+
+		require.NoError(t, repos.APIKey.UpdateLastUsed(ctx, created.ID, at(5)))
+
+		got, err := repos.APIKey.GetAPIKeyByHash(ctx, created.Key_Hash)
+		require.NoError(t, err)
+		require.NotNil(t, got.Last_Used_At)
+		assert.True(t, at(5).Equal(*got.Last_Used_At))
+	})
+
+	t.Run("DeleteAPIKey is scoped to the user", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		created := key(alice.ID, "key-1", 2)
+		require.NoError(t, repos.APIKey.CreateAPIKey(ctx, created))
+
+		assert.ErrorIs(t, repos.APIKey.DeleteAPIKey(ctx, bob.ID, created.ID), sql.ErrNoRows)
+
+		require.NoError(t, repos.APIKey.DeleteAPIKey(ctx, alice.ID, created.ID))
+
+		_, err := repos.APIKey.GetAPIKeyByHash(ctx, created.Key_Hash)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
@@ -0,0 +1,127 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Budget checks IBudgetRepository.
+func Budget(t *testing.T, factory Factory) {
+	t.Run("GetBudget", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		budget := newBudget(t, repos, user.ID, category.ID, "2024-01")
+
+		got, err := repos.Budget.GetBudget(ctx, budget.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, budget, got)
+	})
+
+	t.Run("GetBudget reports a missing budget as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+
+		_, err := repos.Budget.GetBudget(ctx, "budget-missing")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetUserBudgets lists only the user's budgets for the month", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		food := newCategory(t, repos, alice.ID, "Food")
+		january := newBudget(t, repos, alice.ID, food.ID, "2024-01")
+		newBudget(t, repos, alice.ID, food.ID, "2024-02")
+		newBudget(t, repos, bob.ID, newCategory(t, repos, bob.ID, "Food").ID, "2024-01")
+
+		budgets, err := repos.Budget.GetUserBudgets(ctx, alice.ID, "2024-01")
+		require.NoError(t, err)
+		assert.Equal(t, []model.Budget{january}, budgets)
+
+		none, err := repos.Budget.GetUserBudgets(ctx, alice.ID, "2023-12")
+		assert.NoError(t, err)
+		assert.NotNil(t, none)
+		assert.Empty(t, none)
+	})
+
+	t.Run("GetCategoryBudget finds the category's budget for the month", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		budget := newBudget(t, repos, user.ID, category.ID, "2024-01")
+
+		got, err := repos.Budget.GetCategoryBudget(ctx, category.ID, "2024-01")
+		assert.NoError(t, err)
+		assert.Equal(t, budget, got)
+
+		_, err = repos.Budget.GetCategoryBudget(ctx, category.ID, "2024-02")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("CreateBudget allows one budget per category and month", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		budget := newBudget(t, repos, user.ID, category.ID, "2024-01")
+
+		budget.ID = "budget-other"
+
+		assert.ErrorIs(t, repos.Budget.CreateBudget(ctx, budget), model.ErrDuplicateKey)
+	})
+
+	t.Run("UpdateBudget", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		food := newCategory(t, repos, user.ID, "Food")
+		rent := newCategory(t, repos, user.ID, "Rent")
+		budget := newBudget(t, repos, user.ID, food.ID, "2024-01")
+		taken := newBudget(t, repos, user.ID, rent.ID, "2024-02")
+
+		budget.Category_ID = rent.ID
+		budget.Amount = model.NewMoney(2500, "USD")
+		budget.Rollover = true
+		require.NoError(t, repos.Budget.UpdateBudget(ctx, budget.ID, budget))
+
+		got, err := repos.Budget.GetBudget(ctx, budget.ID)
+		require.NoError(t, err)
+		assert.Equal(t, budget, got)
+
+		budget.Month = taken.Month
+		assert.ErrorIs(t, repos.Budget.UpdateBudget(ctx, budget.ID, budget), model.ErrDuplicateKey, "Expected the month to be taken by another budget")
+	})
+
+	t.Run("Budgets are deleted with their category and user", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		food := newCategory(t, repos, user.ID, "Food")
+		rent := newCategory(t, repos, user.ID, "Rent")
+		byCategory := newBudget(t, repos, user.ID, food.ID, "2024-01")
+		byUser := newBudget(t, repos, user.ID, rent.ID, "2024-01")
+
+		require.NoError(t, repos.Category.DeleteCategory(ctx, food.ID))
+		_, err := repos.Budget.GetBudget(ctx, byCategory.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		require.NoError(t, repos.User.DeleteUser(ctx, user.ID))
+		_, err = repos.Budget.GetBudget(ctx, byUser.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("DeleteBudget", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		budget := newBudget(t, repos, user.ID, category.ID, "2024-01")
+
+		require.NoError(t, repos.Budget.DeleteBudget(ctx, budget.ID))
+
+		_, err := repos.Budget.GetBudget(ctx, budget.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
@@ -0,0 +1,122 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Category checks ICategoryRepository.
+func Category(t *testing.T, factory Factory) {
+	t.Run("GetCategory", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+
+		got, err := repos.Category.GetCategory(ctx, category.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, category, got)
+	})
+
+	t.Run("GetCategory reports a missing category as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+
+		_, err := repos.Category.GetCategory(ctx, "category-missing")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetUserCategories lists only the user's categories", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		food := newCategory(t, repos, alice.ID, "Food")
+		newCategory(t, repos, bob.ID, "Rent")
+
+		categories, err := repos.Category.GetUserCategories(ctx, alice.ID, byName)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Category{food}, categories)
+
+		all, err := repos.Category.GetCategories(ctx, byName)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		none, err := repos.Category.GetUserCategories(ctx, "user-missing", byName)
+		assert.NoError(t, err)
+		assert.NotNil(t, none)
+		assert.Empty(t, none)
+	})
+
+	t.Run("GetUserCategories sorts, searches and continues after a cursor", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		rent := newCategory(t, repos, alice.ID, "Rent")
+		food := newCategory(t, repos, alice.ID, "Food")
+		fuel := newCategory(t, repos, alice.ID, "Fuel")
+
+		categories, err := repos.Category.GetUserCategories(ctx, alice.ID, model.ListQuery{Sort: "name", Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []model.Category{food, fuel}, categories)
+
+		cursor := fuel.Cursor("name")
+		categories, err = repos.Category.GetUserCategories(ctx, alice.ID, model.ListQuery{Sort: "name", After: &cursor})
+		require.NoError(t, err)
+		assert.Equal(t, []model.Category{rent}, categories)
+
+		categories, err = repos.Category.GetCategories(ctx, model.ListQuery{Sort: "-name", Search: "f"})
+		require.NoError(t, err)
+		assert.Equal(t, []model.Category{fuel, food}, categories)
+	})
+
+	t.Run("IsUserCategoryExist is scoped to the user", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		newCategory(t, repos, alice.ID, "Food")
+
+		assert.True(t, repos.Category.IsUserCategoryExist(ctx, alice.ID, "Food"))
+		assert.False(t, repos.Category.IsUserCategoryExist(ctx, alice.ID, "Rent"))
+		assert.False(t, repos.Category.IsUserCategoryExist(ctx, bob.ID, "Food"))
+	})
+
+	t.Run("CreateCategory rejects a name the user already has", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		newCategory(t, repos, alice.ID, "Food")
+
+		err := repos.Category.CreateCategory(ctx, model.Category{ID: "category-dup", Name: "Food", User_ID: alice.ID})
+		assert.ErrorIs(t, err, model.ErrDuplicateKey)
+
+		newCategory(t, repos, bob.ID, "Food")
+	})
+
+	t.Run("DeleteCategory", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+
+		require.NoError(t, repos.Category.DeleteCategory(ctx, category.ID))
+
+		_, err := repos.Category.GetCategory(ctx, category.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.False(t, repos.Category.IsUserCategoryExist(ctx, user.ID, "Food"))
+	})
+
+	t.Run("DeleteCategory refuses a category in use", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeExpense, 100, at(2))))
+
+		assert.ErrorIs(t, repos.Category.DeleteCategory(ctx, category.ID), model.ErrForeignKey)
+
+		_, err := repos.Category.GetCategory(ctx, category.ID)
+		assert.NoError(t, err)
+	})
+}
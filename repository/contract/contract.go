@@ -0,0 +1,153 @@
+// Package contract holds the behaviour every storage backend must share,
+// written as test suites over the repository interfaces. A backend runs them
+// from its own test with a Factory that returns empty storage:
+//
+//	func TestMemory(t *testing.T) {
+//		contract.Run(t, func(t *testing.T) app.Repositories {
+//			return app.MemoryRepositories(memory.NewStore())
+//		})
+//	}
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+// Factory returns a fresh, empty set of repositories sharing one store.
+type Factory func(t *testing.T) app.Repositories
+
+var ctx = context.Background()
+
+// Run runs every suite against the backend built by factory.
+func Run(t *testing.T, factory Factory) {
+	t.Run("User", func(t *testing.T) { User(t, factory) })
+	t.Run("Category", func(t *testing.T) { Category(t, factory) })
+	t.Run("Wallet", func(t *testing.T) { Wallet(t, factory) })
+	t.Run("Transaction", func(t *testing.T) { Transaction(t, factory) })
+	t.Run("Transfer", func(t *testing.T) { Transfer(t, factory) })
+	t.Run("Rate", func(t *testing.T) { Rate(t, factory) })
+	t.Run("Session", func(t *testing.T) { Session(t, factory) })
+	t.Run("APIKey", func(t *testing.T) { APIKey(t, factory) })
+	t.Run("Budget", func(t *testing.T) { Budget(t, factory) })
+	t.Run("Notification", func(t *testing.T) { Notification(t, factory) })
+	t.Run("Recurring", func(t *testing.T) { Recurring(t, factory) })
+	t.Run("UnitOfWork", func(t *testing.T) { UnitOfWork(t, factory) })
+}
+
+// Listing queries in each listing's default order, without a limit.
+var (
+	byCreation = model.ListQuery{Sort: "created_at"}
+	byName     = model.ListQuery{Sort: "name"}
+	byDate     = model.TransactionQuery{ListQuery: model.ListQuery{Sort: "-date"}}
+)
+
+// at returns a timestamp on day of January 2024. Fixtures use whole seconds
+// in UTC so every backend stores them exactly.
+func at(day int) time.Time {
+	return time.Date(2024, time.January, day, 10, 0, 0, 0, time.UTC)
+}
+
+func newUser(t *testing.T, repos app.Repositories, username string) model.User {
+	t.Helper()
+
+	user := model.User{
+		ID:         "user-" + utils.GenerateRandomID(10),
+		Username:   username,
+		Password:   "hash-of-" + username,
+		Email:      username + "@example.com",
+		Role:       model.RoleUser,
+		Created_At: at(1),
+	}
+
+	require.NoError(t, repos.User.CreateUser(ctx, user))
+
+	return user
+}
+
+func newCategory(t *testing.T, repos app.Repositories, userID string, name string) model.Category {
+	t.Helper()
+
+	category := model.Category{ID: "category-" + utils.GenerateRandomID(10), Name: name, User_ID: userID}
+
+	require.NoError(t, repos.Category.CreateCategory(ctx, category))
+
+	return category
+}
+
+func newWallet(t *testing.T, repos app.Repositories, userID string, name string) model.Wallet {
+	t.Helper()
+
+	wallet := model.Wallet{
+		ID:       "wallet-" + utils.GenerateRandomID(10),
+		Name:     name,
+		Currency: "IDR",
+		Balance:  model.NewMoney(0, "IDR"),
+		User_ID:  userID,
+	}
+
+	require.NoError(t, repos.Wallet.CreateWallet(ctx, wallet))
+
+	return wallet
+}
+
+func newBudget(t *testing.T, repos app.Repositories, userID string, categoryID string, month string) model.Budget {
+	t.Helper()
+
+	budget := model.Budget{
+		ID:          "budget-" + utils.GenerateRandomID(10),
+		User_ID:     userID,
+		Category_ID: categoryID,
+		Month:       month,
+		Amount:      model.NewMoney(100000, "IDR"),
+		Created_At:  at(1),
+	}
+
+	require.NoError(t, repos.Budget.CreateBudget(ctx, budget))
+
+	return budget
+}
+
+func balance(t *testing.T, repos app.Repositories, walletID string) int64 {
+	t.Helper()
+
+	wallet, err := repos.Wallet.GetWallet(ctx, walletID)
+	require.NoError(t, err)
+
+	return wallet.Balance.Amount
+}
+
+func newTransaction(userID string, walletID string, categoryID string, kind string, amount int64, date time.Time) model.Transaction {
+	return model.Transaction{
+		ID:               "transaction-" + utils.GenerateRandomID(10),
+		User_ID:          userID,
+		Wallet_ID:        walletID,
+		Category_ID:      categoryID,
+		Type:             kind,
+		Amount:           model.NewMoney(amount, "IDR"),
+		Original_Amount:  model.NewMoney(amount, "IDR"),
+		Description:      kind,
+		Transaction_Date: date,
+		Created_At:       date,
+	}
+}
+
+func newTransfer(userID string, fromWalletID string, toWalletID string, amount int64, date time.Time) model.Transfer {
+	return model.Transfer{
+		ID:             "transfer-" + utils.GenerateRandomID(10),
+		User_ID:        userID,
+		From_Wallet_ID: fromWalletID,
+		To_Wallet_ID:   toWalletID,
+		Amount:         model.NewMoney(amount, "IDR"),
+		To_Amount:      model.NewMoney(amount, "IDR"),
+		Description:    "transfer",
+		Transfer_Date:  date,
+		Created_At:     date,
+	}
+}
@@ -0,0 +1,62 @@
+package contract_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/config"
+	"github.com/varomnrg/money-tracker/migration"
+	"github.com/varomnrg/money-tracker/repository/contract"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+func TestMemory(t *testing.T) {
+	contract.Run(t, func(t *testing.T) app.Repositories {
+		return app.MemoryRepositories(memory.NewStore())
+	})
+}
+
+func TestSqlite(t *testing.T) {
+	contract.Run(t, func(t *testing.T) app.Repositories {
+		return app.SqliteRepositories(openMigrated(t, "sqlite::memory:", false))
+	})
+}
+
+// TestPostgresql runs against the database in TEST_DATABASE_URL. Every
+// subtest migrates that database down to nothing and back up, so never point
+// it at data you want to keep.
+func TestPostgresql(t *testing.T) {
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	contract.Run(t, func(t *testing.T) app.Repositories {
+		return app.PostgresqlRepositories(openMigrated(t, url, true))
+	})
+}
+
+func openMigrated(t *testing.T, url string, reset bool) *sql.DB {
+	t.Helper()
+
+	db, err := app.OpenDatabase(config.Database{URL: url})
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := migration.New(db)
+	require.NoError(t, err)
+
+	if reset {
+		_, err = migrator.To(context.Background(), 0)
+		require.NoError(t, err)
+	}
+
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	return db
+}
@@ -0,0 +1,154 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Notification checks INotificationRepository.
+func Notification(t *testing.T, factory Factory) {
+	notification := func(t *testing.T, repos app.Repositories, userID string, id string, day int) model.Notification {
+		t.Helper()
+
+		created := model.Notification{
+			ID:         id,
+			User_ID:    userID,
+			Kind:       model.NotificationBudgetThreshold,
+			Key:        "event-" + id,
+			Title:      "Title of " + id,
+			Message:    "Message of " + id,
+			Created_At: at(day),
+		}
+
+		require.NoError(t, repos.Notification.CreateNotification(ctx, created))
+
+		return created
+	}
+
+	newest := model.NotificationQuery{ListQuery: model.ListQuery{Sort: "-created_at"}}
+
+	t.Run("GetNotification", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		created := notification(t, repos, user.ID, "notification-1", 2)
+
+		got, err := repos.Notification.GetNotification(ctx, created.ID)
+		require.NoError(t, err)
+		got.Created_At = got.Created_At.UTC()
+		assert.Equal(t, created, got)
+
+		_, err = repos.Notification.GetNotification(ctx, "notification-missing")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetNotificationByKey is scoped to the user", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		created := notification(t, repos, alice.ID, "notification-1", 2)
+
+		got, err := repos.Notification.GetNotificationByKey(ctx, alice.ID, created.Key)
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, got.ID)
+
+		_, err = repos.Notification.GetNotificationByKey(ctx, bob.ID, created.Key)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("CreateNotification allows one notification per user and key", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		created := notification(t, repos, user.ID, "notification-1", 2)
+
+		created.ID = "notification-2"
+
+		assert.ErrorIs(t, repos.Notification.CreateNotification(ctx, created), model.ErrDuplicateKey)
+	})
+
+	t.Run("GetUserNotifications lists the user's notifications newest first", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		notification(t, repos, alice.ID, "notification-1", 2)
+		notification(t, repos, alice.ID, "notification-3", 4)
+		notification(t, repos, alice.ID, "notification-2", 3)
+		notification(t, repos, bob.ID, "notification-4", 5)
+
+		query := newest
+		query.Limit = 2
+
+		notifications, err := repos.Notification.GetUserNotifications(ctx, alice.ID, query)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"notification-3", "notification-2"}, notificationIDs(notifications))
+
+		cursor := notifications[1].Cursor("-created_at")
+		query = newest
+		query.After = &cursor
+
+		notifications, err = repos.Notification.GetUserNotifications(ctx, alice.ID, query)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"notification-1"}, notificationIDs(notifications))
+
+		query = newest
+		query.Search = "MESSAGE OF NOTIFICATION-2"
+
+		notifications, err = repos.Notification.GetUserNotifications(ctx, alice.ID, query)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"notification-2"}, notificationIDs(notifications))
+	})
+
+	t.Run("MarkRead keeps the first read time", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		created := notification(t, repos, user.ID, "notification-1", 2)
+
+		require.NoError(t, repos.Notification.MarkRead(ctx, created.ID, at(5)))
+		require.NoError(t, repos.Notification.MarkRead(ctx, created.ID, at(6)))
+
+		got, err := repos.Notification.GetNotification(ctx, created.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.Read_At)
+		assert.True(t, at(5).Equal(*got.Read_At))
+	})
+
+	t.Run("MarkAllRead marks only the user's notifications", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		notification(t, repos, alice.ID, "notification-1", 2)
+		notification(t, repos, alice.ID, "notification-2", 3)
+		notification(t, repos, bob.ID, "notification-3", 4)
+
+		unread := newest
+		unread.Unread = true
+
+		notifications, err := repos.Notification.GetUserNotifications(ctx, alice.ID, unread)
+		require.NoError(t, err)
+		assert.Len(t, notifications, 2)
+
+		require.NoError(t, repos.Notification.MarkAllRead(ctx, alice.ID, at(5)))
+
+		notifications, err = repos.Notification.GetUserNotifications(ctx, alice.ID, unread)
+		require.NoError(t, err)
+		assert.Empty(t, notifications)
+
+		notifications, err = repos.Notification.GetUserNotifications(ctx, bob.ID, unread)
+		require.NoError(t, err)
+		assert.Len(t, notifications, 1, "Expected other users' notifications to stay unread")
+	})
+}
+
+func notificationIDs(notifications []model.Notification) []string {
+	ids := make([]string, 0, len(notifications))
+
+	for _, notification := range notifications {
+		ids = append(ids, notification.ID)
+	}
+
+	return ids
+}
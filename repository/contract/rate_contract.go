@@ -0,0 +1,70 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Rate checks IRateRepository.
+func Rate(t *testing.T, factory Factory) {
+	date := func(day int) time.Time {
+		return time.Date(2024, time.January, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("GetRate returns the latest rate on or before the date", func(t *testing.T) {
+		repos := factory(t)
+		require.NoError(t, repos.Rate.SaveRate(ctx, model.ExchangeRate{ID: "rate-1", Base: "USD", Quote: "IDR", Rate: 15000, Date: date(1)}))
+		require.NoError(t, repos.Rate.SaveRate(ctx, model.ExchangeRate{ID: "rate-2", Base: "USD", Quote: "IDR", Rate: 15500, Date: date(10)}))
+
+		rate, err := repos.Rate.GetRate(ctx, "USD", "IDR", date(5))
+		require.NoError(t, err)
+		assert.Equal(t, "rate-1", rate.ID)
+		assert.Equal(t, 15000.0, rate.Rate)
+		assert.True(t, date(1).Equal(rate.Date))
+
+		rate, err = repos.Rate.GetRate(ctx, "USD", "IDR", date(10))
+		require.NoError(t, err)
+		assert.Equal(t, "rate-2", rate.ID)
+	})
+
+	t.Run("GetRate reports a missing rate as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+		require.NoError(t, repos.Rate.SaveRate(ctx, model.ExchangeRate{ID: "rate-1", Base: "USD", Quote: "IDR", Rate: 15000, Date: date(10)}))
+
+		_, err := repos.Rate.GetRate(ctx, "USD", "IDR", date(5))
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = repos.Rate.GetRate(ctx, "IDR", "USD", date(10))
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("SaveRate replaces the rate for the same pair and date", func(t *testing.T) {
+		repos := factory(t)
+		require.NoError(t, repos.Rate.SaveRate(ctx, model.ExchangeRate{ID: "rate-1", Base: "USD", Quote: "IDR", Rate: 15000, Date: date(1)}))
+		require.NoError(t, repos.Rate.SaveRate(ctx, model.ExchangeRate{ID: "rate-2", Base: "USD", Quote: "IDR", Rate: 16000, Date: date(1)}))
+
+		rates, err := repos.Rate.GetRates(ctx)
+		require.NoError(t, err)
+		require.Len(t, rates, 1)
+		assert.Equal(t, 16000.0, rates[0].Rate)
+	})
+
+	t.Run("DeleteRate", func(t *testing.T) {
+		repos := factory(t)
+		require.NoError(t, repos.Rate.SaveRate(ctx, model.ExchangeRate{ID: "rate-1", Base: "USD", Quote: "IDR", Rate: 15000, Date: date(1)}))
+
+		require.NoError(t, repos.Rate.DeleteRate(ctx, "rate-1"))
+
+		rates, err := repos.Rate.GetRates(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, rates)
+		assert.Empty(t, rates)
+
+		assert.ErrorIs(t, repos.Rate.DeleteRate(ctx, "rate-1"), sql.ErrNoRows)
+	})
+}
@@ -0,0 +1,185 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/app"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+// Recurring checks IRecurringRepository.
+func Recurring(t *testing.T, factory Factory) {
+	t.Run("GetRecurringTransaction", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		category := newCategory(t, repos, user.ID, "Gym")
+
+		until := at(31)
+		recurring := recurringTransaction(user.ID, wallet.ID, category.ID, at(5))
+		recurring.Frequency = model.FrequencyWeekly
+		recurring.Interval = 2
+		recurring.Weekdays = []string{"MO", "TH"}
+		recurring.Until = &until
+		recurring.Count = 6
+		recurring.Last_Date = ptr(at(4))
+		recurring.Occurrences = 1
+		require.NoError(t, repos.Recurring.CreateRecurringTransaction(ctx, recurring))
+
+		got, err := repos.Recurring.GetRecurringTransaction(ctx, recurring.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, recurring, got)
+	})
+
+	t.Run("GetRecurringTransaction reports a missing recurring transaction as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+
+		_, err := repos.Recurring.GetRecurringTransaction(ctx, "rec-missing")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetUserRecurringTransactions lists only the user's, oldest first", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		rent := newRecurring(t, repos, alice.ID, at(1), func(r *model.RecurringTransaction) { r.Created_At = at(2) })
+		salary := newRecurring(t, repos, alice.ID, at(25), func(r *model.RecurringTransaction) { r.Created_At = at(1) })
+		newRecurring(t, repos, bob.ID, at(1))
+
+		recurring, err := repos.Recurring.GetUserRecurringTransactions(ctx, alice.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.RecurringTransaction{salary, rent}, recurring)
+	})
+
+	t.Run("GetDueRecurringTransactions skips paused, ended and future ones", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		later := newRecurring(t, repos, user.ID, at(10))
+		sooner := newRecurring(t, repos, user.ID, at(3))
+		newRecurring(t, repos, user.ID, at(10).Add(time.Second))
+		newRecurring(t, repos, user.ID, at(2), func(r *model.RecurringTransaction) { r.Paused = true })
+		newRecurring(t, repos, user.ID, at(2), func(r *model.RecurringTransaction) { r.Next_Date = nil })
+
+		due, err := repos.Recurring.GetDueRecurringTransactions(ctx, at(10))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.RecurringTransaction{sooner, later}, due)
+	})
+
+	t.Run("UpdateRecurringTransaction only updates what was read", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		recurring := newRecurring(t, repos, user.ID, at(1))
+
+		advanced := recurring
+		advanced.Last_Date = recurring.Next_Date
+		advanced.Next_Date = ptr(at(2))
+		advanced.Occurrences = 1
+		advanced.Amount = model.NewMoney(2500, "USD")
+		advanced.Description = "Rent, from February"
+		advanced.Weekdays = []string{"FR"}
+		advanced.Paused = true
+
+		updated, err := repos.Recurring.UpdateRecurringTransaction(ctx, advanced, recurring.Occurrences)
+		require.NoError(t, err)
+		assert.True(t, updated)
+
+		got, err := repos.Recurring.GetRecurringTransaction(ctx, recurring.ID)
+		require.NoError(t, err)
+		assert.Equal(t, advanced, got)
+
+		updated, err = repos.Recurring.UpdateRecurringTransaction(ctx, recurring, recurring.Occurrences)
+		require.NoError(t, err)
+		assert.False(t, updated, "Expected an update of a stale read to be refused")
+
+		got, err = repos.Recurring.GetRecurringTransaction(ctx, recurring.ID)
+		require.NoError(t, err)
+		assert.Equal(t, advanced, got)
+	})
+
+	t.Run("Recurring transactions are deleted with their wallet, category and user", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		wallet := newWallet(t, repos, user.ID, "Bank")
+		category := newCategory(t, repos, user.ID, "Rent")
+		byWallet := recurringTransaction(user.ID, wallet.ID, newCategory(t, repos, user.ID, "Salary").ID, at(1))
+		byCategory := recurringTransaction(user.ID, newWallet(t, repos, user.ID, "Cash").ID, category.ID, at(1))
+		byUser := newRecurring(t, repos, user.ID, at(1))
+		require.NoError(t, repos.Recurring.CreateRecurringTransaction(ctx, byWallet))
+		require.NoError(t, repos.Recurring.CreateRecurringTransaction(ctx, byCategory))
+
+		require.NoError(t, repos.Wallet.DeleteWallet(ctx, wallet.ID))
+		_, err := repos.Recurring.GetRecurringTransaction(ctx, byWallet.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		require.NoError(t, repos.Category.DeleteCategory(ctx, category.ID))
+		_, err = repos.Recurring.GetRecurringTransaction(ctx, byCategory.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		require.NoError(t, repos.User.DeleteUser(ctx, user.ID))
+		_, err = repos.Recurring.GetRecurringTransaction(ctx, byUser.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("DeleteRecurringTransaction", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		recurring := newRecurring(t, repos, user.ID, at(1))
+
+		require.NoError(t, repos.Recurring.DeleteRecurringTransaction(ctx, recurring.ID))
+
+		_, err := repos.Recurring.GetRecurringTransaction(ctx, recurring.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+// recurringTransaction returns a monthly recurring expense starting, and
+// next due, at start.
+func recurringTransaction(userID string, walletID string, categoryID string, start time.Time) model.RecurringTransaction {
+	return model.RecurringTransaction{
+		ID:          "rec-" + utils.GenerateRandomID(10),
+		User_ID:     userID,
+		Wallet_ID:   walletID,
+		Category_ID: categoryID,
+		Type:        model.TransactionTypeExpense,
+		Amount:      model.NewMoney(150000, "IDR"),
+		Description: "Rent",
+		Recurrence: model.Recurrence{
+			Frequency:  model.FrequencyMonthly,
+			Interval:   1,
+			Start_Date: start,
+		},
+		Next_Date:  ptr(start),
+		Created_At: at(1),
+	}
+}
+
+// newRecurring stores a recurringTransaction of the user, in a wallet and
+// category of its own, after applying options to it.
+func newRecurring(t *testing.T, repos app.Repositories, userID string, start time.Time, options ...func(*model.RecurringTransaction)) model.RecurringTransaction {
+	t.Helper()
+
+	wallet := newWallet(t, repos, userID, "Wallet "+utils.GenerateRandomID(5))
+	category := newCategory(t, repos, userID, "Category "+utils.GenerateRandomID(5))
+	recurring := recurringTransaction(userID, wallet.ID, category.ID, start)
+
+	for _, option := range options {
+		option(&recurring)
+	}
+
+	require.NoError(t, repos.Recurring.CreateRecurringTransaction(ctx, recurring))
+
+	return recurring
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,125 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Session checks ISessionRepository.
+func Session(t *testing.T, factory Factory) {
+	token := func(userID string, sessionID string, id string, createdAt time.Time) model.RefreshToken {
+		return model.RefreshToken{
+			ID:         id,
+			Session_ID: sessionID,
+			User_ID:    userID,
+			Token_Hash: "hash-of-" + id,
+			User_Agent: "curl",
+			Created_At: createdAt,
+			Expires_At: createdAt.Add(30 * 24 * time.Hour),
+		}
+	}
+
+	t.Run("GetRefreshTokenByHash", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		created := token(user.ID, "session-1", "token-1", at(2))
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, created))
+
+		got, err := repos.Session.GetRefreshTokenByHash(ctx, created.Token_Hash)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, got.ID)
+		assert.Equal(t, created.Session_ID, got.Session_ID)
+		assert.Equal(t, created.User_ID, got.User_ID)
+		assert.True(t, created.Expires_At.Equal(got.Expires_At))
+		assert.Nil(t, got.Used_At)
+		assert.Nil(t, got.Revoked_At)
+
+		_, err = repos.Session.GetRefreshTokenByHash(ctx, "hash-missing")
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("UseRefreshToken succeeds only once", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		created := token(user.ID, "session-1", "token-1", at(2))
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, created))
+
+		used, err := repos.Session.UseRefreshToken(ctx, created.ID, at(3))
+		require.NoError(t, err)
+		assert.True(t, used)
+
+		used, err = repos.Session.UseRefreshToken(ctx, created.ID, at(4))
+		require.NoError(t, err)
+		assert.False(t, used)
+
+		got, err := repos.Session.GetRefreshTokenByHash(ctx, created.Token_Hash)
+		require.NoError(t, err)
+		require.NotNil(t, got.Used_At)
+		assert.True(t, at(3).Equal(*got.Used_At))
+	})
+
+	t.Run("RevokeSession revokes every token of the session", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		first := token(user.ID, "session-1", "token-1", at(2))
+		second := token(user.ID, "session-1", "token-2", at(3))
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, first))
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, second))
+
+		require.NoError(t, repos.Session.RevokeSession(ctx, user.ID, "session-1", at(4)))
+
+		used, err := repos.Session.UseRefreshToken(ctx, second.ID, at(5))
+		require.NoError(t, err)
+		assert.False(t, used, "Expected a revoked token not to be usable")
+
+		assert.ErrorIs(t, repos.Session.RevokeSession(ctx, user.ID, "session-1", at(5)), sql.ErrNoRows)
+	})
+
+	t.Run("RevokeSession is scoped to the user", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, token(alice.ID, "session-1", "token-1", at(2))))
+
+		assert.ErrorIs(t, repos.Session.RevokeSession(ctx, bob.ID, "session-1", at(3)), sql.ErrNoRows)
+	})
+
+	t.Run("GetUserSessions lists sessions by their current token", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, token(user.ID, "session-1", "token-1", at(2))))
+		_, err := repos.Session.UseRefreshToken(ctx, "token-1", at(3))
+		require.NoError(t, err)
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, token(user.ID, "session-1", "token-2", at(3))))
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, token(user.ID, "session-2", "token-3", at(4))))
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, token(user.ID, "session-3", "token-4", at(5))))
+		require.NoError(t, repos.Session.RevokeSession(ctx, user.ID, "session-3", at(6)))
+
+		sessions, err := repos.Session.GetUserSessions(ctx, user.ID, at(6))
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+
+		assert.Equal(t, "session-2", sessions[0].ID)
+		assert.Equal(t, "session-1", sessions[1].ID)
+		assert.True(t, at(2).Equal(sessions[1].Created_At), "Expected the session to start with its first token")
+		assert.True(t, at(3).Equal(sessions[1].Last_Used_At))
+		assert.Equal(t, "curl", sessions[1].User_Agent)
+	})
+
+	t.Run("GetUserSessions leaves out expired sessions", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		require.NoError(t, repos.Session.CreateRefreshToken(ctx, token(user.ID, "session-1", "token-1", at(2))))
+
+		sessions, err := repos.Session.GetUserSessions(ctx, user.ID, at(2).Add(31*24*time.Hour))
+
+		assert.NoError(t, err)
+		assert.NotNil(t, sessions)
+		assert.Empty(t, sessions)
+	})
+}
@@ -0,0 +1,273 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Transaction checks ITransactionRepository, including the wallet balance it
+// keeps in step with the ledger.
+func Transaction(t *testing.T, factory Factory) {
+	t.Run("GetTransaction", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		transaction := newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeExpense, 1500, at(2))
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, transaction))
+
+		got, err := repos.Transaction.GetTransaction(ctx, transaction.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, transaction, inUTC(got))
+	})
+
+	t.Run("GetTransaction reports a missing transaction as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+
+		_, err := repos.Transaction.GetTransaction(ctx, "transaction-missing")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetUserTransactions lists the user's transactions newest first", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		category := newCategory(t, repos, alice.ID, "Food")
+		wallet := newWallet(t, repos, alice.ID, "Cash")
+		older := newTransaction(alice.ID, wallet.ID, category.ID, model.TransactionTypeIncome, 100, at(2))
+		newer := newTransaction(alice.ID, wallet.ID, category.ID, model.TransactionTypeIncome, 200, at(3))
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, older))
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, newer))
+
+		transactions, err := repos.Transaction.GetUserTransactions(ctx, alice.ID, byDate)
+		require.NoError(t, err)
+		require.Len(t, transactions, 2)
+		assert.Equal(t, newer.ID, transactions[0].ID)
+		assert.Equal(t, older.ID, transactions[1].ID)
+
+		none, err := repos.Transaction.GetUserTransactions(ctx, bob.ID, byDate)
+		assert.NoError(t, err)
+		assert.NotNil(t, none)
+		assert.Empty(t, none)
+	})
+
+	t.Run("GetUserTransactions filters by date, wallet, category, amount and description", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		food := newCategory(t, repos, alice.ID, "Food")
+		salary := newCategory(t, repos, alice.ID, "Salary")
+		cash := newWallet(t, repos, alice.ID, "Cash")
+		bank := newWallet(t, repos, alice.ID, "Bank")
+		lunch := newTransaction(alice.ID, cash.ID, food.ID, model.TransactionTypeExpense, 100, at(2))
+		lunch.Description = "Lunch with Bob"
+		dinner := newTransaction(alice.ID, cash.ID, food.ID, model.TransactionTypeExpense, 300, at(4))
+		pay := newTransaction(alice.ID, bank.ID, salary.ID, model.TransactionTypeIncome, 5000, at(3))
+		for _, transaction := range []model.Transaction{lunch, dinner, pay} {
+			require.NoError(t, repos.Transaction.CreateTransaction(ctx, transaction))
+		}
+
+		min := model.NewMoney(200, "IDR")
+		max := model.NewMoney(1000, "IDR")
+		dollar := model.NewMoney(1, "USD")
+		tests := []struct {
+			name  string
+			query model.TransactionQuery
+			want  []model.Transaction
+		}{
+			{"no filters", model.TransactionQuery{}, []model.Transaction{dinner, pay, lunch}},
+			{"from is inclusive", model.TransactionQuery{From: at(3)}, []model.Transaction{dinner, pay}},
+			{"to is exclusive", model.TransactionQuery{To: at(3)}, []model.Transaction{lunch}},
+			{"wallet", model.TransactionQuery{Wallet_ID: bank.ID}, []model.Transaction{pay}},
+			{"category", model.TransactionQuery{Category_ID: food.ID}, []model.Transaction{dinner, lunch}},
+			{"amount range", model.TransactionQuery{Min_Amount: &min, Max_Amount: &max}, []model.Transaction{dinner}},
+			{"amount in another currency", model.TransactionQuery{Min_Amount: &dollar}, []model.Transaction{}},
+			{"description", model.TransactionQuery{ListQuery: model.ListQuery{Search: "bob"}}, []model.Transaction{lunch}},
+			{"search treats wildcards literally", model.TransactionQuery{ListQuery: model.ListQuery{Search: "%"}}, []model.Transaction{}},
+		}
+
+		for _, tt := range tests {
+			tt.query.Sort = "-date"
+
+			transactions, err := repos.Transaction.GetUserTransactions(ctx, alice.ID, tt.query)
+
+			require.NoError(t, err, tt.name)
+			for i := range transactions {
+				transactions[i] = inUTC(transactions[i])
+			}
+			assert.Equal(t, tt.want, transactions, tt.name)
+		}
+	})
+
+	t.Run("GetUserTransactions pages through ties in the sort key", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		category := newCategory(t, repos, alice.ID, "Food")
+		wallet := newWallet(t, repos, alice.ID, "Cash")
+		for _, amount := range []int64{300, 100, 200, 100, 300} {
+			transaction := newTransaction(alice.ID, wallet.ID, category.ID, model.TransactionTypeIncome, amount, at(2))
+			require.NoError(t, repos.Transaction.CreateTransaction(ctx, transaction))
+		}
+
+		for _, sort := range []string{"-date", "amount", "-amount"} {
+			all, err := repos.Transaction.GetUserTransactions(ctx, alice.ID, model.TransactionQuery{ListQuery: model.ListQuery{Sort: sort}})
+			require.NoError(t, err)
+			require.Len(t, all, 5)
+
+			paged := make([]model.Transaction, 0)
+			query := model.TransactionQuery{ListQuery: model.ListQuery{Sort: sort, Limit: 2}}
+
+			for len(paged) < len(all) {
+				page, err := repos.Transaction.GetUserTransactions(ctx, alice.ID, query)
+				require.NoError(t, err)
+				require.NotEmpty(t, page, sort)
+
+				paged = append(paged, page...)
+				cursor := page[len(page)-1].Cursor(sort)
+				query.After = &cursor
+			}
+
+			assert.Equal(t, all, paged, sort)
+		}
+
+		ascending, err := repos.Transaction.GetUserTransactions(ctx, alice.ID, model.TransactionQuery{ListQuery: model.ListQuery{Sort: "amount"}})
+		require.NoError(t, err)
+		for i := 1; i < len(ascending); i++ {
+			assert.LessOrEqual(t, ascending[i-1].Amount.Amount, ascending[i].Amount.Amount)
+		}
+	})
+
+	t.Run("GetCategoryTotals sums by category, type and currency in SQL order", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		salary := newCategory(t, repos, alice.ID, "Salary")
+		food := newCategory(t, repos, alice.ID, "Food")
+		cash := newWallet(t, repos, alice.ID, "Cash")
+		bank := newWallet(t, repos, alice.ID, "Bank")
+		dollars := model.Wallet{ID: "wallet-usd", Name: "Dollars", Currency: "USD", Balance: model.NewMoney(0, "USD"), User_ID: alice.ID}
+		require.NoError(t, repos.Wallet.CreateWallet(ctx, dollars))
+
+		abroad := newTransaction(alice.ID, dollars.ID, food.ID, model.TransactionTypeExpense, 700, at(5))
+		abroad.Amount = model.NewMoney(700, "USD")
+		for _, transaction := range []model.Transaction{
+			newTransaction(alice.ID, cash.ID, food.ID, model.TransactionTypeExpense, 100, at(2)),
+			newTransaction(alice.ID, bank.ID, food.ID, model.TransactionTypeExpense, 250, at(3)),
+			newTransaction(alice.ID, cash.ID, food.ID, model.TransactionTypeIncome, 40, at(3)),
+			newTransaction(alice.ID, bank.ID, salary.ID, model.TransactionTypeIncome, 5000, at(4)),
+			newTransaction(alice.ID, cash.ID, food.ID, model.TransactionTypeExpense, 999, at(10)),
+			abroad,
+		} {
+			require.NoError(t, repos.Transaction.CreateTransaction(ctx, transaction))
+		}
+		require.NoError(t, repos.Transfer.CreateTransfer(ctx, newTransfer(alice.ID, bank.ID, cash.ID, 300, at(4))))
+		bobs := newCategory(t, repos, bob.ID, "Food")
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, newTransaction(bob.ID, newWallet(t, repos, bob.ID, "Cash").ID, bobs.ID, model.TransactionTypeExpense, 60, at(2))))
+
+		totals, err := repos.Transaction.GetCategoryTotals(ctx, alice.ID, at(2), at(10))
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.CategoryTotal{
+			{Category_ID: food.ID, Category_Name: "Food", Type: model.TransactionTypeExpense, Total: model.NewMoney(350, "IDR")},
+			{Category_ID: food.ID, Category_Name: "Food", Type: model.TransactionTypeExpense, Total: model.NewMoney(700, "USD")},
+			{Category_ID: food.ID, Category_Name: "Food", Type: model.TransactionTypeIncome, Total: model.NewMoney(40, "IDR")},
+			{Category_ID: salary.ID, Category_Name: "Salary", Type: model.TransactionTypeIncome, Total: model.NewMoney(5000, "IDR")},
+		}, totals)
+
+		none, err := repos.Transaction.GetCategoryTotals(ctx, alice.ID, at(20), at(30))
+		assert.NoError(t, err)
+		assert.NotNil(t, none)
+		assert.Empty(t, none)
+	})
+
+	t.Run("CreateTransaction adjusts the wallet balance", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeIncome, 5000, at(2))))
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeExpense, 1500, at(3))))
+
+		assert.Equal(t, int64(3500), balance(t, repos, wallet.ID))
+	})
+
+	t.Run("CreateTransaction stores nothing for a missing wallet", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		transaction := newTransaction(user.ID, "wallet-missing", category.ID, model.TransactionTypeIncome, 100, at(2))
+
+		assert.ErrorIs(t, repos.Transaction.CreateTransaction(ctx, transaction), model.ErrForeignKey)
+
+		_, err := repos.Transaction.GetTransaction(ctx, transaction.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("UpdateTransaction moves the amount between wallets", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		cash := newWallet(t, repos, user.ID, "Cash")
+		bank := newWallet(t, repos, user.ID, "Bank")
+		transaction := newTransaction(user.ID, cash.ID, category.ID, model.TransactionTypeIncome, 5000, at(2))
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, transaction))
+
+		update := newTransaction(user.ID, bank.ID, category.ID, model.TransactionTypeExpense, 700, at(4))
+		require.NoError(t, repos.Transaction.UpdateTransaction(ctx, transaction.ID, update))
+
+		assert.Equal(t, int64(0), balance(t, repos, cash.ID))
+		assert.Equal(t, int64(-700), balance(t, repos, bank.ID))
+
+		got, err := repos.Transaction.GetTransaction(ctx, transaction.ID)
+		require.NoError(t, err)
+		got = inUTC(got)
+		assert.Equal(t, bank.ID, got.Wallet_ID)
+		assert.Equal(t, model.TransactionTypeExpense, got.Type)
+		assert.Equal(t, model.NewMoney(700, "IDR"), got.Amount)
+		assert.Equal(t, at(4), got.Transaction_Date)
+		assert.Equal(t, transaction.Created_At, got.Created_At, "Expected the creation time to stay")
+	})
+
+	t.Run("UpdateTransaction reports a missing transaction as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+
+		err := repos.Transaction.UpdateTransaction(ctx, "transaction-missing", newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeIncome, 100, at(2)))
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Equal(t, int64(0), balance(t, repos, wallet.ID))
+	})
+
+	t.Run("DeleteTransaction reverts the wallet balance", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		transaction := newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeExpense, 1500, at(2))
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, transaction))
+
+		require.NoError(t, repos.Transaction.DeleteTransaction(ctx, transaction.ID))
+
+		assert.Equal(t, int64(0), balance(t, repos, wallet.ID))
+
+		_, err := repos.Transaction.GetTransaction(ctx, transaction.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		assert.ErrorIs(t, repos.Transaction.DeleteTransaction(ctx, transaction.ID), sql.ErrNoRows)
+	})
+}
+
+func inUTC(transaction model.Transaction) model.Transaction {
+	transaction.Transaction_Date = transaction.Transaction_Date.UTC()
+	transaction.Created_At = transaction.Created_At.UTC()
+
+	return transaction
+}
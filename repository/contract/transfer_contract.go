@@ -0,0 +1,123 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Transfer checks ITransferRepository and the transaction legs it maintains.
+func Transfer(t *testing.T, factory Factory) {
+	t.Run("CreateTransfer records both legs and balances", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		cash := newWallet(t, repos, user.ID, "Cash")
+		bank := newWallet(t, repos, user.ID, "Bank")
+		transfer := newTransfer(user.ID, cash.ID, bank.ID, 2500, at(2))
+
+		require.NoError(t, repos.Transfer.CreateTransfer(ctx, transfer))
+
+		got, err := repos.Transfer.GetTransfer(ctx, transfer.ID)
+		require.NoError(t, err)
+		got.Transfer_Date = got.Transfer_Date.UTC()
+		got.Created_At = got.Created_At.UTC()
+		assert.Equal(t, transfer, got)
+
+		assert.Equal(t, int64(-2500), balance(t, repos, cash.ID))
+		assert.Equal(t, int64(2500), balance(t, repos, bank.ID))
+
+		debit, credit := transfer.Legs()
+		for _, leg := range []model.Transaction{debit, credit} {
+			stored, err := repos.Transaction.GetTransaction(ctx, leg.ID)
+			require.NoError(t, err)
+			assert.Equal(t, leg, inUTC(stored))
+		}
+	})
+
+	t.Run("GetTransfer reports a missing transfer as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+
+		_, err := repos.Transfer.GetTransfer(ctx, "transfer-missing")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetUserTransfers lists only the user's transfers", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		cash := newWallet(t, repos, alice.ID, "Cash")
+		bank := newWallet(t, repos, alice.ID, "Bank")
+		transfer := newTransfer(alice.ID, cash.ID, bank.ID, 100, at(2))
+		require.NoError(t, repos.Transfer.CreateTransfer(ctx, transfer))
+
+		transfers, err := repos.Transfer.GetUserTransfers(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Len(t, transfers, 1)
+		assert.Equal(t, transfer.ID, transfers[0].ID)
+
+		none, err := repos.Transfer.GetUserTransfers(ctx, bob.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, none)
+		assert.Empty(t, none)
+	})
+
+	t.Run("CreateTransfer stores nothing for a missing wallet", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		cash := newWallet(t, repos, user.ID, "Cash")
+		transfer := newTransfer(user.ID, cash.ID, "wallet-missing", 100, at(2))
+
+		assert.ErrorIs(t, repos.Transfer.CreateTransfer(ctx, transfer), model.ErrForeignKey)
+
+		_, err := repos.Transfer.GetTransfer(ctx, transfer.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Equal(t, int64(0), balance(t, repos, cash.ID))
+	})
+
+	t.Run("UpdateTransfer replaces the legs", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		cash := newWallet(t, repos, user.ID, "Cash")
+		bank := newWallet(t, repos, user.ID, "Bank")
+		savings := newWallet(t, repos, user.ID, "Savings")
+		transfer := newTransfer(user.ID, cash.ID, bank.ID, 2500, at(2))
+		require.NoError(t, repos.Transfer.CreateTransfer(ctx, transfer))
+
+		update := newTransfer(user.ID, bank.ID, savings.ID, 400, at(3))
+		require.NoError(t, repos.Transfer.UpdateTransfer(ctx, transfer.ID, update))
+
+		assert.Equal(t, int64(0), balance(t, repos, cash.ID))
+		assert.Equal(t, int64(-400), balance(t, repos, bank.ID))
+		assert.Equal(t, int64(400), balance(t, repos, savings.ID))
+
+		got, err := repos.Transfer.GetTransfer(ctx, transfer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bank.ID, got.From_Wallet_ID)
+		assert.Equal(t, savings.ID, got.To_Wallet_ID)
+		assert.Equal(t, model.NewMoney(400, "IDR"), got.Amount)
+	})
+
+	t.Run("DeleteTransfer removes the legs and reverts balances", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		cash := newWallet(t, repos, user.ID, "Cash")
+		bank := newWallet(t, repos, user.ID, "Bank")
+		transfer := newTransfer(user.ID, cash.ID, bank.ID, 2500, at(2))
+		require.NoError(t, repos.Transfer.CreateTransfer(ctx, transfer))
+
+		require.NoError(t, repos.Transfer.DeleteTransfer(ctx, transfer.ID))
+
+		assert.Equal(t, int64(0), balance(t, repos, cash.ID))
+		assert.Equal(t, int64(0), balance(t, repos, bank.ID))
+
+		transactions, err := repos.Transaction.GetUserTransactions(ctx, user.ID, byDate)
+		assert.NoError(t, err)
+		assert.Empty(t, transactions)
+
+		assert.ErrorIs(t, repos.Transfer.DeleteTransfer(ctx, transfer.ID), sql.ErrNoRows)
+	})
+}
@@ -0,0 +1,85 @@
+package contract
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// UnitOfWork checks that repository calls made inside IUnitOfWork.Do commit
+// and roll back together.
+func UnitOfWork(t *testing.T, factory Factory) {
+	t.Run("Do commits every call", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		transaction := newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeIncome, 100, at(2))
+
+		err := repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := repos.Transaction.CreateTransaction(ctx, transaction); err != nil {
+				return err
+			}
+
+			return repos.Wallet.UpdateWallet(ctx, wallet.ID, model.WalletRequest{Name: "Pocket"})
+		})
+		require.NoError(t, err)
+
+		got, err := repos.Wallet.GetWallet(ctx, wallet.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Pocket", got.Name)
+		assert.Equal(t, int64(100), got.Balance.Amount)
+	})
+
+	t.Run("Do rolls back every call when fn fails", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		transaction := newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeIncome, 100, at(2))
+		failure := errors.New("failure")
+
+		err := repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+			if err := repos.Transaction.CreateTransaction(ctx, transaction); err != nil {
+				return err
+			}
+
+			if err := repos.Category.CreateCategory(ctx, model.Category{ID: "category-new", Name: "Rent", User_ID: user.ID}); err != nil {
+				return err
+			}
+
+			return failure
+		})
+		assert.ErrorIs(t, err, failure)
+
+		_, err = repos.Transaction.GetTransaction(ctx, transaction.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Equal(t, int64(0), balance(t, repos, wallet.ID))
+		assert.False(t, repos.Category.IsUserCategoryExist(ctx, user.ID, "Rent"))
+	})
+
+	t.Run("nested Do joins the outer unit", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		failure := errors.New("failure")
+
+		err := repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+			err := repos.UnitOfWork.Do(ctx, func(ctx context.Context) error {
+				return repos.Category.CreateCategory(ctx, model.Category{ID: "category-new", Name: "Rent", User_ID: user.ID})
+			})
+			if err != nil {
+				return err
+			}
+
+			return failure
+		})
+		assert.ErrorIs(t, err, failure)
+
+		assert.False(t, repos.Category.IsUserCategoryExist(ctx, user.ID, "Rent"))
+	})
+}
@@ -0,0 +1,173 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// User checks IUserRepository.
+func User(t *testing.T, factory Factory) {
+	t.Run("GetUser returns the user without its password", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+
+		got, err := repos.User.GetUser(ctx, user.ID)
+
+		require.NoError(t, err)
+		got.Created_At = got.Created_At.UTC()
+		assert.Equal(t, model.UserResponse{ID: user.ID, Username: "alice", Email: "alice@example.com", Role: model.RoleUser, Created_At: user.Created_At}, got)
+	})
+
+	t.Run("GetUser reports a missing user as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+
+		_, err := repos.User.GetUser(ctx, "user-missing")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetUsers lists every user", func(t *testing.T) {
+		repos := factory(t)
+		newUser(t, repos, "alice")
+		newUser(t, repos, "bob")
+
+		users, err := repos.User.GetUsers(ctx, byCreation)
+		require.NoError(t, err)
+
+		withPassword, err := repos.User.GetUsersWithPassword(ctx)
+		require.NoError(t, err)
+
+		assert.Len(t, users, 2)
+		assert.Len(t, withPassword, 2)
+		for _, user := range withPassword {
+			assert.Equal(t, "hash-of-"+user.Username, user.Password)
+		}
+	})
+
+	t.Run("GetUsers returns an empty list without users", func(t *testing.T) {
+		repos := factory(t)
+
+		users, err := repos.User.GetUsers(ctx, byCreation)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, users)
+		assert.Empty(t, users)
+	})
+
+	t.Run("GetUsers sorts, searches and continues after a cursor", func(t *testing.T) {
+		repos := factory(t)
+		newUser(t, repos, "carol")
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+
+		users, err := repos.User.GetUsers(ctx, model.ListQuery{Sort: "-username", Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"carol", "bob"}, usernames(users))
+
+		cursor := model.UserResponse{ID: bob.ID, Username: bob.Username}.Cursor("-username")
+		users, err = repos.User.GetUsers(ctx, model.ListQuery{Sort: "-username", After: &cursor})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice"}, usernames(users))
+
+		users, err = repos.User.GetUsers(ctx, model.ListQuery{Sort: "username", Search: "ALICE@"})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, alice.ID, users[0].ID)
+	})
+
+	t.Run("IsUsernameExist", func(t *testing.T) {
+		repos := factory(t)
+		newUser(t, repos, "alice")
+
+		assert.True(t, repos.User.IsUsernameExist(ctx, "alice"))
+		assert.False(t, repos.User.IsUsernameExist(ctx, "bob"))
+	})
+
+	t.Run("CreateUser rejects a taken username", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		user.ID = "user-other"
+
+		assert.ErrorIs(t, repos.User.CreateUser(ctx, user), model.ErrDuplicateKey)
+	})
+
+	t.Run("GetUserByUsername returns the password hash", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+
+		got, err := repos.User.GetUserByUsername(ctx, "alice")
+
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, got.ID)
+		assert.Equal(t, user.Password, got.Password)
+
+		_, err = repos.User.GetUserByUsername(ctx, "bob")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("UpdateUser replaces username, password and email", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+
+		err := repos.User.UpdateUser(ctx, user.ID, model.UserRequest{Username: "alicia", Password: "new-hash", Email: "alicia@example.com"})
+		require.NoError(t, err)
+
+		got, err := repos.User.GetUserByUsername(ctx, "alicia")
+		require.NoError(t, err)
+
+		assert.Equal(t, user.ID, got.ID)
+		assert.Equal(t, "new-hash", got.Password)
+		assert.Equal(t, "alicia@example.com", got.Email)
+		assert.Equal(t, model.RoleUser, got.Role)
+		assert.False(t, repos.User.IsUsernameExist(ctx, "alice"))
+	})
+
+	t.Run("UpdatePassword", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+
+		require.NoError(t, repos.User.UpdatePassword(ctx, user.ID, "rehashed"))
+
+		got, err := repos.User.GetUserByUsername(ctx, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, "rehashed", got.Password)
+	})
+
+	t.Run("DeleteUser removes what the user owns", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		other := newUser(t, repos, "bob")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		kept := newWallet(t, repos, other.ID, "Cash")
+
+		require.NoError(t, repos.User.DeleteUser(ctx, user.ID))
+
+		_, err := repos.User.GetUser(ctx, user.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = repos.Category.GetCategory(ctx, category.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = repos.Wallet.GetWallet(ctx, wallet.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = repos.Wallet.GetWallet(ctx, kept.ID)
+		assert.NoError(t, err, "Expected other users' data to stay")
+	})
+}
+
+func usernames(users []model.UserResponse) []string {
+	names := make([]string, 0, len(users))
+
+	for _, user := range users {
+		names = append(names, user.Username)
+	}
+
+	return names
+}
@@ -0,0 +1,118 @@
+package contract
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// Wallet checks IWalletRepository.
+func Wallet(t *testing.T, factory Factory) {
+	t.Run("GetWallet", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		wallet := model.Wallet{ID: "wallet-usd", Name: "Travel", Currency: "USD", Balance: model.NewMoney(1250, "USD"), User_ID: user.ID}
+		require.NoError(t, repos.Wallet.CreateWallet(ctx, wallet))
+
+		got, err := repos.Wallet.GetWallet(ctx, wallet.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, wallet, got)
+	})
+
+	t.Run("GetWallet reports a missing wallet as sql.ErrNoRows", func(t *testing.T) {
+		repos := factory(t)
+
+		_, err := repos.Wallet.GetWallet(ctx, "wallet-missing")
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("GetUserWallets lists only the user's wallets", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		cash := newWallet(t, repos, alice.ID, "Cash")
+		newWallet(t, repos, bob.ID, "Cash")
+
+		wallets, err := repos.Wallet.GetUserWallets(ctx, alice.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.Wallet{cash}, wallets)
+	})
+
+	t.Run("IsUserWalletExist is scoped to the user", func(t *testing.T) {
+		repos := factory(t)
+		alice := newUser(t, repos, "alice")
+		bob := newUser(t, repos, "bob")
+		newWallet(t, repos, alice.ID, "Cash")
+
+		assert.True(t, repos.Wallet.IsUserWalletExist(ctx, alice.ID, "Cash"))
+		assert.False(t, repos.Wallet.IsUserWalletExist(ctx, alice.ID, "Bank"))
+		assert.False(t, repos.Wallet.IsUserWalletExist(ctx, bob.ID, "Cash"))
+	})
+
+	t.Run("CreateWallet rejects a name the user already has", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		wallet.ID = "wallet-dup"
+
+		assert.ErrorIs(t, repos.Wallet.CreateWallet(ctx, wallet), model.ErrDuplicateKey)
+	})
+
+	t.Run("UpdateWallet only renames", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+
+		require.NoError(t, repos.Wallet.UpdateWallet(ctx, wallet.ID, model.WalletRequest{Name: "Pocket", Currency: "USD"}))
+
+		got, err := repos.Wallet.GetWallet(ctx, wallet.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Pocket", got.Name)
+		assert.Equal(t, "IDR", got.Currency)
+	})
+
+	t.Run("UpdateWallet rejects a name the user already has", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		newWallet(t, repos, user.ID, "Cash")
+		bank := newWallet(t, repos, user.ID, "Bank")
+
+		assert.ErrorIs(t, repos.Wallet.UpdateWallet(ctx, bank.ID, model.WalletRequest{Name: "Cash"}), model.ErrDuplicateKey)
+	})
+
+	t.Run("DeleteWallet removes its transactions", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		category := newCategory(t, repos, user.ID, "Food")
+		wallet := newWallet(t, repos, user.ID, "Cash")
+		transaction := newTransaction(user.ID, wallet.ID, category.ID, model.TransactionTypeIncome, 100, at(2))
+		require.NoError(t, repos.Transaction.CreateTransaction(ctx, transaction))
+
+		require.NoError(t, repos.Wallet.DeleteWallet(ctx, wallet.ID))
+
+		_, err := repos.Wallet.GetWallet(ctx, wallet.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = repos.Transaction.GetTransaction(ctx, transaction.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("DeleteWallet refuses a wallet with transfers", func(t *testing.T) {
+		repos := factory(t)
+		user := newUser(t, repos, "alice")
+		cash := newWallet(t, repos, user.ID, "Cash")
+		bank := newWallet(t, repos, user.ID, "Bank")
+		require.NoError(t, repos.Transfer.CreateTransfer(ctx, newTransfer(user.ID, cash.ID, bank.ID, 100, at(2))))
+
+		assert.ErrorIs(t, repos.Wallet.DeleteWallet(ctx, cash.ID), model.ErrForeignKey)
+
+		_, err := repos.Wallet.GetWallet(ctx, cash.ID)
+		assert.NoError(t, err)
+	})
+}
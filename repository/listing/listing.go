@@ -0,0 +1,149 @@
+// Package listing builds the paged listing queries shared by the SQL
+// repositories: filters, keyset pagination after a cursor, ordering and
+// limit.
+package listing
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// KeyType says how a cursor's sort key is read back for a column.
+type KeyType int
+
+const (
+	Text KeyType = iota
+	Time
+	Int
+)
+
+// Column is a column a listing can be sorted by.
+type Column struct {
+	Name string
+	Key  KeyType
+}
+
+// Builder collects the conditions of a listing query. Conditions are written
+// with ? placeholders, which are numbered $1, $2, ... in the final query.
+type Builder struct {
+	// Like is the case-insensitive LIKE operator of the dialect.
+	Like       string
+	conditions []string
+	args       []any
+}
+
+func NewPostgresqlBuilder() *Builder {
+	return &Builder{Like: "ILIKE"}
+}
+
+// NewSqliteBuilder uses LIKE, which SQLite already treats as
+// case-insensitive for ASCII.
+func NewSqliteBuilder() *Builder {
+	return &Builder{Like: "LIKE"}
+}
+
+func (b *Builder) Where(condition string, args ...any) {
+	b.conditions = append(b.conditions, condition)
+	b.args = append(b.args, args...)
+}
+
+// Search matches search as a substring of any of columns. An empty search
+// matches everything.
+func (b *Builder) Search(search string, columns ...string) {
+	if search == "" {
+		return
+	}
+
+	pattern := "%" + escapeLike(search) + "%"
+	matches := make([]string, 0, len(columns))
+	args := make([]any, 0, len(columns))
+
+	for _, column := range columns {
+		matches = append(matches, fmt.Sprintf(`%s %s ? ESCAPE '\'`, column, b.Like))
+		args = append(args, pattern)
+	}
+
+	b.Where("("+strings.Join(matches, " OR ")+")", args...)
+}
+
+// Query appends the conditions, the keyset condition for query.After, the
+// order and the limit to base, a SELECT without a WHERE clause. The sort
+// field of query must be one of columns.
+func (b *Builder) Query(base string, columns map[string]Column, query model.ListQuery) (string, []any, error) {
+	field, desc := query.SortField()
+
+	column, ok := columns[field]
+	if !ok {
+		return "", nil, fmt.Errorf("listing: cannot sort by %q", field)
+	}
+
+	direction, before := "ASC", ">"
+	if desc {
+		direction, before = "DESC", "<"
+	}
+
+	if query.After != nil {
+		key, err := cursorKey(*query.After, column.Key)
+		if err != nil {
+			return "", nil, err
+		}
+
+		b.Where(
+			fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?))", column.Name, before),
+			key, key, query.After.ID,
+		)
+	}
+
+	var sql strings.Builder
+
+	sql.WriteString(base)
+
+	if len(b.conditions) > 0 {
+		sql.WriteString(" WHERE ")
+		sql.WriteString(strings.Join(b.conditions, " AND "))
+	}
+
+	fmt.Fprintf(&sql, " ORDER BY %s %s, id %s", column.Name, direction, direction)
+
+	if query.Limit > 0 {
+		fmt.Fprintf(&sql, " LIMIT %d", query.Limit)
+	}
+
+	return number(sql.String()), b.args, nil
+}
+
+func cursorKey(cursor model.Cursor, keyType KeyType) (any, error) {
+	switch keyType {
+	case Time:
+		return cursor.TimeKey()
+	case Int:
+		return cursor.IntKey()
+	default:
+		return cursor.Key, nil
+	}
+}
+
+// number replaces each ? placeholder with $1, $2, ... in order.
+func number(query string) string {
+	var numbered strings.Builder
+
+	n := 0
+
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&numbered, "$%d", n)
+			continue
+		}
+
+		numbered.WriteRune(r)
+	}
+
+	return numbered.String()
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Order returns a comparison by key with ties broken by id, reversed when
+// desc, matching the ORDER BY of the SQL listings.
+func Order[T any](desc bool, key func(a, b T) int, id func(T) string) func(a, b T) int {
+	return func(a, b T) int {
+		c := key(a, b)
+		if c == 0 {
+			c = cmp.Compare(id(a), id(b))
+		}
+
+		if desc {
+			return -c
+		}
+
+		return c
+	}
+}
+
+// Page sorts items with compare, drops those up to and including after and
+// keeps at most limit of the rest. A nil after starts from the first item and
+// a zero limit keeps everything.
+func Page[T any](items []T, compare func(a, b T) int, after *T, limit int) []T {
+	slices.SortFunc(items, compare)
+
+	if after != nil {
+		i := slices.IndexFunc(items, func(item T) bool { return compare(item, *after) > 0 })
+		if i < 0 {
+			i = len(items)
+		}
+
+		items = items[i:]
+	}
+
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items
+}
+
+// Contains reports whether search is a case-insensitive substring of any of
+// fields. An empty search matches everything.
+func Contains(search string, fields ...string) bool {
+	search = strings.ToLower(search)
+
+	return slices.ContainsFunc(fields, func(field string) bool {
+		return strings.Contains(strings.ToLower(field), search)
+	})
+}
@@ -0,0 +1,205 @@
+// Package memory keeps every table in process memory. It backs the in-memory
+// repositories used for demo mode and for tests that should not need a
+// database.
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sync"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+var (
+	// ErrDuplicateKey is returned when a write would break a uniqueness
+	// constraint of the schema, such as two users with the same username.
+	ErrDuplicateKey = model.ErrDuplicateKey
+	// ErrForeignKey is returned when a write refers to a missing row or a
+	// delete would leave rows referring to a removed one.
+	ErrForeignKey = model.ErrForeignKey
+)
+
+// Tables holds one map per table, keyed by ID. Writers change rows through
+// Put and Delete, so that a failed unit of work can undo them.
+type Tables struct {
+	Users         map[string]model.User
+	Categories    map[string]model.Category
+	Wallets       map[string]model.Wallet
+	Transactions  map[string]model.Transaction
+	Transfers     map[string]model.Transfer
+	Rates         map[string]model.ExchangeRate
+	RefreshTokens map[string]model.RefreshToken
+	APIKeys       map[string]model.APIKey
+	Budgets       map[string]model.Budget
+	Notifications map[string]model.Notification
+	Recurring     map[string]model.RecurringTransaction
+
+	// undo reverts, in reverse order, the changes made by the running unit of
+	// work. It is nil outside of one.
+	undo []func()
+}
+
+// Put stores row under id in table, one of the maps of t.
+func Put[T any](t *Tables, table map[string]T, id string, row T) {
+	record(t, table, id)
+	table[id] = row
+}
+
+// Delete removes the row with the given id from table, one of the maps of t.
+func Delete[T any](t *Tables, table map[string]T, id string) {
+	record(t, table, id)
+	delete(table, id)
+}
+
+// record notes how to restore the row with the given id in table when a unit
+// of work is running.
+func record[T any](t *Tables, table map[string]T, id string) {
+	if t.undo == nil {
+		return
+	}
+
+	old, ok := table[id]
+	t.undo = append(t.undo, func() {
+		if ok {
+			table[id] = old
+		} else {
+			delete(table, id)
+		}
+	})
+}
+
+// Store guards the tables with a read/write lock. It is safe for concurrent
+// use and also serves as the unit of work for the in-memory repositories.
+type Store struct {
+	mu     sync.RWMutex
+	tables Tables
+}
+
+type heldKey struct{}
+
+func NewStore() *Store {
+	return &Store{
+		tables: Tables{
+			Users:         map[string]model.User{},
+			Categories:    map[string]model.Category{},
+			Wallets:       map[string]model.Wallet{},
+			Transactions:  map[string]model.Transaction{},
+			Transfers:     map[string]model.Transfer{},
+			Rates:         map[string]model.ExchangeRate{},
+			RefreshTokens: map[string]model.RefreshToken{},
+			APIKeys:       map[string]model.APIKey{},
+			Budgets:       map[string]model.Budget{},
+			Notifications: map[string]model.Notification{},
+			Recurring:     map[string]model.RecurringTransaction{},
+		},
+	}
+}
+
+// held reports whether ctx belongs to a unit of work that already holds the
+// write lock of s.
+func (s *Store) held(ctx context.Context) bool {
+	return ctx.Value(heldKey{}) == s
+}
+
+// Read runs fn with shared access to the tables. fn must not modify them.
+func (s *Store) Read(ctx context.Context, fn func(t *Tables)) {
+	if !s.held(ctx) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+
+	fn(&s.tables)
+}
+
+// Write runs fn with exclusive access to the tables. Writers check
+// everything that can fail before changing anything, so an error leaves the
+// tables as they were.
+func (s *Store) Write(ctx context.Context, fn func(t *Tables) error) error {
+	if !s.held(ctx) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
+
+	return fn(&s.tables)
+}
+
+// Do runs fn holding the write lock, so its repository calls see no
+// concurrent changes. When fn fails or panics, every change it made is
+// undone from the rows it wrote, in reverse order. Nested calls join the
+// outermost one.
+func (s *Store) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.held(ctx) {
+		return fn(ctx)
+	}
+
+	ctx, commit := unitofwork.WithAfterCommit(ctx)
+
+	err := s.do(context.WithValue(ctx, heldKey{}, s), fn)
+	if err != nil {
+		return err
+	}
+
+	commit()
+
+	return nil
+}
+
+// do runs fn for Do under the write lock, which it releases before Do runs
+// the functions waiting for the commit.
+func (s *Store) do(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tables.undo = []func(){}
+	committed := false
+
+	defer func() {
+		if !committed {
+			for i := len(s.tables.undo) - 1; i >= 0; i-- {
+				s.tables.undo[i]()
+			}
+		}
+
+		s.tables.undo = nil
+	}()
+
+	err := fn(ctx)
+	committed = err == nil
+
+	return err
+}
+
+// Values returns the rows of table ordered by ID, so listings are stable
+// before callers apply their own ordering.
+func Values[T any](table map[string]T) []T {
+	keys := make([]string, 0, len(table))
+	for key := range table {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	rows := make([]T, 0, len(keys))
+	for _, key := range keys {
+		rows = append(rows, table[key])
+	}
+
+	return rows
+}
+
+// AdjustBalance adds delta to the balance of the wallet, returning
+// sql.ErrNoRows when there is no such wallet.
+func (t *Tables) AdjustBalance(walletID string, delta model.Money) error {
+	wallet, ok := t.Wallets[walletID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+
+	wallet.Balance.Amount += delta.Amount
+	Put(t, t.Wallets, walletID, wallet)
+
+	return nil
+}
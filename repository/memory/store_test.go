@@ -0,0 +1,229 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+var ctx = context.Background()
+
+func insertWallet(ctx context.Context, store *memory.Store, wallet model.Wallet) error {
+	return store.Write(ctx, func(t *memory.Tables) error {
+		memory.Put(t, t.Wallets, wallet.ID, wallet)
+		return nil
+	})
+}
+
+func getWallet(store *memory.Store, id string) (model.Wallet, bool) {
+	var (
+		wallet model.Wallet
+		ok     bool
+	)
+
+	store.Read(ctx, func(t *memory.Tables) {
+		wallet, ok = t.Wallets[id]
+	})
+
+	return wallet, ok
+}
+
+func TestDo_CommitsOnSuccess(t *testing.T) {
+	store := memory.NewStore()
+
+	err := store.Do(ctx, func(ctx context.Context) error {
+		return insertWallet(ctx, store, model.Wallet{ID: "wallet-1"})
+	})
+
+	assert.NoError(t, err)
+
+	_, ok := getWallet(store, "wallet-1")
+	assert.True(t, ok, "Expected the wallet to be kept")
+}
+
+func TestDo_RollsBackOnError(t *testing.T) {
+	store := memory.NewStore()
+	assert.NoError(t, insertWallet(ctx, store, model.Wallet{ID: "wallet-1", Balance: model.NewMoney(100, "IDR")}))
+
+	errBoom := errors.New("boom")
+
+	err := store.Do(ctx, func(ctx context.Context) error {
+		err := store.Write(ctx, func(t *memory.Tables) error {
+			return t.AdjustBalance("wallet-1", model.NewMoney(50, "IDR"))
+		})
+		if err != nil {
+			return err
+		}
+
+		err = insertWallet(ctx, store, model.Wallet{ID: "wallet-2"})
+		if err != nil {
+			return err
+		}
+
+		return errBoom
+	})
+
+	assert.ErrorIs(t, err, errBoom)
+
+	wallet, _ := getWallet(store, "wallet-1")
+	assert.Equal(t, int64(100), wallet.Balance.Amount, "Expected the balance change to be undone")
+
+	_, ok := getWallet(store, "wallet-2")
+	assert.False(t, ok, "Expected the insert to be undone")
+}
+
+func TestDo_RollsBackRepeatedWrites(t *testing.T) {
+	store := memory.NewStore()
+	assert.NoError(t, insertWallet(ctx, store, model.Wallet{ID: "wallet-1", Balance: model.NewMoney(100, "IDR")}))
+
+	errBoom := errors.New("boom")
+
+	err := store.Do(ctx, func(ctx context.Context) error {
+		for i := 0; i < 3; i++ {
+			err := store.Write(ctx, func(t *memory.Tables) error {
+				return t.AdjustBalance("wallet-1", model.NewMoney(50, "IDR"))
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err := store.Write(ctx, func(t *memory.Tables) error {
+			memory.Delete(t, t.Wallets, "wallet-1")
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return errBoom
+	})
+
+	assert.ErrorIs(t, err, errBoom)
+
+	wallet, ok := getWallet(store, "wallet-1")
+	assert.True(t, ok, "Expected the delete to be undone")
+	assert.Equal(t, int64(100), wallet.Balance.Amount, "Expected the balance from before the unit of work")
+}
+
+func TestDo_RollsBackOnPanic(t *testing.T) {
+	store := memory.NewStore()
+	assert.NoError(t, insertWallet(ctx, store, model.Wallet{ID: "wallet-1", Balance: model.NewMoney(100, "IDR")}))
+
+	assert.Panics(t, func() {
+		store.Do(ctx, func(ctx context.Context) error {
+			err := store.Write(ctx, func(t *memory.Tables) error {
+				return t.AdjustBalance("wallet-1", model.NewMoney(50, "IDR"))
+			})
+			if err != nil {
+				return err
+			}
+
+			panic("boom")
+		})
+	})
+
+	wallet, _ := getWallet(store, "wallet-1")
+	assert.Equal(t, int64(100), wallet.Balance.Amount, "Expected the balance change to be undone")
+
+	assert.NoError(t, insertWallet(ctx, store, model.Wallet{ID: "wallet-2"}), "Expected the lock to be released")
+}
+
+func TestDo_NestedCallsJoinOutermost(t *testing.T) {
+	store := memory.NewStore()
+	errBoom := errors.New("boom")
+
+	err := store.Do(ctx, func(ctx context.Context) error {
+		err := store.Do(ctx, func(ctx context.Context) error {
+			return insertWallet(ctx, store, model.Wallet{ID: "wallet-1"})
+		})
+		if err != nil {
+			return err
+		}
+
+		return errBoom
+	})
+
+	assert.ErrorIs(t, err, errBoom)
+
+	_, ok := getWallet(store, "wallet-1")
+	assert.False(t, ok, "Expected the inner call to be rolled back with the outer one")
+}
+
+func TestDo_RunsAfterCommitOnceCommitted(t *testing.T) {
+	store := memory.NewStore()
+	errBoom := errors.New("boom")
+
+	var ran []string
+
+	err := store.Do(ctx, func(ctx context.Context) error {
+		err := store.Do(ctx, func(ctx context.Context) error {
+			unitofwork.AfterCommit(ctx, func() {
+				// The lock is released by now, so the committed rows can be
+				// read.
+				_, ok := getWallet(store, "wallet-1")
+				assert.True(t, ok)
+
+				ran = append(ran, "committed")
+			})
+
+			return insertWallet(ctx, store, model.Wallet{ID: "wallet-1"})
+		})
+		if err != nil {
+			return err
+		}
+
+		assert.Empty(t, ran, "Expected nested calls to wait for the outermost one")
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"committed"}, ran)
+
+	err = store.Do(ctx, func(ctx context.Context) error {
+		unitofwork.AfterCommit(ctx, func() { ran = append(ran, "rolled back") })
+		return errBoom
+	})
+
+	assert.ErrorIs(t, err, errBoom)
+	assert.Equal(t, []string{"committed"}, ran, "Expected nothing to run after a rollback")
+}
+
+func TestWrite_ConcurrentBalanceUpdates(t *testing.T) {
+	store := memory.NewStore()
+	assert.NoError(t, insertWallet(ctx, store, model.Wallet{ID: "wallet-1"}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			store.Do(ctx, func(ctx context.Context) error {
+				return store.Write(ctx, func(t *memory.Tables) error {
+					return t.AdjustBalance("wallet-1", model.NewMoney(1, "IDR"))
+				})
+			})
+		}()
+	}
+	wg.Wait()
+
+	wallet, _ := getWallet(store, "wallet-1")
+	assert.Equal(t, int64(100), wallet.Balance.Amount)
+}
+
+func TestAdjustBalance_MissingWallet(t *testing.T) {
+	store := memory.NewStore()
+
+	err := store.Write(ctx, func(t *memory.Tables) error {
+		return t.AdjustBalance("wallet-404", model.NewMoney(1, "IDR"))
+	})
+
+	assert.Error(t, err)
+}
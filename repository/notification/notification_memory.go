@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryNotificationRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryNotificationRepository(store *memory.Store) *memoryNotificationRepository {
+	return &memoryNotificationRepository{
+		store: store,
+	}
+}
+
+func (m *memoryNotificationRepository) GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) ([]model.Notification, error) {
+	notifications := make([]model.Notification, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, notification := range t.Notifications {
+			if notification.User_ID != userID || (query.Unread && notification.Read_At != nil) {
+				continue
+			}
+
+			if memory.Contains(query.Search, notification.Title, notification.Message) {
+				notifications = append(notifications, notification)
+			}
+		}
+	})
+
+	_, desc := query.SortField()
+	compare := memory.Order(desc, func(a, b model.Notification) int {
+		return a.Created_At.Compare(b.Created_At)
+	}, func(n model.Notification) string { return n.ID })
+
+	var after *model.Notification
+
+	if query.After != nil {
+		createdAt, err := query.After.TimeKey()
+		if err != nil {
+			return []model.Notification{}, err
+		}
+
+		after = &model.Notification{ID: query.After.ID, Created_At: createdAt}
+	}
+
+	return memory.Page(notifications, compare, after, query.Limit), nil
+}
+
+func (m *memoryNotificationRepository) GetNotification(ctx context.Context, id string) (model.Notification, error) {
+	var (
+		notification model.Notification
+		ok           bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		notification, ok = t.Notifications[id]
+	})
+
+	if !ok {
+		return model.Notification{}, sql.ErrNoRows
+	}
+
+	return notification, nil
+}
+
+func (m *memoryNotificationRepository) GetNotificationByKey(ctx context.Context, userID string, key string) (model.Notification, error) {
+	var (
+		notification model.Notification
+		ok           bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		notification, ok = notificationByKey(t, userID, key)
+	})
+
+	if !ok {
+		return model.Notification{}, sql.ErrNoRows
+	}
+
+	return notification, nil
+}
+
+func notificationByKey(t *memory.Tables, userID string, key string) (model.Notification, bool) {
+	for _, notification := range t.Notifications {
+		if notification.User_ID == userID && notification.Key == key {
+			return notification, true
+		}
+	}
+
+	return model.Notification{}, false
+}
+
+func (m *memoryNotificationRepository) CreateNotification(ctx context.Context, notification model.Notification) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Notifications[notification.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := notificationByKey(t, notification.User_ID, notification.Key); ok {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := t.Users[notification.User_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		notification.Read_At = nil
+		memory.Put(t, t.Notifications, notification.ID, notification)
+
+		return nil
+	})
+}
+
+func (m *memoryNotificationRepository) MarkRead(ctx context.Context, id string, readAt time.Time) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		notification, ok := t.Notifications[id]
+		if ok && notification.Read_At == nil {
+			notification.Read_At = &readAt
+			memory.Put(t, t.Notifications, id, notification)
+		}
+
+		return nil
+	})
+}
+
+func (m *memoryNotificationRepository) MarkAllRead(ctx context.Context, userID string, readAt time.Time) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		for id, notification := range t.Notifications {
+			if notification.User_ID == userID && notification.Read_At == nil {
+				notification.Read_At = &readAt
+				memory.Put(t, t.Notifications, id, notification)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlNotificationRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlNotificationRepository(connectionPool *sql.DB) *postgresqlNotificationRepository {
+	return &postgresqlNotificationRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlNotificationRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+const selectNotification = "SELECT id, user_id, kind, event_key, title, message, read_at, created_at FROM notifications"
+
+var notificationColumns = map[string]listing.Column{
+	"created_at": {Name: "created_at", Key: listing.Time},
+}
+
+func scanNotification(row interface{ Scan(...any) error }, notification *model.Notification) error {
+	return row.Scan(
+		&notification.ID, &notification.User_ID, &notification.Kind, &notification.Key,
+		&notification.Title, &notification.Message, &notification.Read_At, &notification.Created_At,
+	)
+}
+
+// filterNotifications adds the conditions of query other than the cursor.
+func filterNotifications(builder *listing.Builder, userID string, query model.NotificationQuery) {
+	builder.Where("user_id = ?", userID)
+	builder.Search(query.Search, "title", "message")
+
+	if query.Unread {
+		builder.Where("read_at IS NULL")
+	}
+}
+
+// queryNotifications runs a listing query built by filterNotifications.
+func queryNotifications(ctx context.Context, db unitofwork.DBTX, builder *listing.Builder, query model.ListQuery) ([]model.Notification, error) {
+	statement, args, err := builder.Query(selectNotification, notificationColumns, query)
+
+	if err != nil {
+		return []model.Notification{}, err
+	}
+
+	rows, err := db.QueryContext(ctx, statement, args...)
+
+	if err != nil {
+		return []model.Notification{}, err
+	}
+
+	defer rows.Close()
+
+	notifications := make([]model.Notification, 0)
+
+	for rows.Next() {
+		notification := model.Notification{}
+		err := scanNotification(rows, &notification)
+		if err != nil {
+			return notifications, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (p *postgresqlNotificationRepository) GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) ([]model.Notification, error) {
+	builder := listing.NewPostgresqlBuilder()
+	filterNotifications(builder, userID, query)
+
+	return queryNotifications(ctx, p.db(ctx), builder, query.ListQuery)
+}
+
+func (p *postgresqlNotificationRepository) GetNotification(ctx context.Context, id string) (model.Notification, error) {
+	notification := model.Notification{}
+
+	err := scanNotification(p.db(ctx).QueryRowContext(ctx, selectNotification+" WHERE id = $1", id), &notification)
+
+	if err != nil {
+		return notification, err
+	}
+
+	return notification, nil
+}
+
+func (p *postgresqlNotificationRepository) GetNotificationByKey(ctx context.Context, userID string, key string) (model.Notification, error) {
+	notification := model.Notification{}
+
+	err := scanNotification(p.db(ctx).QueryRowContext(ctx, selectNotification+" WHERE user_id = $1 AND event_key = $2", userID, key), &notification)
+
+	if err != nil {
+		return notification, err
+	}
+
+	return notification, nil
+}
+
+func (p *postgresqlNotificationRepository) CreateNotification(ctx context.Context, notification model.Notification) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO notifications (id, user_id, kind, event_key, title, message, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		notification.ID, notification.User_ID, notification.Kind, notification.Key, notification.Title, notification.Message, notification.Created_At,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlNotificationRepository) MarkRead(ctx context.Context, id string, readAt time.Time) error {
+	_, err := p.db(ctx).ExecContext(ctx, "UPDATE notifications SET read_at = $1 WHERE id = $2 AND read_at IS NULL", readAt, id)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlNotificationRepository) MarkAllRead(ctx context.Context, userID string, readAt time.Time) error {
+	_, err := p.db(ctx).ExecContext(ctx, "UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL", readAt, userID)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type INotificationRepository interface {
+	GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) ([]model.Notification, error)
+	GetNotification(ctx context.Context, id string) (model.Notification, error)
+	// GetNotificationByKey returns the user's notification for the event key,
+	// or sql.ErrNoRows when the user has not been notified of it.
+	GetNotificationByKey(ctx context.Context, userID string, key string) (model.Notification, error)
+	CreateNotification(ctx context.Context, notification model.Notification) error
+	// MarkRead marks the notification read at readAt unless it already is.
+	MarkRead(ctx context.Context, id string, readAt time.Time) error
+	// MarkAllRead marks every unread notification of the user read at readAt.
+	MarkAllRead(ctx context.Context, userID string, readAt time.Time) error
+}
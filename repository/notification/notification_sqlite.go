@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteNotificationRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteNotificationRepository(connectionPool *sql.DB) *sqliteNotificationRepository {
+	return &sqliteNotificationRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteNotificationRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteNotificationRepository) GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) ([]model.Notification, error) {
+	builder := listing.NewSqliteBuilder()
+	filterNotifications(builder, userID, query)
+
+	return queryNotifications(ctx, s.db(ctx), builder, query.ListQuery)
+}
+
+func (s *sqliteNotificationRepository) GetNotification(ctx context.Context, id string) (model.Notification, error) {
+	notification := model.Notification{}
+
+	err := scanNotification(s.db(ctx).QueryRowContext(ctx, selectNotification+" WHERE id = $1", id), &notification)
+
+	if err != nil {
+		return notification, err
+	}
+
+	return notification, nil
+}
+
+func (s *sqliteNotificationRepository) GetNotificationByKey(ctx context.Context, userID string, key string) (model.Notification, error) {
+	notification := model.Notification{}
+
+	err := scanNotification(s.db(ctx).QueryRowContext(ctx, selectNotification+" WHERE user_id = $1 AND event_key = $2", userID, key), &notification)
+
+	if err != nil {
+		return notification, err
+	}
+
+	return notification, nil
+}
+
+func (s *sqliteNotificationRepository) CreateNotification(ctx context.Context, notification model.Notification) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO notifications (id, user_id, kind, event_key, title, message, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		notification.ID, notification.User_ID, notification.Kind, notification.Key, notification.Title, notification.Message, notification.Created_At.UTC(),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteNotificationRepository) MarkRead(ctx context.Context, id string, readAt time.Time) error {
+	_, err := s.db(ctx).ExecContext(ctx, "UPDATE notifications SET read_at = $1 WHERE id = $2 AND read_at IS NULL", readAt.UTC(), id)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteNotificationRepository) MarkAllRead(ctx context.Context, userID string, readAt time.Time) error {
+	_, err := s.db(ctx).ExecContext(ctx, "UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND read_at IS NULL", readAt.UTC(), userID)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryRateRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryRateRepository(store *memory.Store) *memoryRateRepository {
+	return &memoryRateRepository{
+		store: store,
+	}
+}
+
+func (m *memoryRateRepository) GetRates(ctx context.Context) ([]model.ExchangeRate, error) {
+	var rates []model.ExchangeRate
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		rates = memory.Values(t.Rates)
+	})
+
+	slices.SortStableFunc(rates, func(a, b model.ExchangeRate) int {
+		if c := b.Date.Compare(a.Date); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(a.Base, b.Base); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Quote, b.Quote)
+	})
+
+	return rates, nil
+}
+
+func (m *memoryRateRepository) GetRate(ctx context.Context, base string, quote string, date time.Time) (model.ExchangeRate, error) {
+	var (
+		rate  model.ExchangeRate
+		found bool
+	)
+
+	day := truncateToDate(date)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, candidate := range t.Rates {
+			if candidate.Base != base || candidate.Quote != quote || candidate.Date.After(day) {
+				continue
+			}
+
+			if !found || candidate.Date.After(rate.Date) {
+				rate, found = candidate, true
+			}
+		}
+	})
+
+	if !found {
+		return model.ExchangeRate{}, sql.ErrNoRows
+	}
+
+	return rate, nil
+}
+
+func (m *memoryRateRepository) SaveRate(ctx context.Context, rate model.ExchangeRate) error {
+	rate.Date = truncateToDate(rate.Date)
+
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		for id, existing := range t.Rates {
+			if existing.Base == rate.Base && existing.Quote == rate.Quote && existing.Date.Equal(rate.Date) {
+				existing.Rate = rate.Rate
+				memory.Put(t, t.Rates, id, existing)
+
+				return nil
+			}
+		}
+
+		if _, ok := t.Rates[rate.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		memory.Put(t, t.Rates, rate.ID, rate)
+
+		return nil
+	})
+}
+
+func (m *memoryRateRepository) DeleteRate(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Rates[id]; !ok {
+			return sql.ErrNoRows
+		}
+
+		memory.Delete(t, t.Rates, id)
+
+		return nil
+	})
+}
+
+// truncateToDate drops the time of day, as storing into the DATE column of
+// exchange_rates does.
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlRateRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlRateRepository(connectionPool *sql.DB) *postgresqlRateRepository {
+	return &postgresqlRateRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlRateRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+func (p *postgresqlRateRepository) GetRates(ctx context.Context) ([]model.ExchangeRate, error) {
+	rows, err := p.db(ctx).QueryContext(ctx, "SELECT id, base, quote, rate, date FROM exchange_rates ORDER BY date DESC, base, quote")
+
+	if err != nil {
+		return []model.ExchangeRate{}, err
+	}
+
+	defer rows.Close()
+
+	rates := make([]model.ExchangeRate, 0)
+
+	for rows.Next() {
+		rate := model.ExchangeRate{}
+		err := rows.Scan(&rate.ID, &rate.Base, &rate.Quote, &rate.Rate, &rate.Date)
+		if err != nil {
+			return rates, err
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (p *postgresqlRateRepository) GetRate(ctx context.Context, base string, quote string, date time.Time) (model.ExchangeRate, error) {
+	rate := model.ExchangeRate{}
+
+	row := p.db(ctx).QueryRowContext(
+		ctx,
+		"SELECT id, base, quote, rate, date FROM exchange_rates WHERE base = $1 AND quote = $2 AND date <= $3 ORDER BY date DESC LIMIT 1",
+		base, quote, date,
+	)
+
+	err := row.Scan(&rate.ID, &rate.Base, &rate.Quote, &rate.Rate, &rate.Date)
+
+	if err != nil {
+		return rate, err
+	}
+
+	return rate, nil
+}
+
+func (p *postgresqlRateRepository) SaveRate(ctx context.Context, rate model.ExchangeRate) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		`INSERT INTO exchange_rates (id, base, quote, rate, date) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (base, quote, date) DO UPDATE SET rate = EXCLUDED.rate`,
+		rate.ID, rate.Base, rate.Quote, rate.Rate, rate.Date,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlRateRepository) DeleteRate(ctx context.Context, id string) error {
+	result, err := p.db(ctx).ExecContext(ctx, "DELETE FROM exchange_rates WHERE id = $1", id)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
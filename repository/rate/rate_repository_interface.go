@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IRateRepository interface {
+	GetRates(ctx context.Context) ([]model.ExchangeRate, error)
+	// GetRate returns the most recent rate for base/quote dated on or before
+	// date.
+	GetRate(ctx context.Context, base string, quote string, date time.Time) (model.ExchangeRate, error)
+	// SaveRate stores rate, replacing any existing rate for the same pair and
+	// date.
+	SaveRate(ctx context.Context, rate model.ExchangeRate) error
+	DeleteRate(ctx context.Context, id string) error
+}
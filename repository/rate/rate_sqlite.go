@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteRateRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteRateRepository(connectionPool *sql.DB) *sqliteRateRepository {
+	return &sqliteRateRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteRateRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteRateRepository) GetRates(ctx context.Context) ([]model.ExchangeRate, error) {
+	rows, err := s.db(ctx).QueryContext(ctx, "SELECT id, base, quote, rate, date FROM exchange_rates ORDER BY date DESC, base, quote")
+
+	if err != nil {
+		return []model.ExchangeRate{}, err
+	}
+
+	defer rows.Close()
+
+	rates := make([]model.ExchangeRate, 0)
+
+	for rows.Next() {
+		rate := model.ExchangeRate{}
+		err := rows.Scan(&rate.ID, &rate.Base, &rate.Quote, &rate.Rate, &rate.Date)
+		if err != nil {
+			return rates, err
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// GetRate compares dates only, like the DATE column of the PostgreSQL schema,
+// so a rate applies from the start of its day whatever the time of date.
+func (s *sqliteRateRepository) GetRate(ctx context.Context, base string, quote string, date time.Time) (model.ExchangeRate, error) {
+	rate := model.ExchangeRate{}
+
+	row := s.db(ctx).QueryRowContext(
+		ctx,
+		"SELECT id, base, quote, rate, date FROM exchange_rates WHERE base = $1 AND quote = $2 AND date <= $3 ORDER BY date DESC LIMIT 1",
+		base, quote, truncateToDate(date),
+	)
+
+	err := row.Scan(&rate.ID, &rate.Base, &rate.Quote, &rate.Rate, &rate.Date)
+
+	if err != nil {
+		return rate, err
+	}
+
+	return rate, nil
+}
+
+func (s *sqliteRateRepository) SaveRate(ctx context.Context, rate model.ExchangeRate) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		`INSERT INTO exchange_rates (id, base, quote, rate, date) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (base, quote, date) DO UPDATE SET rate = EXCLUDED.rate`,
+		rate.ID, rate.Base, rate.Quote, rate.Rate, truncateToDate(rate.Date),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteRateRepository) DeleteRate(ctx context.Context, id string) error {
+	result, err := s.db(ctx).ExecContext(ctx, "DELETE FROM exchange_rates WHERE id = $1", id)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
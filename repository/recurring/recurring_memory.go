@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryRecurringRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryRecurringRepository(store *memory.Store) *memoryRecurringRepository {
+	return &memoryRecurringRepository{
+		store: store,
+	}
+}
+
+func (m *memoryRecurringRepository) GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error) {
+	recurringTransactions := make([]model.RecurringTransaction, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, recurring := range memory.Values(t.Recurring) {
+			if recurring.User_ID == userID {
+				recurringTransactions = append(recurringTransactions, recurring)
+			}
+		}
+	})
+
+	slices.SortStableFunc(recurringTransactions, func(a, b model.RecurringTransaction) int {
+		return a.Created_At.Compare(b.Created_At)
+	})
+
+	return recurringTransactions, nil
+}
+
+func (m *memoryRecurringRepository) GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error) {
+	var (
+		recurring model.RecurringTransaction
+		ok        bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		recurring, ok = t.Recurring[id]
+	})
+
+	if !ok {
+		return model.RecurringTransaction{}, sql.ErrNoRows
+	}
+
+	return recurring, nil
+}
+
+func (m *memoryRecurringRepository) GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]model.RecurringTransaction, error) {
+	due := make([]model.RecurringTransaction, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, recurring := range memory.Values(t.Recurring) {
+			if !recurring.Paused && recurring.Next_Date != nil && !recurring.Next_Date.After(now) {
+				due = append(due, recurring)
+			}
+		}
+	})
+
+	slices.SortStableFunc(due, func(a, b model.RecurringTransaction) int {
+		return a.Next_Date.Compare(*b.Next_Date)
+	})
+
+	return due, nil
+}
+
+func (m *memoryRecurringRepository) CreateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Recurring[recurring.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := t.Users[recurring.User_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		if _, ok := t.Wallets[recurring.Wallet_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		if _, ok := t.Categories[recurring.Category_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		recurring.Weekdays = slices.Clone(recurring.Weekdays)
+		memory.Put(t, t.Recurring, recurring.ID, recurring)
+
+		return nil
+	})
+}
+
+func (m *memoryRecurringRepository) UpdateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction, occurrences int) (bool, error) {
+	var updated bool
+
+	err := m.store.Write(ctx, func(t *memory.Tables) error {
+		existing, ok := t.Recurring[recurring.ID]
+		if !ok || existing.Occurrences != occurrences {
+			return nil
+		}
+
+		if _, ok := t.Wallets[recurring.Wallet_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		if _, ok := t.Categories[recurring.Category_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		recurring.User_ID = existing.User_ID
+		recurring.Created_At = existing.Created_At
+		recurring.Weekdays = slices.Clone(recurring.Weekdays)
+		memory.Put(t, t.Recurring, recurring.ID, recurring)
+		updated = true
+
+		return nil
+	})
+
+	return updated, err
+}
+
+func (m *memoryRecurringRepository) DeleteRecurringTransaction(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		memory.Delete(t, t.Recurring, id)
+
+		return nil
+	})
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlRecurringRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlRecurringRepository(connectionPool *sql.DB) *postgresqlRecurringRepository {
+	return &postgresqlRecurringRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlRecurringRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+const selectRecurring = `SELECT id, user_id, wallet_id, category_id, type, amount, currency, description,
+	frequency, interval_count, weekdays, start_date, until, count,
+	paused, next_date, last_date, occurrences, created_at FROM recurring_transactions`
+
+const insertRecurring = `INSERT INTO recurring_transactions (id, user_id, wallet_id, category_id, type, amount, currency, description,
+	frequency, interval_count, weekdays, start_date, until, count,
+	paused, next_date, last_date, occurrences, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`
+
+const updateRecurring = `UPDATE recurring_transactions SET wallet_id = $1, category_id = $2, type = $3, amount = $4, currency = $5, description = $6,
+	frequency = $7, interval_count = $8, weekdays = $9, start_date = $10, until = $11, count = $12,
+	paused = $13, next_date = $14, last_date = $15, occurrences = $16
+	WHERE id = $17 AND occurrences = $18`
+
+func scanRecurring(row interface{ Scan(...any) error }, recurring *model.RecurringTransaction) error {
+	var weekdays string
+
+	err := row.Scan(
+		&recurring.ID, &recurring.User_ID, &recurring.Wallet_ID, &recurring.Category_ID, &recurring.Type,
+		&recurring.Amount, &recurring.Amount.Currency, &recurring.Description,
+		&recurring.Frequency, &recurring.Interval, &weekdays, &recurring.Start_Date, &recurring.Until, &recurring.Count,
+		&recurring.Paused, &recurring.Next_Date, &recurring.Last_Date, &recurring.Occurrences, &recurring.Created_At,
+	)
+	if err != nil {
+		return err
+	}
+
+	if weekdays != "" {
+		recurring.Weekdays = strings.Split(weekdays, ",")
+	}
+
+	recurring.Start_Date = recurring.Start_Date.UTC()
+	recurring.Until = utc(recurring.Until)
+	recurring.Next_Date = utc(recurring.Next_Date)
+	recurring.Last_Date = utc(recurring.Last_Date)
+	recurring.Created_At = recurring.Created_At.UTC()
+
+	return nil
+}
+
+// utc returns t in UTC, keeping nil as is.
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	u := t.UTC()
+
+	return &u
+}
+
+// insertArgs returns the values of insertRecurring.
+func insertArgs(recurring model.RecurringTransaction) []any {
+	return []any{
+		recurring.ID, recurring.User_ID, recurring.Wallet_ID, recurring.Category_ID, recurring.Type,
+		recurring.Amount, recurring.Amount.Currency, recurring.Description,
+		recurring.Frequency, recurring.Interval, strings.Join(recurring.Weekdays, ","), recurring.Start_Date.UTC(), utc(recurring.Until), recurring.Count,
+		recurring.Paused, utc(recurring.Next_Date), utc(recurring.Last_Date), recurring.Occurrences, recurring.Created_At.UTC(),
+	}
+}
+
+// updateArgs returns the values of updateRecurring.
+func updateArgs(recurring model.RecurringTransaction, occurrences int) []any {
+	return []any{
+		recurring.Wallet_ID, recurring.Category_ID, recurring.Type, recurring.Amount, recurring.Amount.Currency, recurring.Description,
+		recurring.Frequency, recurring.Interval, strings.Join(recurring.Weekdays, ","), recurring.Start_Date.UTC(), utc(recurring.Until), recurring.Count,
+		recurring.Paused, utc(recurring.Next_Date), utc(recurring.Last_Date), recurring.Occurrences,
+		recurring.ID, occurrences,
+	}
+}
+
+func queryRecurring(ctx context.Context, db unitofwork.DBTX, query string, args ...any) ([]model.RecurringTransaction, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return []model.RecurringTransaction{}, err
+	}
+
+	defer rows.Close()
+
+	recurringTransactions := make([]model.RecurringTransaction, 0)
+
+	for rows.Next() {
+		recurring := model.RecurringTransaction{}
+		err := scanRecurring(rows, &recurring)
+		if err != nil {
+			return recurringTransactions, err
+		}
+		recurringTransactions = append(recurringTransactions, recurring)
+	}
+
+	return recurringTransactions, rows.Err()
+}
+
+// execUpdate runs updateRecurring and reports whether it changed a row.
+func execUpdate(ctx context.Context, db unitofwork.DBTX, recurring model.RecurringTransaction, occurrences int) (bool, error) {
+	result, err := db.ExecContext(ctx, updateRecurring, updateArgs(recurring, occurrences)...)
+
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (p *postgresqlRecurringRepository) GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error) {
+	return queryRecurring(ctx, p.db(ctx), selectRecurring+" WHERE user_id = $1 ORDER BY created_at, id", userID)
+}
+
+func (p *postgresqlRecurringRepository) GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error) {
+	recurring := model.RecurringTransaction{}
+
+	err := scanRecurring(p.db(ctx).QueryRowContext(ctx, selectRecurring+" WHERE id = $1", id), &recurring)
+
+	return recurring, err
+}
+
+func (p *postgresqlRecurringRepository) GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]model.RecurringTransaction, error) {
+	return queryRecurring(ctx, p.db(ctx), selectRecurring+" WHERE NOT paused AND next_date <= $1 ORDER BY next_date, id", now.UTC())
+}
+
+func (p *postgresqlRecurringRepository) CreateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction) error {
+	_, err := p.db(ctx).ExecContext(ctx, insertRecurring, insertArgs(recurring)...)
+
+	return err
+}
+
+func (p *postgresqlRecurringRepository) UpdateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction, occurrences int) (bool, error) {
+	return execUpdate(ctx, p.db(ctx), recurring, occurrences)
+}
+
+func (p *postgresqlRecurringRepository) DeleteRecurringTransaction(ctx context.Context, id string) error {
+	_, err := p.db(ctx).ExecContext(ctx, "DELETE FROM recurring_transactions WHERE id = $1", id)
+
+	return err
+}
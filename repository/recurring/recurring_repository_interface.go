@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IRecurringRepository interface {
+	GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error)
+	GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error)
+	// GetDueRecurringTransactions returns the unpaused recurring transactions
+	// whose next occurrence is at or before now, soonest first.
+	GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]model.RecurringTransaction, error)
+	CreateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction) error
+	// UpdateRecurringTransaction stores recurring if it has still handled
+	// occurrences occurrences, and reports whether it did. A false result
+	// means an occurrence was materialized or skipped since recurring was
+	// read.
+	UpdateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction, occurrences int) (bool, error)
+	DeleteRecurringTransaction(ctx context.Context, id string) error
+}
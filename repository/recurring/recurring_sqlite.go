@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteRecurringRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteRecurringRepository(connectionPool *sql.DB) *sqliteRecurringRepository {
+	return &sqliteRecurringRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteRecurringRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteRecurringRepository) GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error) {
+	return queryRecurring(ctx, s.db(ctx), selectRecurring+" WHERE user_id = $1 ORDER BY created_at, id", userID)
+}
+
+func (s *sqliteRecurringRepository) GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error) {
+	recurring := model.RecurringTransaction{}
+
+	err := scanRecurring(s.db(ctx).QueryRowContext(ctx, selectRecurring+" WHERE id = $1", id), &recurring)
+
+	return recurring, err
+}
+
+func (s *sqliteRecurringRepository) GetDueRecurringTransactions(ctx context.Context, now time.Time) ([]model.RecurringTransaction, error) {
+	return queryRecurring(ctx, s.db(ctx), selectRecurring+" WHERE NOT paused AND next_date <= $1 ORDER BY next_date, id", now.UTC())
+}
+
+func (s *sqliteRecurringRepository) CreateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction) error {
+	_, err := s.db(ctx).ExecContext(ctx, insertRecurring, insertArgs(recurring)...)
+
+	return err
+}
+
+func (s *sqliteRecurringRepository) UpdateRecurringTransaction(ctx context.Context, recurring model.RecurringTransaction, occurrences int) (bool, error) {
+	return execUpdate(ctx, s.db(ctx), recurring, occurrences)
+}
+
+func (s *sqliteRecurringRepository) DeleteRecurringTransaction(ctx context.Context, id string) error {
+	_, err := s.db(ctx).ExecContext(ctx, "DELETE FROM recurring_transactions WHERE id = $1", id)
+
+	return err
+}
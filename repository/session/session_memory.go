@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memorySessionRepository struct {
+	store *memory.Store
+}
+
+func NewMemorySessionRepository(store *memory.Store) *memorySessionRepository {
+	return &memorySessionRepository{
+		store: store,
+	}
+}
+
+func (m *memorySessionRepository) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.RefreshTokens[token.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		for _, existing := range t.RefreshTokens {
+			if existing.Token_Hash == token.Token_Hash {
+				return memory.ErrDuplicateKey
+			}
+		}
+
+		if _, ok := t.Users[token.User_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		memory.Put(t, t.RefreshTokens, token.ID, token)
+
+		return nil
+	})
+}
+
+func (m *memorySessionRepository) GetRefreshTokenByHash(ctx context.Context, hash string) (model.RefreshToken, error) {
+	var (
+		token model.RefreshToken
+		found bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, candidate := range t.RefreshTokens {
+			if candidate.Token_Hash == hash {
+				token, found = candidate, true
+				return
+			}
+		}
+	})
+
+	if !found {
+		return model.RefreshToken{}, sql.ErrNoRows
+	}
+
+	return token, nil
+}
+
+func (m *memorySessionRepository) UseRefreshToken(ctx context.Context, id string, usedAt time.Time) (bool, error) {
+	var used bool
+
+	err := m.store.Write(ctx, func(t *memory.Tables) error {
+		token, ok := t.RefreshTokens[id]
+		if !ok || token.Used_At != nil || token.Revoked_At != nil {
+			return nil
+		}
+
+		token.Used_At = &usedAt
+		memory.Put(t, t.RefreshTokens, id, token)
+		used = true
+
+		return nil
+	})
+
+	return used, err
+}
+
+func (m *memorySessionRepository) RevokeSession(ctx context.Context, userID string, sessionID string, revokedAt time.Time) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		revoked := false
+
+		for id, token := range t.RefreshTokens {
+			if token.User_ID != userID || token.Session_ID != sessionID || token.Revoked_At != nil {
+				continue
+			}
+
+			token.Revoked_At = &revokedAt
+			memory.Put(t, t.RefreshTokens, id, token)
+			revoked = true
+		}
+
+		if !revoked {
+			return sql.ErrNoRows
+		}
+
+		return nil
+	})
+}
+
+func (m *memorySessionRepository) GetUserSessions(ctx context.Context, userID string, now time.Time) ([]model.Session, error) {
+	sessions := make([]model.Session, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		started := map[string]time.Time{}
+
+		for _, token := range t.RefreshTokens {
+			if first, ok := started[token.Session_ID]; !ok || token.Created_At.Before(first) {
+				started[token.Session_ID] = token.Created_At
+			}
+		}
+
+		for _, token := range memory.Values(t.RefreshTokens) {
+			if token.User_ID != userID || token.Used_At != nil || token.Revoked_At != nil || !token.Expires_At.After(now) {
+				continue
+			}
+
+			sessions = append(sessions, model.Session{
+				ID:           token.Session_ID,
+				User_Agent:   token.User_Agent,
+				Created_At:   started[token.Session_ID],
+				Last_Used_At: token.Created_At,
+				Expires_At:   token.Expires_At,
+			})
+		}
+	})
+
+	slices.SortStableFunc(sessions, func(a, b model.Session) int {
+		return b.Last_Used_At.Compare(a.Last_Used_At)
+	})
+
+	return sessions, nil
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlSessionRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlSessionRepository(connectionPool *sql.DB) *postgresqlSessionRepository {
+	return &postgresqlSessionRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlSessionRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+func (p *postgresqlSessionRepository) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		`INSERT INTO refresh_tokens (id, session_id, user_id, token_hash, user_agent, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		token.ID, token.Session_ID, token.User_ID, token.Token_Hash, token.User_Agent, token.Created_At, token.Expires_At,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlSessionRepository) GetRefreshTokenByHash(ctx context.Context, hash string) (model.RefreshToken, error) {
+	token := model.RefreshToken{}
+
+	row := p.db(ctx).QueryRowContext(
+		ctx,
+		`SELECT id, session_id, user_id, token_hash, user_agent, created_at, expires_at, used_at, revoked_at
+		FROM refresh_tokens WHERE token_hash = $1`,
+		hash,
+	)
+
+	err := row.Scan(
+		&token.ID, &token.Session_ID, &token.User_ID, &token.Token_Hash, &token.User_Agent,
+		&token.Created_At, &token.Expires_At, &token.Used_At, &token.Revoked_At,
+	)
+
+	if err != nil {
+		return token, err
+	}
+
+	return token, nil
+}
+
+func (p *postgresqlSessionRepository) UseRefreshToken(ctx context.Context, id string, usedAt time.Time) (bool, error) {
+	result, err := p.db(ctx).ExecContext(
+		ctx,
+		"UPDATE refresh_tokens SET used_at = $1 WHERE id = $2 AND used_at IS NULL AND revoked_at IS NULL",
+		usedAt, id,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (p *postgresqlSessionRepository) RevokeSession(ctx context.Context, userID string, sessionID string, revokedAt time.Time) error {
+	result, err := p.db(ctx).ExecContext(
+		ctx,
+		"UPDATE refresh_tokens SET revoked_at = $1 WHERE user_id = $2 AND session_id = $3 AND revoked_at IS NULL",
+		revokedAt, userID, sessionID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (p *postgresqlSessionRepository) GetUserSessions(ctx context.Context, userID string, now time.Time) ([]model.Session, error) {
+	rows, err := p.db(ctx).QueryContext(
+		ctx,
+		`SELECT t.session_id, t.user_agent,
+			(SELECT MIN(s.created_at) FROM refresh_tokens s WHERE s.session_id = t.session_id),
+			t.created_at, t.expires_at
+		FROM refresh_tokens t
+		WHERE t.user_id = $1 AND t.used_at IS NULL AND t.revoked_at IS NULL AND t.expires_at > $2
+		ORDER BY t.created_at DESC`,
+		userID, now,
+	)
+
+	if err != nil {
+		return []model.Session{}, err
+	}
+
+	defer rows.Close()
+
+	sessions := make([]model.Session, 0)
+
+	for rows.Next() {
+		session := model.Session{}
+		err := rows.Scan(&session.ID, &session.User_Agent, &session.Created_At, &session.Last_Used_At, &session.Expires_At)
+		if err != nil {
+			return sessions, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
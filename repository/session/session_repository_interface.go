@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type ISessionRepository interface {
+	CreateRefreshToken(ctx context.Context, token model.RefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, hash string) (model.RefreshToken, error)
+	// UseRefreshToken marks an unused, unrevoked token as used and reports
+	// whether it did. A false result means the token was already exchanged.
+	UseRefreshToken(ctx context.Context, id string, usedAt time.Time) (bool, error)
+	// RevokeSession revokes every refresh token of the user's session.
+	RevokeSession(ctx context.Context, userID string, sessionID string, revokedAt time.Time) error
+	// GetUserSessions returns the sessions whose current refresh token is
+	// still usable at now.
+	GetUserSessions(ctx context.Context, userID string, now time.Time) ([]model.Session, error)
+}
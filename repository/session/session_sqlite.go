@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteSessionRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteSessionRepository(connectionPool *sql.DB) *sqliteSessionRepository {
+	return &sqliteSessionRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteSessionRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteSessionRepository) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		`INSERT INTO refresh_tokens (id, session_id, user_id, token_hash, user_agent, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		token.ID, token.Session_ID, token.User_ID, token.Token_Hash, token.User_Agent, token.Created_At.UTC(), token.Expires_At.UTC(),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteSessionRepository) GetRefreshTokenByHash(ctx context.Context, hash string) (model.RefreshToken, error) {
+	token := model.RefreshToken{}
+
+	row := s.db(ctx).QueryRowContext(
+		ctx,
+		`SELECT id, session_id, user_id, token_hash, user_agent, created_at, expires_at, used_at, revoked_at
+		FROM refresh_tokens WHERE token_hash = $1`,
+		hash,
+	)
+
+	err := row.Scan(
+		&token.ID, &token.Session_ID, &token.User_ID, &token.Token_Hash, &token.User_Agent,
+		&token.Created_At, &token.Expires_At, &token.Used_At, &token.Revoked_At,
+	)
+
+	if err != nil {
+		return token, err
+	}
+
+	return token, nil
+}
+
+func (s *sqliteSessionRepository) UseRefreshToken(ctx context.Context, id string, usedAt time.Time) (bool, error) {
+	result, err := s.db(ctx).ExecContext(
+		ctx,
+		"UPDATE refresh_tokens SET used_at = $1 WHERE id = $2 AND used_at IS NULL AND revoked_at IS NULL",
+		usedAt.UTC(), id,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (s *sqliteSessionRepository) RevokeSession(ctx context.Context, userID string, sessionID string, revokedAt time.Time) error {
+	result, err := s.db(ctx).ExecContext(
+		ctx,
+		"UPDATE refresh_tokens SET revoked_at = $1 WHERE user_id = $2 AND session_id = $3 AND revoked_at IS NULL",
+		revokedAt.UTC(), userID, sessionID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetUserSessions joins each token to the first one of its session rather
+// than selecting MIN(created_at), which SQLite returns as plain text that
+// cannot be scanned into a time.Time.
+func (s *sqliteSessionRepository) GetUserSessions(ctx context.Context, userID string, now time.Time) ([]model.Session, error) {
+	rows, err := s.db(ctx).QueryContext(
+		ctx,
+		`SELECT t.session_id, t.user_agent, f.created_at, t.created_at, t.expires_at
+		FROM refresh_tokens t
+		JOIN refresh_tokens f ON f.session_id = t.session_id AND NOT EXISTS (
+			SELECT 1 FROM refresh_tokens e
+			WHERE e.session_id = f.session_id AND (e.created_at < f.created_at OR e.created_at = f.created_at AND e.id < f.id)
+		)
+		WHERE t.user_id = $1 AND t.used_at IS NULL AND t.revoked_at IS NULL AND t.expires_at > $2
+		ORDER BY t.created_at DESC`,
+		userID, now.UTC(),
+	)
+
+	if err != nil {
+		return []model.Session{}, err
+	}
+
+	defer rows.Close()
+
+	sessions := make([]model.Session, 0)
+
+	for rows.Next() {
+		session := model.Session{}
+		err := rows.Scan(&session.ID, &session.User_Agent, &session.Created_At, &session.Last_Used_At, &session.Expires_At)
+		if err != nil {
+			return sessions, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryTransactionRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryTransactionRepository(store *memory.Store) *memoryTransactionRepository {
+	return &memoryTransactionRepository{
+		store: store,
+	}
+}
+
+func (m *memoryTransactionRepository) GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) ([]model.Transaction, error) {
+	transactions := make([]model.Transaction, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, transaction := range t.Transactions {
+			if transaction.User_ID == userID && matchTransaction(transaction, query) {
+				transactions = append(transactions, transaction)
+			}
+		}
+	})
+
+	field, desc := query.SortField()
+	compare := memory.Order(desc, func(a, b model.Transaction) int {
+		if field == "amount" {
+			return cmp.Compare(a.Amount.Amount, b.Amount.Amount)
+		}
+
+		return a.Transaction_Date.Compare(b.Transaction_Date)
+	}, func(t model.Transaction) string { return t.ID })
+
+	var after *model.Transaction
+
+	if query.After != nil {
+		after = &model.Transaction{ID: query.After.ID}
+
+		var err error
+
+		if field == "amount" {
+			after.Amount.Amount, err = query.After.IntKey()
+		} else {
+			after.Transaction_Date, err = query.After.TimeKey()
+		}
+
+		if err != nil {
+			return []model.Transaction{}, err
+		}
+	}
+
+	return memory.Page(transactions, compare, after, query.Limit), nil
+}
+
+func matchTransaction(transaction model.Transaction, query model.TransactionQuery) bool {
+	switch {
+	case !query.From.IsZero() && transaction.Transaction_Date.Before(query.From),
+		!query.To.IsZero() && !transaction.Transaction_Date.Before(query.To),
+		query.Wallet_ID != "" && transaction.Wallet_ID != query.Wallet_ID,
+		query.Category_ID != "" && transaction.Category_ID != query.Category_ID,
+		query.Min_Amount != nil && !inCurrency(transaction, *query.Min_Amount, 1),
+		query.Max_Amount != nil && !inCurrency(transaction, *query.Max_Amount, -1),
+		query.Search != "" && !memory.Contains(query.Search, transaction.Description):
+		return false
+	}
+
+	return true
+}
+
+// inCurrency reports whether the transaction is in bound's currency with an
+// amount of at least bound for sign 1, or at most bound for sign -1.
+func inCurrency(transaction model.Transaction, bound model.Money, sign int) bool {
+	return transaction.Amount.Currency == bound.Currency &&
+		cmp.Compare(transaction.Amount.Amount, bound.Amount)*sign >= 0
+}
+
+func (m *memoryTransactionRepository) GetTransaction(ctx context.Context, id string) (model.Transaction, error) {
+	var (
+		transaction model.Transaction
+		ok          bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		transaction, ok = t.Transactions[id]
+	})
+
+	if !ok {
+		return model.Transaction{}, sql.ErrNoRows
+	}
+
+	return transaction, nil
+}
+
+func (m *memoryTransactionRepository) CreateTransaction(ctx context.Context, transaction model.Transaction) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Transactions[transaction.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		err := checkReferences(t, transaction)
+		if err != nil {
+			return err
+		}
+
+		memory.Put(t, t.Transactions, transaction.ID, transaction)
+
+		return t.AdjustBalance(transaction.Wallet_ID, transaction.BalanceDelta())
+	})
+}
+
+func (m *memoryTransactionRepository) UpdateTransaction(ctx context.Context, id string, transaction model.Transaction) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		old, ok := t.Transactions[id]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		err := checkReferences(t, transaction)
+		if err != nil {
+			return err
+		}
+
+		err = t.AdjustBalance(old.Wallet_ID, old.BalanceDelta().Neg())
+		if err != nil {
+			return err
+		}
+
+		transaction.ID = id
+		transaction.User_ID = old.User_ID
+		transaction.Created_At = old.Created_At
+		transaction.Transfer_ID = old.Transfer_ID
+		memory.Put(t, t.Transactions, id, transaction)
+
+		return t.AdjustBalance(transaction.Wallet_ID, transaction.BalanceDelta())
+	})
+}
+
+func (m *memoryTransactionRepository) DeleteTransaction(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		old, ok := t.Transactions[id]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		memory.Delete(t, t.Transactions, id)
+
+		return t.AdjustBalance(old.Wallet_ID, old.BalanceDelta().Neg())
+	})
+}
+
+func (m *memoryTransactionRepository) GetCategoryTotals(ctx context.Context, userID string, from time.Time, to time.Time) ([]model.CategoryTotal, error) {
+	type group struct{ categoryID, kind, currency string }
+
+	sums := make(map[group]int64)
+	names := make(map[string]string)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, transaction := range t.Transactions {
+			date := transaction.Transaction_Date
+			if transaction.User_ID != userID || transaction.IsTransfer() || date.Before(from) || !date.Before(to) {
+				continue
+			}
+
+			sums[group{transaction.Category_ID, transaction.Type, transaction.Amount.Currency}] += transaction.Amount.Amount
+			names[transaction.Category_ID] = t.Categories[transaction.Category_ID].Name
+		}
+	})
+
+	totals := make([]model.CategoryTotal, 0, len(sums))
+
+	for g, sum := range sums {
+		totals = append(totals, model.CategoryTotal{
+			Category_ID:   g.categoryID,
+			Category_Name: names[g.categoryID],
+			Type:          g.kind,
+			Total:         model.NewMoney(sum, g.currency),
+		})
+	}
+
+	slices.SortFunc(totals, func(a, b model.CategoryTotal) int {
+		keys := [][2]string{
+			{a.Category_Name, b.Category_Name},
+			{a.Category_ID, b.Category_ID},
+			{a.Type, b.Type},
+			{a.Total.Currency, b.Total.Currency},
+		}
+
+		for _, key := range keys {
+			if c := cmp.Compare(key[0], key[1]); c != 0 {
+				return c
+			}
+		}
+
+		return 0
+	})
+
+	return totals, nil
+}
+
+// checkReferences fails the way the foreign keys of the transactions table
+// would, with memory.ErrForeignKey for a missing wallet or category.
+func checkReferences(t *memory.Tables, transaction model.Transaction) error {
+	if _, ok := t.Wallets[transaction.Wallet_ID]; !ok {
+		return memory.ErrForeignKey
+	}
+
+	if _, ok := t.Categories[transaction.Category_ID]; transaction.Category_ID != "" && !ok {
+		return memory.ErrForeignKey
+	}
+
+	return nil
+}
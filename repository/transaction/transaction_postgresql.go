@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlTransactionRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlTransactionRepository(connectionPool *sql.DB) *postgresqlTransactionRepository {
+	return &postgresqlTransactionRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlTransactionRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+const selectTransaction = "SELECT id, user_id, wallet_id, COALESCE(category_id, ''), type, amount, currency, original_amount, original_currency, description, transaction_date, created_at, COALESCE(transfer_id, '') FROM transactions"
+
+// selectCategoryTotals is shared by PostgreSQL and SQLite; its arguments are
+// the user ID and the bounds of the period.
+const selectCategoryTotals = `SELECT t.category_id, c.name, t.type, t.currency, CAST(SUM(t.amount) AS BIGINT)
+	FROM transactions t JOIN categories c ON c.id = t.category_id
+	WHERE t.user_id = $1 AND t.transfer_id IS NULL AND t.transaction_date >= $2 AND t.transaction_date < $3
+	GROUP BY t.category_id, c.name, t.type, t.currency
+	ORDER BY c.name, t.category_id, t.type, t.currency`
+
+var transactionColumns = map[string]listing.Column{
+	"date":   {Name: "transaction_date", Key: listing.Time},
+	"amount": {Name: "amount", Key: listing.Int},
+}
+
+// filterTransactions adds the conditions of query other than the cursor.
+// Times are compared in UTC, which is how SQLite stores them.
+func filterTransactions(builder *listing.Builder, userID string, query model.TransactionQuery) {
+	builder.Where("user_id = ?", userID)
+
+	if !query.From.IsZero() {
+		builder.Where("transaction_date >= ?", query.From.UTC())
+	}
+
+	if !query.To.IsZero() {
+		builder.Where("transaction_date < ?", query.To.UTC())
+	}
+
+	if query.Wallet_ID != "" {
+		builder.Where("wallet_id = ?", query.Wallet_ID)
+	}
+
+	if query.Category_ID != "" {
+		builder.Where("category_id = ?", query.Category_ID)
+	}
+
+	if query.Min_Amount != nil {
+		builder.Where("currency = ? AND amount >= ?", query.Min_Amount.Currency, query.Min_Amount.Amount)
+	}
+
+	if query.Max_Amount != nil {
+		builder.Where("currency = ? AND amount <= ?", query.Max_Amount.Currency, query.Max_Amount.Amount)
+	}
+
+	builder.Search(query.Search, "description")
+}
+
+func scanTransaction(row interface{ Scan(...any) error }, transaction *model.Transaction) error {
+	return row.Scan(
+		&transaction.ID, &transaction.User_ID, &transaction.Wallet_ID, &transaction.Category_ID,
+		&transaction.Type, &transaction.Amount, &transaction.Amount.Currency,
+		&transaction.Original_Amount, &transaction.Original_Amount.Currency,
+		&transaction.Description, &transaction.Transaction_Date, &transaction.Created_At,
+		&transaction.Transfer_ID,
+	)
+}
+
+func (p *postgresqlTransactionRepository) GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) ([]model.Transaction, error) {
+	builder := listing.NewPostgresqlBuilder()
+	filterTransactions(builder, userID, query)
+
+	statement, args, err := builder.Query(selectTransaction, transactionColumns, query.ListQuery)
+
+	if err != nil {
+		return []model.Transaction{}, err
+	}
+
+	rows, err := p.db(ctx).QueryContext(ctx, statement, args...)
+
+	if err != nil {
+		return []model.Transaction{}, err
+	}
+
+	defer rows.Close()
+
+	transactions := make([]model.Transaction, 0)
+
+	for rows.Next() {
+		transaction := model.Transaction{}
+		err := scanTransaction(rows, &transaction)
+		if err != nil {
+			return transactions, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (p *postgresqlTransactionRepository) GetTransaction(ctx context.Context, id string) (model.Transaction, error) {
+	transaction := model.Transaction{}
+
+	row := p.db(ctx).QueryRowContext(ctx, selectTransaction+" WHERE id = $1", id)
+
+	err := scanTransaction(row, &transaction)
+
+	if err != nil {
+		return transaction, err
+	}
+
+	return transaction, nil
+}
+
+func (p *postgresqlTransactionRepository) CreateTransaction(ctx context.Context, transaction model.Transaction) error {
+	return unitofwork.InTx(ctx, p.connectionPool, func(ctx context.Context) error {
+		tx := p.db(ctx)
+
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO transactions (id, user_id, wallet_id, category_id, type, amount, currency, original_amount, original_currency, description, transaction_date, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+			transaction.ID, transaction.User_ID, transaction.Wallet_ID, transaction.Category_ID,
+			transaction.Type, transaction.Amount, transaction.Amount.Currency,
+			transaction.Original_Amount, transaction.Original_Amount.Currency,
+			transaction.Description, transaction.Transaction_Date, transaction.Created_At,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return adjustWalletBalance(ctx, tx, transaction.Wallet_ID, transaction.BalanceDelta())
+	})
+}
+
+func (p *postgresqlTransactionRepository) UpdateTransaction(ctx context.Context, id string, transaction model.Transaction) error {
+	return unitofwork.InTx(ctx, p.connectionPool, func(ctx context.Context) error {
+		tx := p.db(ctx)
+
+		old := model.Transaction{}
+
+		err := scanTransaction(tx.QueryRowContext(ctx, selectTransaction+" WHERE id = $1 FOR UPDATE", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		err = adjustWalletBalance(ctx, tx, old.Wallet_ID, old.BalanceDelta().Neg())
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE transactions SET wallet_id = $1, category_id = $2, type = $3, amount = $4, currency = $5, original_amount = $6, original_currency = $7, description = $8, transaction_date = $9 WHERE id = $10",
+			transaction.Wallet_ID, transaction.Category_ID, transaction.Type,
+			transaction.Amount, transaction.Amount.Currency,
+			transaction.Original_Amount, transaction.Original_Amount.Currency,
+			transaction.Description, transaction.Transaction_Date, id,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return adjustWalletBalance(ctx, tx, transaction.Wallet_ID, transaction.BalanceDelta())
+	})
+}
+
+func (p *postgresqlTransactionRepository) DeleteTransaction(ctx context.Context, id string) error {
+	return unitofwork.InTx(ctx, p.connectionPool, func(ctx context.Context) error {
+		tx := p.db(ctx)
+
+		old := model.Transaction{}
+
+		err := scanTransaction(tx.QueryRowContext(ctx, selectTransaction+" WHERE id = $1 FOR UPDATE", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM transactions WHERE id = $1", id)
+
+		if err != nil {
+			return err
+		}
+
+		return adjustWalletBalance(ctx, tx, old.Wallet_ID, old.BalanceDelta().Neg())
+	})
+}
+
+func (p *postgresqlTransactionRepository) GetCategoryTotals(ctx context.Context, userID string, from time.Time, to time.Time) ([]model.CategoryTotal, error) {
+	rows, err := p.db(ctx).QueryContext(ctx, selectCategoryTotals, userID, from.UTC(), to.UTC())
+
+	if err != nil {
+		return []model.CategoryTotal{}, err
+	}
+
+	defer rows.Close()
+
+	return scanCategoryTotals(rows)
+}
+
+func scanCategoryTotals(rows *sql.Rows) ([]model.CategoryTotal, error) {
+	totals := make([]model.CategoryTotal, 0)
+
+	for rows.Next() {
+		total := model.CategoryTotal{}
+		err := rows.Scan(&total.Category_ID, &total.Category_Name, &total.Type, &total.Total.Currency, &total.Total.Amount)
+		if err != nil {
+			return totals, err
+		}
+		totals = append(totals, total)
+	}
+
+	return totals, rows.Err()
+}
+
+func adjustWalletBalance(ctx context.Context, tx unitofwork.DBTX, walletID string, delta model.Money) error {
+	result, err := tx.ExecContext(ctx, "UPDATE wallets SET balance = balance + $1 WHERE id = $2", delta, walletID)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// ITransactionRepository persists transactions. Implementations must keep the
+// owning wallet's balance in step with the ledger: creating, updating or
+// deleting a transaction adjusts the balance within the same database
+// transaction.
+type ITransactionRepository interface {
+	// GetUserTransactions returns the user's transactions matching query,
+	// whose sort must be one of model.TransactionSorts.
+	GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) ([]model.Transaction, error)
+	GetTransaction(ctx context.Context, id string) (model.Transaction, error)
+	CreateTransaction(ctx context.Context, transaction model.Transaction) error
+	UpdateTransaction(ctx context.Context, id string, transaction model.Transaction) error
+	DeleteTransaction(ctx context.Context, id string) error
+	// GetCategoryTotals sums the user's income and expense transactions dated
+	// in [from, to) by category, type and currency. Transfer legs are left
+	// out.
+	GetCategoryTotals(ctx context.Context, userID string, from time.Time, to time.Time) ([]model.CategoryTotal, error)
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteTransactionRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteTransactionRepository(connectionPool *sql.DB) *sqliteTransactionRepository {
+	return &sqliteTransactionRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteTransactionRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteTransactionRepository) GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) ([]model.Transaction, error) {
+	builder := listing.NewSqliteBuilder()
+	filterTransactions(builder, userID, query)
+
+	statement, args, err := builder.Query(selectTransaction, transactionColumns, query.ListQuery)
+
+	if err != nil {
+		return []model.Transaction{}, err
+	}
+
+	rows, err := s.db(ctx).QueryContext(ctx, statement, args...)
+
+	if err != nil {
+		return []model.Transaction{}, err
+	}
+
+	defer rows.Close()
+
+	transactions := make([]model.Transaction, 0)
+
+	for rows.Next() {
+		transaction := model.Transaction{}
+		err := scanTransaction(rows, &transaction)
+		if err != nil {
+			return transactions, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+func (s *sqliteTransactionRepository) GetTransaction(ctx context.Context, id string) (model.Transaction, error) {
+	transaction := model.Transaction{}
+
+	row := s.db(ctx).QueryRowContext(ctx, selectTransaction+" WHERE id = $1", id)
+
+	err := scanTransaction(row, &transaction)
+
+	if err != nil {
+		return transaction, err
+	}
+
+	return transaction, nil
+}
+
+func (s *sqliteTransactionRepository) CreateTransaction(ctx context.Context, transaction model.Transaction) error {
+	return unitofwork.InTx(ctx, s.connectionPool, func(ctx context.Context) error {
+		tx := s.db(ctx)
+
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO transactions (id, user_id, wallet_id, category_id, type, amount, currency, original_amount, original_currency, description, transaction_date, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+			transaction.ID, transaction.User_ID, transaction.Wallet_ID, transaction.Category_ID,
+			transaction.Type, transaction.Amount, transaction.Amount.Currency,
+			transaction.Original_Amount, transaction.Original_Amount.Currency,
+			transaction.Description, transaction.Transaction_Date.UTC(), transaction.Created_At.UTC(),
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return adjustWalletBalance(ctx, tx, transaction.Wallet_ID, transaction.BalanceDelta())
+	})
+}
+
+// UpdateTransaction reads the old row without FOR UPDATE, which SQLite lacks;
+// the single connection of the pool already keeps other writers out.
+func (s *sqliteTransactionRepository) UpdateTransaction(ctx context.Context, id string, transaction model.Transaction) error {
+	return unitofwork.InTx(ctx, s.connectionPool, func(ctx context.Context) error {
+		tx := s.db(ctx)
+
+		old := model.Transaction{}
+
+		err := scanTransaction(tx.QueryRowContext(ctx, selectTransaction+" WHERE id = $1", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		err = adjustWalletBalance(ctx, tx, old.Wallet_ID, old.BalanceDelta().Neg())
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE transactions SET wallet_id = $1, category_id = $2, type = $3, amount = $4, currency = $5, original_amount = $6, original_currency = $7, description = $8, transaction_date = $9 WHERE id = $10",
+			transaction.Wallet_ID, transaction.Category_ID, transaction.Type,
+			transaction.Amount, transaction.Amount.Currency,
+			transaction.Original_Amount, transaction.Original_Amount.Currency,
+			transaction.Description, transaction.Transaction_Date.UTC(), id,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return adjustWalletBalance(ctx, tx, transaction.Wallet_ID, transaction.BalanceDelta())
+	})
+}
+
+func (s *sqliteTransactionRepository) DeleteTransaction(ctx context.Context, id string) error {
+	return unitofwork.InTx(ctx, s.connectionPool, func(ctx context.Context) error {
+		tx := s.db(ctx)
+
+		old := model.Transaction{}
+
+		err := scanTransaction(tx.QueryRowContext(ctx, selectTransaction+" WHERE id = $1", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM transactions WHERE id = $1", id)
+
+		if err != nil {
+			return err
+		}
+
+		return adjustWalletBalance(ctx, tx, old.Wallet_ID, old.BalanceDelta().Neg())
+	})
+}
+
+func (s *sqliteTransactionRepository) GetCategoryTotals(ctx context.Context, userID string, from time.Time, to time.Time) ([]model.CategoryTotal, error) {
+	rows, err := s.db(ctx).QueryContext(ctx, selectCategoryTotals, userID, from.UTC(), to.UTC())
+
+	if err != nil {
+		return []model.CategoryTotal{}, err
+	}
+
+	defer rows.Close()
+
+	return scanCategoryTotals(rows)
+}
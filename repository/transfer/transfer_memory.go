@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryTransferRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryTransferRepository(store *memory.Store) *memoryTransferRepository {
+	return &memoryTransferRepository{
+		store: store,
+	}
+}
+
+func (m *memoryTransferRepository) GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error) {
+	transfers := make([]model.Transfer, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, transfer := range memory.Values(t.Transfers) {
+			if transfer.User_ID == userID {
+				transfers = append(transfers, transfer)
+			}
+		}
+	})
+
+	slices.SortStableFunc(transfers, func(a, b model.Transfer) int {
+		return b.Transfer_Date.Compare(a.Transfer_Date)
+	})
+
+	return transfers, nil
+}
+
+func (m *memoryTransferRepository) GetTransfer(ctx context.Context, id string) (model.Transfer, error) {
+	var (
+		transfer model.Transfer
+		ok       bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		transfer, ok = t.Transfers[id]
+	})
+
+	if !ok {
+		return model.Transfer{}, sql.ErrNoRows
+	}
+
+	return transfer, nil
+}
+
+func (m *memoryTransferRepository) CreateTransfer(ctx context.Context, transfer model.Transfer) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Transfers[transfer.ID]; ok {
+			return memory.ErrDuplicateKey
+		}
+
+		err := checkWallets(t, transfer)
+		if err != nil {
+			return err
+		}
+
+		memory.Put(t, t.Transfers, transfer.ID, transfer)
+
+		return insertMemoryLegs(t, transfer)
+	})
+}
+
+func (m *memoryTransferRepository) UpdateTransfer(ctx context.Context, id string, transfer model.Transfer) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		old, ok := t.Transfers[id]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		err := checkWallets(t, transfer)
+		if err != nil {
+			return err
+		}
+
+		err = deleteMemoryLegs(t, old)
+		if err != nil {
+			return err
+		}
+
+		transfer.ID = id
+		transfer.User_ID = old.User_ID
+		transfer.Created_At = old.Created_At
+		memory.Put(t, t.Transfers, id, transfer)
+
+		return insertMemoryLegs(t, transfer)
+	})
+}
+
+func (m *memoryTransferRepository) DeleteTransfer(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		old, ok := t.Transfers[id]
+		if !ok {
+			return sql.ErrNoRows
+		}
+
+		err := deleteMemoryLegs(t, old)
+		if err != nil {
+			return err
+		}
+
+		memory.Delete(t, t.Transfers, id)
+
+		return nil
+	})
+}
+
+// checkWallets returns memory.ErrForeignKey unless both wallets of transfer
+// exist, before anything is changed.
+func checkWallets(t *memory.Tables, transfer model.Transfer) error {
+	for _, walletID := range []string{transfer.From_Wallet_ID, transfer.To_Wallet_ID} {
+		if _, ok := t.Wallets[walletID]; !ok {
+			return memory.ErrForeignKey
+		}
+	}
+
+	return nil
+}
+
+func insertMemoryLegs(t *memory.Tables, transfer model.Transfer) error {
+	debit, credit := transfer.Legs()
+
+	for _, leg := range []model.Transaction{debit, credit} {
+		memory.Put(t, t.Transactions, leg.ID, leg)
+
+		err := t.AdjustBalance(leg.Wallet_ID, leg.BalanceDelta())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteMemoryLegs(t *memory.Tables, transfer model.Transfer) error {
+	debit, credit := transfer.Legs()
+
+	for _, leg := range []model.Transaction{debit, credit} {
+		memory.Delete(t, t.Transactions, leg.ID)
+
+		err := t.AdjustBalance(leg.Wallet_ID, leg.BalanceDelta().Neg())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
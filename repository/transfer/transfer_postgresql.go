@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlTransferRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlTransferRepository(connectionPool *sql.DB) *postgresqlTransferRepository {
+	return &postgresqlTransferRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlTransferRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+const selectTransfer = "SELECT id, user_id, from_wallet_id, to_wallet_id, amount, currency, to_amount, to_currency, description, transfer_date, created_at FROM transfers"
+
+func scanTransfer(row interface{ Scan(...any) error }, transfer *model.Transfer) error {
+	return row.Scan(
+		&transfer.ID, &transfer.User_ID, &transfer.From_Wallet_ID, &transfer.To_Wallet_ID,
+		&transfer.Amount, &transfer.Amount.Currency, &transfer.To_Amount, &transfer.To_Amount.Currency,
+		&transfer.Description, &transfer.Transfer_Date, &transfer.Created_At,
+	)
+}
+
+func (p *postgresqlTransferRepository) GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error) {
+	rows, err := p.db(ctx).QueryContext(ctx, selectTransfer+" WHERE user_id = $1 ORDER BY transfer_date DESC", userID)
+
+	if err != nil {
+		return []model.Transfer{}, err
+	}
+
+	defer rows.Close()
+
+	transfers := make([]model.Transfer, 0)
+
+	for rows.Next() {
+		transfer := model.Transfer{}
+		err := scanTransfer(rows, &transfer)
+		if err != nil {
+			return transfers, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func (p *postgresqlTransferRepository) GetTransfer(ctx context.Context, id string) (model.Transfer, error) {
+	transfer := model.Transfer{}
+
+	err := scanTransfer(p.db(ctx).QueryRowContext(ctx, selectTransfer+" WHERE id = $1", id), &transfer)
+
+	if err != nil {
+		return transfer, err
+	}
+
+	return transfer, nil
+}
+
+func (p *postgresqlTransferRepository) CreateTransfer(ctx context.Context, transfer model.Transfer) error {
+	return unitofwork.InTx(ctx, p.connectionPool, func(ctx context.Context) error {
+		tx := p.db(ctx)
+
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO transfers (id, user_id, from_wallet_id, to_wallet_id, amount, currency, to_amount, to_currency, description, transfer_date, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+			transfer.ID, transfer.User_ID, transfer.From_Wallet_ID, transfer.To_Wallet_ID,
+			transfer.Amount, transfer.Amount.Currency, transfer.To_Amount, transfer.To_Amount.Currency,
+			transfer.Description, transfer.Transfer_Date, transfer.Created_At,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return insertLegs(ctx, tx, transfer)
+	})
+}
+
+func (p *postgresqlTransferRepository) UpdateTransfer(ctx context.Context, id string, transfer model.Transfer) error {
+	return unitofwork.InTx(ctx, p.connectionPool, func(ctx context.Context) error {
+		tx := p.db(ctx)
+
+		old := model.Transfer{}
+
+		err := scanTransfer(tx.QueryRowContext(ctx, selectTransfer+" WHERE id = $1 FOR UPDATE", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		err = deleteLegs(ctx, tx, old)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE transfers SET from_wallet_id = $1, to_wallet_id = $2, amount = $3, currency = $4, to_amount = $5, to_currency = $6, description = $7, transfer_date = $8 WHERE id = $9",
+			transfer.From_Wallet_ID, transfer.To_Wallet_ID,
+			transfer.Amount, transfer.Amount.Currency, transfer.To_Amount, transfer.To_Amount.Currency,
+			transfer.Description, transfer.Transfer_Date, id,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		transfer.ID = id
+
+		return insertLegs(ctx, tx, transfer)
+	})
+}
+
+func (p *postgresqlTransferRepository) DeleteTransfer(ctx context.Context, id string) error {
+	return unitofwork.InTx(ctx, p.connectionPool, func(ctx context.Context) error {
+		tx := p.db(ctx)
+
+		old := model.Transfer{}
+
+		err := scanTransfer(tx.QueryRowContext(ctx, selectTransfer+" WHERE id = $1 FOR UPDATE", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		err = deleteLegs(ctx, tx, old)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM transfers WHERE id = $1", id)
+
+		return err
+	})
+}
+
+// insertLegs records both legs of transfer and applies them to the wallet
+// balances.
+func insertLegs(ctx context.Context, tx unitofwork.DBTX, transfer model.Transfer) error {
+	debit, credit := transfer.Legs()
+
+	for _, leg := range []model.Transaction{debit, credit} {
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO transactions (id, user_id, wallet_id, type, amount, currency, original_amount, original_currency, description, transaction_date, created_at, transfer_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+			leg.ID, leg.User_ID, leg.Wallet_ID, leg.Type, leg.Amount, leg.Amount.Currency,
+			leg.Original_Amount, leg.Original_Amount.Currency,
+			leg.Description, leg.Transaction_Date, leg.Created_At, leg.Transfer_ID,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		err = adjustWalletBalance(ctx, tx, leg.Wallet_ID, leg.BalanceDelta())
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteLegs removes both legs of transfer and reverts their effect on the
+// wallet balances.
+func deleteLegs(ctx context.Context, tx unitofwork.DBTX, transfer model.Transfer) error {
+	debit, credit := transfer.Legs()
+
+	for _, leg := range []model.Transaction{debit, credit} {
+		_, err := tx.ExecContext(ctx, "DELETE FROM transactions WHERE id = $1", leg.ID)
+
+		if err != nil {
+			return err
+		}
+
+		err = adjustWalletBalance(ctx, tx, leg.Wallet_ID, leg.BalanceDelta().Neg())
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func adjustWalletBalance(ctx context.Context, tx unitofwork.DBTX, walletID string, delta model.Money) error {
+	result, err := tx.ExecContext(ctx, "UPDATE wallets SET balance = balance + $1 WHERE id = $2", delta, walletID)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// ITransferRepository persists transfers together with their two transaction
+// legs (see model.Transfer.Legs). Every write changes the transfer, both legs
+// and both wallet balances in a single database transaction.
+type ITransferRepository interface {
+	GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error)
+	GetTransfer(ctx context.Context, id string) (model.Transfer, error)
+	CreateTransfer(ctx context.Context, transfer model.Transfer) error
+	UpdateTransfer(ctx context.Context, id string, transfer model.Transfer) error
+	DeleteTransfer(ctx context.Context, id string) error
+}
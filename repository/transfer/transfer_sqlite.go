@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteTransferRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteTransferRepository(connectionPool *sql.DB) *sqliteTransferRepository {
+	return &sqliteTransferRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteTransferRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteTransferRepository) GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error) {
+	rows, err := s.db(ctx).QueryContext(ctx, selectTransfer+" WHERE user_id = $1 ORDER BY transfer_date DESC", userID)
+
+	if err != nil {
+		return []model.Transfer{}, err
+	}
+
+	defer rows.Close()
+
+	transfers := make([]model.Transfer, 0)
+
+	for rows.Next() {
+		transfer := model.Transfer{}
+		err := scanTransfer(rows, &transfer)
+		if err != nil {
+			return transfers, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func (s *sqliteTransferRepository) GetTransfer(ctx context.Context, id string) (model.Transfer, error) {
+	transfer := model.Transfer{}
+
+	err := scanTransfer(s.db(ctx).QueryRowContext(ctx, selectTransfer+" WHERE id = $1", id), &transfer)
+
+	if err != nil {
+		return transfer, err
+	}
+
+	return transfer, nil
+}
+
+func (s *sqliteTransferRepository) CreateTransfer(ctx context.Context, transfer model.Transfer) error {
+	transfer = inUTC(transfer)
+
+	return unitofwork.InTx(ctx, s.connectionPool, func(ctx context.Context) error {
+		tx := s.db(ctx)
+
+		_, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO transfers (id, user_id, from_wallet_id, to_wallet_id, amount, currency, to_amount, to_currency, description, transfer_date, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+			transfer.ID, transfer.User_ID, transfer.From_Wallet_ID, transfer.To_Wallet_ID,
+			transfer.Amount, transfer.Amount.Currency, transfer.To_Amount, transfer.To_Amount.Currency,
+			transfer.Description, transfer.Transfer_Date, transfer.Created_At,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		return insertLegs(ctx, tx, transfer)
+	})
+}
+
+func (s *sqliteTransferRepository) UpdateTransfer(ctx context.Context, id string, transfer model.Transfer) error {
+	transfer = inUTC(transfer)
+
+	return unitofwork.InTx(ctx, s.connectionPool, func(ctx context.Context) error {
+		tx := s.db(ctx)
+
+		old := model.Transfer{}
+
+		err := scanTransfer(tx.QueryRowContext(ctx, selectTransfer+" WHERE id = $1", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		err = deleteLegs(ctx, tx, old)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE transfers SET from_wallet_id = $1, to_wallet_id = $2, amount = $3, currency = $4, to_amount = $5, to_currency = $6, description = $7, transfer_date = $8 WHERE id = $9",
+			transfer.From_Wallet_ID, transfer.To_Wallet_ID,
+			transfer.Amount, transfer.Amount.Currency, transfer.To_Amount, transfer.To_Amount.Currency,
+			transfer.Description, transfer.Transfer_Date, id,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		transfer.ID = id
+
+		return insertLegs(ctx, tx, transfer)
+	})
+}
+
+func (s *sqliteTransferRepository) DeleteTransfer(ctx context.Context, id string) error {
+	return unitofwork.InTx(ctx, s.connectionPool, func(ctx context.Context) error {
+		tx := s.db(ctx)
+
+		old := model.Transfer{}
+
+		err := scanTransfer(tx.QueryRowContext(ctx, selectTransfer+" WHERE id = $1", id), &old)
+
+		if err != nil {
+			return err
+		}
+
+		err = deleteLegs(ctx, tx, old)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM transfers WHERE id = $1", id)
+
+		return err
+	})
+}
+
+// inUTC converts the timestamps of transfer, and so of its legs, to UTC as
+// the SQLite schema stores them.
+func inUTC(transfer model.Transfer) model.Transfer {
+	transfer.Transfer_Date = transfer.Transfer_Date.UTC()
+	transfer.Created_At = transfer.Created_At.UTC()
+
+	return transfer
+}
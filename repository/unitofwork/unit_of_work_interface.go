@@ -0,0 +1,48 @@
+// Package unitofwork lets services run calls to several repositories in a
+// single database transaction. The transaction travels in the context, so
+// repositories pick it up through Conn without changing their interfaces.
+package unitofwork
+
+import "context"
+
+// IUnitOfWork groups repository calls into one database transaction.
+type IUnitOfWork interface {
+	// Do runs fn in a transaction. Repository calls made with the ctx passed
+	// to fn join it. The transaction commits when fn returns nil and rolls
+	// back when it returns an error or panics. Nested calls join the
+	// outermost transaction, which alone commits or rolls back. Functions
+	// passed to AfterCommit with that ctx run once it has committed.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type afterCommitKey struct{}
+
+// afterCommit holds the functions to run once a unit of work commits.
+type afterCommit struct {
+	fns []func()
+}
+
+// AfterCommit runs fn once the unit of work ctx belongs to has committed, or
+// right away outside of one. fn never runs when the unit of work rolls back.
+// It suits side effects that cannot be undone, such as sending an email.
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return
+	}
+
+	fn()
+}
+
+// WithAfterCommit returns a context collecting the functions passed to
+// AfterCommit, and a function running them, for units of work to call once
+// they have committed.
+func WithAfterCommit(ctx context.Context) (context.Context, func()) {
+	hooks := &afterCommit{}
+
+	return context.WithValue(ctx, afterCommitKey{}, hooks), func() {
+		for _, fn := range hooks.fns {
+			fn()
+		}
+	}
+}
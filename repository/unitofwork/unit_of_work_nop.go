@@ -0,0 +1,15 @@
+package unitofwork
+
+import "context"
+
+type nopUnitOfWork struct{}
+
+// NewNopUnitOfWork returns a unit of work that calls fn without a
+// transaction, for tests and stores without transactions.
+func NewNopUnitOfWork() *nopUnitOfWork {
+	return &nopUnitOfWork{}
+}
+
+func (nopUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,139 @@
+package unitofwork
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// DBTX is the query API shared by *sql.DB and *sql.Tx.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+type sqlUnitOfWork struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlUnitOfWork(connectionPool *sql.DB) *sqlUnitOfWork {
+	return &sqlUnitOfWork{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *sqlUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return InTx(ctx, p.connectionPool, fn)
+}
+
+// Conn returns the transaction carried by ctx, or connectionPool when there
+// is none. Writes through it report constraint violations as
+// model.ErrDuplicateKey and model.ErrForeignKey.
+func Conn(ctx context.Context, connectionPool *sql.DB) DBTX {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return conn{tx}
+	}
+	return conn{connectionPool}
+}
+
+// conn translates the errors of the writes made through DBTX.
+type conn struct {
+	DBTX
+}
+
+func (c conn) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	result, err := c.DBTX.ExecContext(ctx, query, args...)
+
+	return result, translateError(err)
+}
+
+// translateError returns the model error for a constraint violation reported
+// by either database, or err itself.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if translated, ok := translatePostgresqlError(err); ok {
+		return translated
+	}
+
+	if translated, ok := translateSqliteError(err); ok {
+		return translated
+	}
+
+	return err
+}
+
+func translatePostgresqlError(err error) (error, bool) {
+	var e *pq.Error
+	if !errors.As(err, &e) {
+		return nil, false
+	}
+
+	switch e.Code.Name() {
+	case "unique_violation":
+		return model.ErrDuplicateKey, true
+	case "foreign_key_violation":
+		return model.ErrForeignKey, true
+	}
+
+	return nil, false
+}
+
+// InTransaction reports whether ctx carries a transaction.
+func InTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return ok
+}
+
+// InTx runs fn in a transaction on connectionPool, or in the transaction
+// ctx already carries. Repositories whose writes must be atomic use it
+// directly so they work both on their own and inside a unit of work.
+func InTx(ctx context.Context, connectionPool *sql.DB, fn func(ctx context.Context) error) error {
+	if InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	tx, err := connectionPool.BeginTx(ctx, nil)
+
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	ctx, commit := WithAfterCommit(ctx)
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+
+	if err != nil {
+		return translateError(err)
+	}
+
+	commit()
+
+	return nil
+}
+
+// LockForShare returns the clause that keeps a row read inside a
+// transaction from being deleted until the transaction ends, or "" outside
+// one.
+func LockForShare(ctx context.Context) string {
+	if InTransaction(ctx) {
+		return " FOR KEY SHARE"
+	}
+	return ""
+}
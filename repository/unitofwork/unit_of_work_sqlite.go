@@ -0,0 +1,34 @@
+package unitofwork
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/varomnrg/money-tracker/model"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// NewSqliteUnitOfWork works like NewPostgresqlUnitOfWork. The SQLite
+// repositories never call LockForShare, as SQLite has no row locks.
+func NewSqliteUnitOfWork(connectionPool *sql.DB) *sqlUnitOfWork {
+	return &sqlUnitOfWork{
+		connectionPool: connectionPool,
+	}
+}
+
+func translateSqliteError(err error) (error, bool) {
+	var e *sqlite.Error
+	if !errors.As(err, &e) {
+		return nil, false
+	}
+
+	switch e.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return model.ErrDuplicateKey, true
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return model.ErrForeignKey, true
+	}
+
+	return nil, false
+}
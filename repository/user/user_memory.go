@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryUserRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryUserRepository(store *memory.Store) *memoryUserRepository {
+	return &memoryUserRepository{
+		store: store,
+	}
+}
+
+func toUserResponse(user model.User) model.UserResponse {
+	return model.UserResponse{
+		ID:         user.ID,
+		Username:   user.Username,
+		Email:      user.Email,
+		Role:       user.Role,
+		Created_At: user.Created_At,
+	}
+}
+
+func (m *memoryUserRepository) GetUsers(ctx context.Context, query model.ListQuery) ([]model.UserResponse, error) {
+	users := make([]model.UserResponse, 0)
+
+	for _, user := range m.users(ctx) {
+		if memory.Contains(query.Search, user.Username, user.Email) {
+			users = append(users, toUserResponse(user))
+		}
+	}
+
+	field, desc := query.SortField()
+	compare := memory.Order(desc, func(a, b model.UserResponse) int {
+		if field == "username" {
+			return cmp.Compare(a.Username, b.Username)
+		}
+
+		return a.Created_At.Compare(b.Created_At)
+	}, func(u model.UserResponse) string { return u.ID })
+
+	var after *model.UserResponse
+
+	if query.After != nil {
+		after = &model.UserResponse{ID: query.After.ID, Username: query.After.Key}
+
+		if field != "username" {
+			createdAt, err := query.After.TimeKey()
+			if err != nil {
+				return []model.UserResponse{}, err
+			}
+
+			after.Created_At = createdAt
+		}
+	}
+
+	return memory.Page(users, compare, after, query.Limit), nil
+}
+
+func (m *memoryUserRepository) GetUser(ctx context.Context, id string) (model.UserResponse, error) {
+	var (
+		user model.User
+		ok   bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		user, ok = t.Users[id]
+	})
+
+	if !ok {
+		return model.UserResponse{}, sql.ErrNoRows
+	}
+
+	return toUserResponse(user), nil
+}
+
+func (m *memoryUserRepository) CreateUser(ctx context.Context, movie model.User) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Users[movie.ID]; ok || usernameTaken(t, movie.Username, "") {
+			return memory.ErrDuplicateKey
+		}
+
+		memory.Put(t, t.Users, movie.ID, movie)
+
+		return nil
+	})
+}
+
+// DeleteUser removes the user and, like the ON DELETE CASCADE clauses of the
+// schema, everything the user owns.
+func (m *memoryUserRepository) DeleteUser(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		memory.Delete(t, t.Users, id)
+		deleteOwned(t.Categories, id, func(c model.Category) string { return c.User_ID })
+		deleteOwned(t.Wallets, id, func(w model.Wallet) string { return w.User_ID })
+		deleteOwned(t.Transactions, id, func(tr model.Transaction) string { return tr.User_ID })
+		deleteOwned(t.Transfers, id, func(tr model.Transfer) string { return tr.User_ID })
+		deleteOwned(t.RefreshTokens, id, func(rt model.RefreshToken) string { return rt.User_ID })
+		deleteOwned(t.APIKeys, id, func(k model.APIKey) string { return k.User_ID })
+		deleteOwned(t.Budgets, id, func(b model.Budget) string { return b.User_ID })
+		deleteOwned(t.Notifications, id, func(n model.Notification) string { return n.User_ID })
+		deleteOwned(t.Recurring, id, func(r model.RecurringTransaction) string { return r.User_ID })
+
+		return nil
+	})
+}
+
+func deleteOwned[T any](table map[string]T, userID string, owner func(T) string) {
+	for key, row := range table {
+		if owner(row) == userID {
+			delete(table, key)
+		}
+	}
+}
+
+func (m *memoryUserRepository) UpdateUser(ctx context.Context, id string, movie model.UserRequest) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		user, ok := t.Users[id]
+		if !ok {
+			return nil
+		}
+
+		if usernameTaken(t, movie.Username, id) {
+			return memory.ErrDuplicateKey
+		}
+
+		user.Username = movie.Username
+		user.Password = movie.Password
+		user.Email = movie.Email
+		memory.Put(t, t.Users, id, user)
+
+		return nil
+	})
+}
+
+func (m *memoryUserRepository) IsUsernameExist(ctx context.Context, username string) bool {
+	var exist bool
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		exist = usernameTaken(t, username, "")
+	})
+
+	return exist
+}
+
+func (m *memoryUserRepository) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
+	for _, user := range m.users(ctx) {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+
+	return model.User{}, sql.ErrNoRows
+}
+
+func (m *memoryUserRepository) GetUsersWithPassword(ctx context.Context) ([]model.User, error) {
+	return m.users(ctx), nil
+}
+
+func (m *memoryUserRepository) UpdatePassword(ctx context.Context, id string, password string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		user, ok := t.Users[id]
+		if ok {
+			user.Password = password
+			memory.Put(t, t.Users, id, user)
+		}
+
+		return nil
+	})
+}
+
+// users returns every user ordered by creation time.
+func (m *memoryUserRepository) users(ctx context.Context) []model.User {
+	var users []model.User
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		users = memory.Values(t.Users)
+	})
+
+	slices.SortStableFunc(users, func(a, b model.User) int {
+		return a.Created_At.Compare(b.Created_At)
+	})
+
+	return users
+}
+
+// usernameTaken reports whether a user other than exceptID has username.
+func usernameTaken(t *memory.Tables, username string, exceptID string) bool {
+	for _, user := range t.Users {
+		if user.Username == username && user.ID != exceptID {
+			return true
+		}
+	}
+
+	return false
+}
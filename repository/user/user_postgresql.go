@@ -1,42 +1,45 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
-	"log"
 
 	_ "github.com/lib/pq"
 	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
 )
 
 type postgresqlUserRepository struct {
 	connectionPool *sql.DB
 }
 
-func NewPostgresqlUserRepository(DB_URL string) *postgresqlUserRepository {
-	connString := DB_URL
-
-	connectionPool, err := sql.Open("postgres", connString)
-
-	if err != nil {
-		log.Fatal(err)
+func NewPostgresqlUserRepository(connectionPool *sql.DB) *postgresqlUserRepository {
+	return &postgresqlUserRepository{
+		connectionPool: connectionPool,
 	}
+}
+
+func (p *postgresqlUserRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
 
-	err = connectionPool.Ping()
+var userColumns = map[string]listing.Column{
+	"created_at": {Name: "created_at", Key: listing.Time},
+	"username":   {Name: "username", Key: listing.Text},
+}
 
-	if err != nil {
-		log.Fatal(err)
-	}
+func (p *postgresqlUserRepository) GetUsers(ctx context.Context, query model.ListQuery) ([]model.UserResponse, error) {
+	builder := listing.NewPostgresqlBuilder()
+	builder.Search(query.Search, "username", "email")
 
-	fmt.Println("Successfully connected to database!")
+	statement, args, err := builder.Query("SELECT id, username, email, role, created_at FROM users", userColumns, query)
 
-	return &postgresqlUserRepository{
-		connectionPool: connectionPool,
+	if err != nil {
+		return []model.UserResponse{}, err
 	}
-}
 
-func (p *postgresqlUserRepository) GetUsers() ([]model.UserResponse, error) {
-	rows, err := p.connectionPool.Query("SELECT id, username, email, created_at FROM users")
+	rows, err := p.db(ctx).QueryContext(ctx, statement, args...)
 
 	if err != nil {
 		return []model.UserResponse{}, err
@@ -47,22 +50,26 @@ func (p *postgresqlUserRepository) GetUsers() ([]model.UserResponse, error) {
 
 	for rows.Next() {
 		user := model.UserResponse{}
-		err := rows.Scan(&user.ID, &user.Username, &user.Email, &user.Created_At)
+		err := rows.Scan(&user.ID, &user.Username, &user.Email, &user.Role, &user.Created_At)
 		if err != nil {
 			return users, err
 		}
 		users = append(users, user)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
 	return users, nil
 }
 
-func (p *postgresqlUserRepository) GetUser(id string) (model.UserResponse, error) {
+func (p *postgresqlUserRepository) GetUser(ctx context.Context, id string) (model.UserResponse, error) {
 	user := model.UserResponse{}
 
-	row := p.connectionPool.QueryRow("SELECT id, username, email, created_at FROM users WHERE id = $1", id)
+	row := p.db(ctx).QueryRowContext(ctx, "SELECT id, username, email, role, created_at FROM users WHERE id = $1"+unitofwork.LockForShare(ctx), id)
 
-	err := row.Scan(&user.ID, &user.Username, &user.Email, &user.Created_At)
+	err := row.Scan(&user.ID, &user.Username, &user.Email, &user.Role, &user.Created_At)
 
 	if err != nil {
 		return user, err
@@ -71,10 +78,11 @@ func (p *postgresqlUserRepository) GetUser(id string) (model.UserResponse, error
 	return user, nil
 }
 
-func (p *postgresqlUserRepository) CreateUser(movie model.User) error {
-	_, err := p.connectionPool.Exec(
-		"INSERT INTO users (id, username, password, email, created_at) VALUES ($1, $2, $3, $4, $5)",
-		movie.ID, movie.Username, movie.Password, movie.Email, movie.Created_At,
+func (p *postgresqlUserRepository) CreateUser(ctx context.Context, movie model.User) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO users (id, username, password, email, role, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		movie.ID, movie.Username, movie.Password, movie.Email, movie.Role, movie.Created_At,
 	)
 
 	if err != nil {
@@ -84,8 +92,9 @@ func (p *postgresqlUserRepository) CreateUser(movie model.User) error {
 	return nil
 }
 
-func (p *postgresqlUserRepository) DeleteUser(id string) error {
-	_, err := p.connectionPool.Exec(
+func (p *postgresqlUserRepository) DeleteUser(ctx context.Context, id string) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
 		"DELETE FROM users WHERE id = $1",
 		id,
 	)
@@ -97,8 +106,9 @@ func (p *postgresqlUserRepository) DeleteUser(id string) error {
 	return nil
 }
 
-func (p *postgresqlUserRepository) UpdateUser(id string, movie model.UserRequest) error {
-	_, err := p.connectionPool.Exec(
+func (p *postgresqlUserRepository) UpdateUser(ctx context.Context, id string, movie model.UserRequest) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
 		"UPDATE users SET username = $1, password = $2, email = $3 WHERE id = $4",
 		movie.Username, movie.Password, movie.Email, id,
 	)
@@ -110,10 +120,64 @@ func (p *postgresqlUserRepository) UpdateUser(id string, movie model.UserRequest
 	return nil
 }
 
-func (p *postgresqlUserRepository) IsUsernameExist(username string) bool {
-	row := p.connectionPool.QueryRow("SELECT username FROM users WHERE username = $1", username)
+func (p *postgresqlUserRepository) IsUsernameExist(ctx context.Context, username string) bool {
+	row := p.db(ctx).QueryRowContext(ctx, "SELECT username FROM users WHERE username = $1", username)
 
 	err := row.Scan(&username)
 
 	return err == nil
 }
+
+func (p *postgresqlUserRepository) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
+	user := model.User{}
+
+	row := p.db(ctx).QueryRowContext(ctx, "SELECT id, username, password, email, role, created_at FROM users WHERE username = $1", username)
+
+	err := row.Scan(&user.ID, &user.Username, &user.Password, &user.Email, &user.Role, &user.Created_At)
+
+	if err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
+func (p *postgresqlUserRepository) GetUsersWithPassword(ctx context.Context) ([]model.User, error) {
+	rows, err := p.db(ctx).QueryContext(ctx, "SELECT id, username, password, email, role, created_at FROM users")
+
+	if err != nil {
+		return []model.User{}, err
+	}
+	defer rows.Close()
+
+	users := make([]model.User, 0)
+
+	for rows.Next() {
+		user := model.User{}
+		err := rows.Scan(&user.ID, &user.Username, &user.Password, &user.Email, &user.Role, &user.Created_At)
+		if err != nil {
+			return users, err
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (p *postgresqlUserRepository) UpdatePassword(ctx context.Context, id string, password string) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"UPDATE users SET password = $1 WHERE id = $2",
+		password, id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,12 +1,23 @@
 package repository
 
-import "github.com/varomnrg/money-tracker/model"
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
 
 type IUserRepository interface {
-	GetUsers() ([]model.UserResponse, error)
-	GetUser(id string) (model.UserResponse, error)
-	CreateUser(movie model.User) error
-	DeleteUser(id string) error
-	UpdateUser(id string, movie model.UserRequest) error
-	IsUsernameExist(username string) bool
+	// GetUsers returns the users matching query, whose sort must be one of
+	// model.UserSorts. Search matches usernames and emails.
+	GetUsers(ctx context.Context, query model.ListQuery) ([]model.UserResponse, error)
+	// Inside a unit of work the row cannot be deleted by others until the
+	// transaction ends.
+	GetUser(ctx context.Context, id string) (model.UserResponse, error)
+	CreateUser(ctx context.Context, movie model.User) error
+	DeleteUser(ctx context.Context, id string) error
+	UpdateUser(ctx context.Context, id string, movie model.UserRequest) error
+	IsUsernameExist(ctx context.Context, username string) bool
+	GetUserByUsername(ctx context.Context, username string) (model.User, error)
+	GetUsersWithPassword(ctx context.Context) ([]model.User, error)
+	UpdatePassword(ctx context.Context, id string, password string) error
 }
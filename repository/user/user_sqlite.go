@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/listing"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteUserRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteUserRepository(connectionPool *sql.DB) *sqliteUserRepository {
+	return &sqliteUserRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteUserRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteUserRepository) GetUsers(ctx context.Context, query model.ListQuery) ([]model.UserResponse, error) {
+	builder := listing.NewSqliteBuilder()
+	builder.Search(query.Search, "username", "email")
+
+	statement, args, err := builder.Query("SELECT id, username, email, role, created_at FROM users", userColumns, query)
+
+	if err != nil {
+		return []model.UserResponse{}, err
+	}
+
+	rows, err := s.db(ctx).QueryContext(ctx, statement, args...)
+
+	if err != nil {
+		return []model.UserResponse{}, err
+	}
+	defer rows.Close()
+
+	users := make([]model.UserResponse, 0)
+
+	for rows.Next() {
+		user := model.UserResponse{}
+		err := rows.Scan(&user.ID, &user.Username, &user.Email, &user.Role, &user.Created_At)
+		if err != nil {
+			return users, err
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (s *sqliteUserRepository) GetUser(ctx context.Context, id string) (model.UserResponse, error) {
+	user := model.UserResponse{}
+
+	row := s.db(ctx).QueryRowContext(ctx, "SELECT id, username, email, role, created_at FROM users WHERE id = $1", id)
+
+	err := row.Scan(&user.ID, &user.Username, &user.Email, &user.Role, &user.Created_At)
+
+	if err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
+func (s *sqliteUserRepository) CreateUser(ctx context.Context, movie model.User) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO users (id, username, password, email, role, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		movie.ID, movie.Username, movie.Password, movie.Email, movie.Role, movie.Created_At.UTC(),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteUserRepository) DeleteUser(ctx context.Context, id string) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"DELETE FROM users WHERE id = $1",
+		id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteUserRepository) UpdateUser(ctx context.Context, id string, movie model.UserRequest) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"UPDATE users SET username = $1, password = $2, email = $3 WHERE id = $4",
+		movie.Username, movie.Password, movie.Email, id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteUserRepository) IsUsernameExist(ctx context.Context, username string) bool {
+	row := s.db(ctx).QueryRowContext(ctx, "SELECT username FROM users WHERE username = $1", username)
+
+	err := row.Scan(&username)
+
+	return err == nil
+}
+
+func (s *sqliteUserRepository) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
+	user := model.User{}
+
+	row := s.db(ctx).QueryRowContext(ctx, "SELECT id, username, password, email, role, created_at FROM users WHERE username = $1", username)
+
+	err := row.Scan(&user.ID, &user.Username, &user.Password, &user.Email, &user.Role, &user.Created_At)
+
+	if err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
+func (s *sqliteUserRepository) GetUsersWithPassword(ctx context.Context) ([]model.User, error) {
+	rows, err := s.db(ctx).QueryContext(ctx, "SELECT id, username, password, email, role, created_at FROM users ORDER BY created_at")
+
+	if err != nil {
+		return []model.User{}, err
+	}
+	defer rows.Close()
+
+	users := make([]model.User, 0)
+
+	for rows.Next() {
+		user := model.User{}
+		err := rows.Scan(&user.ID, &user.Username, &user.Password, &user.Email, &user.Role, &user.Created_At)
+		if err != nil {
+			return users, err
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (s *sqliteUserRepository) UpdatePassword(ctx context.Context, id string, password string) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"UPDATE users SET password = $1 WHERE id = $2",
+		password, id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
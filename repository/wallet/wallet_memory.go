@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+)
+
+type memoryWalletRepository struct {
+	store *memory.Store
+}
+
+func NewMemoryWalletRepository(store *memory.Store) *memoryWalletRepository {
+	return &memoryWalletRepository{
+		store: store,
+	}
+}
+
+func (m *memoryWalletRepository) GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error) {
+	wallets := make([]model.Wallet, 0)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		for _, wallet := range memory.Values(t.Wallets) {
+			if wallet.User_ID == userID {
+				wallets = append(wallets, wallet)
+			}
+		}
+	})
+
+	return wallets, nil
+}
+
+func (m *memoryWalletRepository) GetWallet(ctx context.Context, id string) (model.Wallet, error) {
+	var (
+		wallet model.Wallet
+		ok     bool
+	)
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		wallet, ok = t.Wallets[id]
+	})
+
+	if !ok {
+		return model.Wallet{}, sql.ErrNoRows
+	}
+
+	return wallet, nil
+}
+
+func (m *memoryWalletRepository) CreateWallet(ctx context.Context, wallet model.Wallet) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		if _, ok := t.Wallets[wallet.ID]; ok || walletNameTaken(t, wallet.User_ID, wallet.Name, "") {
+			return memory.ErrDuplicateKey
+		}
+
+		if _, ok := t.Users[wallet.User_ID]; !ok {
+			return memory.ErrForeignKey
+		}
+
+		wallet.Balance.Currency = wallet.Currency
+		memory.Put(t, t.Wallets, wallet.ID, wallet)
+
+		return nil
+	})
+}
+
+func (m *memoryWalletRepository) UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		existing, ok := t.Wallets[id]
+		if !ok {
+			return nil
+		}
+
+		if walletNameTaken(t, existing.User_ID, wallet.Name, id) {
+			return memory.ErrDuplicateKey
+		}
+
+		existing.Name = wallet.Name
+		memory.Put(t, t.Wallets, id, existing)
+
+		return nil
+	})
+}
+
+// DeleteWallet removes the wallet together with its transactions. A wallet
+// that still takes part in a transfer cannot be deleted.
+func (m *memoryWalletRepository) DeleteWallet(ctx context.Context, id string) error {
+	return m.store.Write(ctx, func(t *memory.Tables) error {
+		for _, transfer := range t.Transfers {
+			if transfer.From_Wallet_ID == id || transfer.To_Wallet_ID == id {
+				return memory.ErrForeignKey
+			}
+		}
+
+		for key, transaction := range t.Transactions {
+			if transaction.Wallet_ID == id {
+				memory.Delete(t, t.Transactions, key)
+			}
+		}
+
+		for key, recurring := range t.Recurring {
+			if recurring.Wallet_ID == id {
+				memory.Delete(t, t.Recurring, key)
+			}
+		}
+
+		memory.Delete(t, t.Wallets, id)
+
+		return nil
+	})
+}
+
+func (m *memoryWalletRepository) IsUserWalletExist(ctx context.Context, userID string, walletName string) bool {
+	var exist bool
+
+	m.store.Read(ctx, func(t *memory.Tables) {
+		exist = walletNameTaken(t, userID, walletName, "")
+	})
+
+	return exist
+}
+
+// walletNameTaken reports whether a wallet of userID other than exceptID is
+// called name.
+func walletNameTaken(t *memory.Tables, userID string, name string, exceptID string) bool {
+	for _, wallet := range t.Wallets {
+		if wallet.User_ID == userID && wallet.Name == name && wallet.ID != exceptID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	_ "github.com/lib/pq"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type postgresqlWalletRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewPostgresqlWalletRepository(connectionPool *sql.DB) *postgresqlWalletRepository {
+	return &postgresqlWalletRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (p *postgresqlWalletRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, p.connectionPool)
+}
+
+func (p *postgresqlWalletRepository) GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error) {
+	rows, err := p.db(ctx).QueryContext(ctx, "SELECT id, name, currency, balance, user_id FROM wallets WHERE user_id = $1", userID)
+
+	if err != nil {
+		return []model.Wallet{}, err
+	}
+
+	defer rows.Close()
+
+	wallets := make([]model.Wallet, 0)
+
+	for rows.Next() {
+		wallet := model.Wallet{}
+		err := rows.Scan(&wallet.ID, &wallet.Name, &wallet.Currency, &wallet.Balance, &wallet.User_ID)
+		if err != nil {
+			return wallets, err
+		}
+		wallet.Balance.Currency = wallet.Currency
+		wallets = append(wallets, wallet)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return wallets, nil
+}
+
+func (p *postgresqlWalletRepository) GetWallet(ctx context.Context, id string) (model.Wallet, error) {
+	wallet := model.Wallet{}
+
+	row := p.db(ctx).QueryRowContext(ctx, "SELECT id, name, currency, balance, user_id FROM wallets WHERE id = $1"+unitofwork.LockForShare(ctx), id)
+
+	err := row.Scan(&wallet.ID, &wallet.Name, &wallet.Currency, &wallet.Balance, &wallet.User_ID)
+
+	if err != nil {
+		return wallet, err
+	}
+
+	wallet.Balance.Currency = wallet.Currency
+
+	return wallet, nil
+}
+
+func (p *postgresqlWalletRepository) CreateWallet(ctx context.Context, wallet model.Wallet) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO wallets (id, name, currency, balance, user_id) VALUES ($1, $2, $3, $4, $5)",
+		wallet.ID, wallet.Name, wallet.Currency, wallet.Balance, wallet.User_ID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlWalletRepository) UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"UPDATE wallets SET name = $1 WHERE id = $2",
+		wallet.Name, id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlWalletRepository) DeleteWallet(ctx context.Context, id string) error {
+	_, err := p.db(ctx).ExecContext(
+		ctx,
+		"DELETE FROM wallets WHERE id = $1",
+		id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresqlWalletRepository) IsUserWalletExist(ctx context.Context, userID string, walletName string) bool {
+	row := p.db(ctx).QueryRowContext(
+		ctx,
+		"SELECT id FROM wallets WHERE user_id = $1 AND name = $2",
+		userID, walletName,
+	)
+
+	wallet := model.Wallet{}
+
+	err := row.Scan(&wallet.ID)
+
+	return err == nil
+}
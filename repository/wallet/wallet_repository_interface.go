@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IWalletRepository interface {
+	GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error)
+	// Inside a unit of work the row cannot be deleted by others until the
+	// transaction ends.
+	GetWallet(ctx context.Context, id string) (model.Wallet, error)
+	CreateWallet(ctx context.Context, wallet model.Wallet) error
+	UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error
+	DeleteWallet(ctx context.Context, id string) error
+	IsUserWalletExist(ctx context.Context, userID string, walletName string) bool
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+)
+
+type sqliteWalletRepository struct {
+	connectionPool *sql.DB
+}
+
+func NewSqliteWalletRepository(connectionPool *sql.DB) *sqliteWalletRepository {
+	return &sqliteWalletRepository{
+		connectionPool: connectionPool,
+	}
+}
+
+func (s *sqliteWalletRepository) db(ctx context.Context) unitofwork.DBTX {
+	return unitofwork.Conn(ctx, s.connectionPool)
+}
+
+func (s *sqliteWalletRepository) GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error) {
+	rows, err := s.db(ctx).QueryContext(ctx, "SELECT id, name, currency, balance, user_id FROM wallets WHERE user_id = $1", userID)
+
+	if err != nil {
+		return []model.Wallet{}, err
+	}
+
+	defer rows.Close()
+
+	wallets := make([]model.Wallet, 0)
+
+	for rows.Next() {
+		wallet := model.Wallet{}
+		err := rows.Scan(&wallet.ID, &wallet.Name, &wallet.Currency, &wallet.Balance, &wallet.User_ID)
+		if err != nil {
+			return wallets, err
+		}
+		wallet.Balance.Currency = wallet.Currency
+		wallets = append(wallets, wallet)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return wallets, nil
+}
+
+func (s *sqliteWalletRepository) GetWallet(ctx context.Context, id string) (model.Wallet, error) {
+	wallet := model.Wallet{}
+
+	row := s.db(ctx).QueryRowContext(ctx, "SELECT id, name, currency, balance, user_id FROM wallets WHERE id = $1", id)
+
+	err := row.Scan(&wallet.ID, &wallet.Name, &wallet.Currency, &wallet.Balance, &wallet.User_ID)
+
+	if err != nil {
+		return wallet, err
+	}
+
+	wallet.Balance.Currency = wallet.Currency
+
+	return wallet, nil
+}
+
+func (s *sqliteWalletRepository) CreateWallet(ctx context.Context, wallet model.Wallet) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"INSERT INTO wallets (id, name, currency, balance, user_id) VALUES ($1, $2, $3, $4, $5)",
+		wallet.ID, wallet.Name, wallet.Currency, wallet.Balance, wallet.User_ID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteWalletRepository) UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"UPDATE wallets SET name = $1 WHERE id = $2",
+		wallet.Name, id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteWalletRepository) DeleteWallet(ctx context.Context, id string) error {
+	_, err := s.db(ctx).ExecContext(
+		ctx,
+		"DELETE FROM wallets WHERE id = $1",
+		id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *sqliteWalletRepository) IsUserWalletExist(ctx context.Context, userID string, walletName string) bool {
+	row := s.db(ctx).QueryRowContext(
+		ctx,
+		"SELECT id FROM wallets WHERE user_id = $1 AND name = $2",
+		userID, walletName,
+	)
+
+	wallet := model.Wallet{}
+
+	err := row.Scan(&wallet.ID)
+
+	return err == nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/varomnrg/money-tracker/model"
+	repository "github.com/varomnrg/money-tracker/repository/apikey"
+	userRepository "github.com/varomnrg/money-tracker/repository/user"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+// KeyPrefix starts every API key so they are easy to tell apart from access
+// tokens and to spot in leaked configuration.
+const KeyPrefix = "mt_"
+
+const (
+	keyLength     = 32
+	displayLength = 8
+)
+
+type APIKeyService struct {
+	apiKeyRepo repository.IAPIKeyRepository
+	userRepo   userRepository.IUserRepository
+}
+
+var (
+	ErrUserNotFound   = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrAPIKeyNotFound = model.NewError(model.CodeNotFound, "api key cannot be found")
+	ErrInvalidAPIKey  = model.NewError(model.CodeUnauthorized, "api key is invalid")
+)
+
+func NewAPIKeyService(apiKeyRepo repository.IAPIKeyRepository, userRepo userRepository.IUserRepository) *APIKeyService {
+	return &APIKeyService{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+	}
+}
+
+func (a *APIKeyService) GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	return a.apiKeyRepo.GetUserAPIKeys(ctx, userID)
+}
+
+func (a *APIKeyService) CreateAPIKey(ctx context.Context, userID string, keyRequest model.APIKeyRequest) (model.APIKeyCreated, error) {
+	_, err := a.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return model.APIKeyCreated{}, ErrUserNotFound
+	}
+
+	secret, err := utils.GenerateSecureToken(keyLength)
+
+	if err != nil {
+		return model.APIKeyCreated{}, err
+	}
+
+	plaintext := KeyPrefix + secret
+
+	key := model.APIKey{
+		ID:         "key-" + utils.GenerateRandomID(10),
+		User_ID:    userID,
+		Name:       keyRequest.Name,
+		Scope:      keyRequest.Scope,
+		Prefix:     plaintext[:len(KeyPrefix)+displayLength],
+		Key_Hash:   utils.HashToken(plaintext),
+		Created_At: utils.GetCurrentTime(),
+	}
+
+	err = a.apiKeyRepo.CreateAPIKey(ctx, key)
+
+	if err != nil {
+		return model.APIKeyCreated{}, err
+	}
+
+	return model.APIKeyCreated{APIKey: key, Key: plaintext}, nil
+}
+
+func (a *APIKeyService) DeleteAPIKey(ctx context.Context, userID string, id string) error {
+	err := a.apiKeyRepo.DeleteAPIKey(ctx, userID, id)
+
+	if err != nil {
+		return ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// Authenticate returns the owner of key, limited to the key's scope.
+func (a *APIKeyService) Authenticate(ctx context.Context, key string) (model.AuthUser, error) {
+	if !strings.HasPrefix(key, KeyPrefix) {
+		return model.AuthUser{}, ErrInvalidAPIKey
+	}
+
+	apiKey, err := a.apiKeyRepo.GetAPIKeyByHash(ctx, utils.HashToken(key))
+
+	if err != nil {
+		return model.AuthUser{}, ErrInvalidAPIKey
+	}
+
+	user, err := a.userRepo.GetUser(ctx, apiKey.User_ID)
+
+	if err != nil {
+		return model.AuthUser{}, ErrInvalidAPIKey
+	}
+
+	err = a.apiKeyRepo.UpdateLastUsed(ctx, apiKey.ID, utils.GetCurrentTime())
+
+	if err != nil {
+		return model.AuthUser{}, err
+	}
+
+	return model.AuthUser{ID: user.ID, Role: user.Role, Scope: apiKey.Scope}, nil
+}
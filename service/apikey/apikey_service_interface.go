@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IAPIKeyService interface {
+	GetUserAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error)
+	CreateAPIKey(ctx context.Context, userID string, key model.APIKeyRequest) (model.APIKeyCreated, error)
+	DeleteAPIKey(ctx context.Context, userID string, id string) error
+	Authenticate(ctx context.Context, key string) (model.AuthUser, error)
+}
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mock_apikey "github.com/varomnrg/money-tracker/mocks/repository/apikey"
+	mock_user "github.com/varomnrg/money-tracker/mocks/repository/user"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/apikey"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+func SetupAPIKeyService(t *testing.T) (*gomock.Controller, *service.APIKeyService, *mock_apikey.MockIAPIKeyRepository, *mock_user.MockIUserRepository) {
+	ctrl := gomock.NewController(t)
+	mockAPIKeyRepo := mock_apikey.NewMockIAPIKeyRepository(ctrl)
+	mockUserRepo := mock_user.NewMockIUserRepository(ctrl)
+	apiKeyService := service.NewAPIKeyService(mockAPIKeyRepo, mockUserRepo)
+
+	return ctrl, apiKeyService, mockAPIKeyRepo, mockUserRepo
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	ctrl, apiKeyService, mockAPIKeyRepo, mockUserRepo := SetupAPIKeyService(t)
+	defer ctrl.Finish()
+
+	var stored model.APIKey
+
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("sql: no rows in result set"))
+	mockAPIKeyRepo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key model.APIKey) error {
+		stored = key
+		return nil
+	})
+
+	t.Run("Create API Key", func(t *testing.T) {
+		created, err := apiKeyService.CreateAPIKey(ctx, "user-1", model.APIKeyRequest{Name: "spreadsheet", Scope: model.ScopeRead})
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(created.Key, service.KeyPrefix))
+		assert.True(t, strings.HasPrefix(created.Key, stored.Prefix), "Expected prefix to be the start of the key")
+		assert.Equal(t, utils.HashToken(created.Key), stored.Key_Hash, "Expected only the key hash to be stored")
+		assert.Equal(t, model.ScopeRead, stored.Scope)
+	})
+
+	t.Run("Create API Key with invalid user id", func(t *testing.T) {
+		_, err := apiKeyService.CreateAPIKey(ctx, "invalid_id", model.APIKeyRequest{Name: "spreadsheet", Scope: model.ScopeRead})
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestDeleteAPIKey(t *testing.T) {
+	ctrl, apiKeyService, mockAPIKeyRepo, _ := SetupAPIKeyService(t)
+	defer ctrl.Finish()
+
+	mockAPIKeyRepo.EXPECT().DeleteAPIKey(gomock.Any(), "user-1", "key-1").Return(nil)
+	mockAPIKeyRepo.EXPECT().DeleteAPIKey(gomock.Any(), "user-1", "key-2").Return(errors.New("sql: no rows in result set"))
+
+	t.Run("Delete API Key", func(t *testing.T) {
+		err := apiKeyService.DeleteAPIKey(ctx, "user-1", "key-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete API Key of another user", func(t *testing.T) {
+		err := apiKeyService.DeleteAPIKey(ctx, "user-1", "key-2")
+
+		assert.ErrorIs(t, err, service.ErrAPIKeyNotFound)
+	})
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctrl, apiKeyService, mockAPIKeyRepo, mockUserRepo := SetupAPIKeyService(t)
+	defer ctrl.Finish()
+
+	mockAPIKeyRepo.EXPECT().GetAPIKeyByHash(gomock.Any(), utils.HashToken("mt_valid")).Return(model.APIKey{ID: "key-1", User_ID: "user-1", Scope: model.ScopeRead}, nil)
+	mockAPIKeyRepo.EXPECT().GetAPIKeyByHash(gomock.Any(), utils.HashToken("mt_revoked")).Return(model.APIKey{}, errors.New("sql: no rows in result set"))
+	mockAPIKeyRepo.EXPECT().UpdateLastUsed(gomock.Any(), "key-1", gomock.Any()).Return(nil)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Role: model.RoleUser}, nil)
+
+	t.Run("Authenticate", func(t *testing.T) {
+		user, err := apiKeyService.Authenticate(ctx, "mt_valid")
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.AuthUser{ID: "user-1", Role: model.RoleUser, Scope: model.ScopeRead}, user)
+	})
+
+	t.Run("Authenticate with revoked key", func(t *testing.T) {
+		_, err := apiKeyService.Authenticate(ctx, "mt_revoked")
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPIKey)
+	})
+
+	t.Run("Authenticate with access token", func(t *testing.T) {
+		_, err := apiKeyService.Authenticate(ctx, "eyJhbGciOiJIUzI1NiJ9")
+
+		assert.ErrorIs(t, err, service.ErrInvalidAPIKey)
+	})
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/varomnrg/money-tracker/model"
+	repository "github.com/varomnrg/money-tracker/repository/session"
+	userService "github.com/varomnrg/money-tracker/service/user"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+const (
+	TokenType = "Bearer"
+
+	refreshTokenLength = 32
+	maxUserAgentLength = 255
+)
+
+type AuthService struct {
+	userService     userService.IUserService
+	sessionRepo     repository.ISessionRepository
+	secret          []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+}
+
+type accessClaims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+var (
+	ErrInvalidCredentials = model.NewError(model.CodeUnauthorized, "invalid username or password")
+	ErrInvalidToken       = model.NewError(model.CodeUnauthorized, "token is invalid or expired")
+	ErrRefreshTokenReused = model.NewError(model.CodeUnauthorized, "refresh token was already used, session has been revoked")
+	ErrSessionNotFound    = model.NewError(model.CodeNotFound, "session cannot be found")
+)
+
+func NewAuthService(userService userService.IUserService, sessionRepo repository.ISessionRepository, secret string, accessTokenTTL time.Duration, refreshTokenTTL time.Duration) *AuthService {
+	return &AuthService{
+		userService:     userService,
+		sessionRepo:     sessionRepo,
+		secret:          []byte(secret),
+		accessTokenTTL:  accessTokenTTL,
+		refreshTokenTTL: refreshTokenTTL,
+	}
+}
+
+func (a *AuthService) Login(ctx context.Context, login model.LoginRequest, userAgent string) (model.TokenResponse, error) {
+	user, err := a.userService.VerifyCredentials(ctx, login.Username, login.Password)
+
+	if err != nil {
+		if errors.Is(err, userService.ErrInvalidCredentials) {
+			return model.TokenResponse{}, ErrInvalidCredentials
+		}
+		return model.TokenResponse{}, err
+	}
+
+	sessionID := "sess-" + utils.GenerateRandomID(10)
+
+	return a.issueTokens(ctx, model.AuthUser{ID: user.ID, Role: user.Role}, sessionID, userAgent)
+}
+
+// Refresh exchanges a refresh token for a new access and refresh token pair
+// of the same session. Each refresh token can be exchanged once; presenting
+// it a second time means it leaked, so the whole session is revoked.
+func (a *AuthService) Refresh(ctx context.Context, refreshToken string, userAgent string) (model.TokenResponse, error) {
+	token, err := a.sessionRepo.GetRefreshTokenByHash(ctx, utils.HashToken(refreshToken))
+
+	if err != nil {
+		return model.TokenResponse{}, ErrInvalidToken
+	}
+
+	now := utils.GetCurrentTime()
+
+	if token.Revoked_At != nil || !now.Before(token.Expires_At) {
+		return model.TokenResponse{}, ErrInvalidToken
+	}
+
+	if token.Used_At != nil {
+		return model.TokenResponse{}, a.revokeReusedSession(ctx, token, now)
+	}
+
+	exchanged, err := a.sessionRepo.UseRefreshToken(ctx, token.ID, now)
+
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	// Another request exchanged the token between the lookup and the update.
+	if !exchanged {
+		return model.TokenResponse{}, a.revokeReusedSession(ctx, token, now)
+	}
+
+	user, err := a.userService.GetUser(ctx, token.User_ID)
+
+	if err != nil {
+		return model.TokenResponse{}, ErrInvalidToken
+	}
+
+	return a.issueTokens(ctx, model.AuthUser{ID: user.ID, Role: user.Role}, token.Session_ID, userAgent)
+}
+
+// Logout revokes the session the refresh token belongs to.
+func (a *AuthService) Logout(ctx context.Context, refreshToken string) error {
+	token, err := a.sessionRepo.GetRefreshTokenByHash(ctx, utils.HashToken(refreshToken))
+
+	if err != nil || token.Revoked_At != nil {
+		return ErrInvalidToken
+	}
+
+	return a.RevokeSession(ctx, token.User_ID, token.Session_ID)
+}
+
+// ParseAccessToken verifies the signature and expiry of token and returns the
+// user it was issued to.
+func (a *AuthService) ParseAccessToken(ctx context.Context, token string) (model.AuthUser, error) {
+	claims := accessClaims{}
+
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		return a.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+
+	if err != nil || claims.Subject == "" {
+		return model.AuthUser{}, ErrInvalidToken
+	}
+
+	return model.AuthUser{ID: claims.Subject, Role: claims.Role}, nil
+}
+
+func (a *AuthService) GetSessions(ctx context.Context, userID string) ([]model.Session, error) {
+	return a.sessionRepo.GetUserSessions(ctx, userID, utils.GetCurrentTime())
+}
+
+func (a *AuthService) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	err := a.sessionRepo.RevokeSession(ctx, userID, sessionID, utils.GetCurrentTime())
+
+	if err != nil {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func (a *AuthService) revokeReusedSession(ctx context.Context, token model.RefreshToken, now time.Time) error {
+	err := a.sessionRepo.RevokeSession(ctx, token.User_ID, token.Session_ID, now)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return ErrRefreshTokenReused
+}
+
+func (a *AuthService) issueTokens(ctx context.Context, user model.AuthUser, sessionID string, userAgent string) (model.TokenResponse, error) {
+	accessToken, err := a.issueAccessToken(user)
+
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	refreshToken, err := utils.GenerateSecureToken(refreshTokenLength)
+
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+
+	now := utils.GetCurrentTime()
+
+	err = a.sessionRepo.CreateRefreshToken(ctx, model.RefreshToken{
+		ID:         "rt-" + utils.GenerateRandomID(10),
+		Session_ID: sessionID,
+		User_ID:    user.ID,
+		Token_Hash: utils.HashToken(refreshToken),
+		User_Agent: userAgent,
+		Created_At: now,
+		Expires_At: now.Add(a.refreshTokenTTL),
+	})
+
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	return model.TokenResponse{
+		Access_Token:  accessToken,
+		Token_Type:    TokenType,
+		Expires_In:    int64(a.accessTokenTTL.Seconds()),
+		Refresh_Token: refreshToken,
+	}, nil
+}
+
+func (a *AuthService) issueAccessToken(user model.AuthUser) (string, error) {
+	now := utils.GetCurrentTime()
+
+	claims := accessClaims{
+		Role: user.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "jwt-" + utils.GenerateRandomID(10),
+			Subject:   user.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.accessTokenTTL)),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(a.secret)
+}
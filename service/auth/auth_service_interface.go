@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IAuthService interface {
+	Login(ctx context.Context, login model.LoginRequest, userAgent string) (model.TokenResponse, error)
+	Refresh(ctx context.Context, refreshToken string, userAgent string) (model.TokenResponse, error)
+	Logout(ctx context.Context, refreshToken string) error
+	ParseAccessToken(ctx context.Context, token string) (model.AuthUser, error)
+	GetSessions(ctx context.Context, userID string) ([]model.Session, error)
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+}
@@ -0,0 +1,215 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	mock_repository "github.com/varomnrg/money-tracker/mocks/repository/session"
+	mock_service "github.com/varomnrg/money-tracker/mocks/service/user"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/auth"
+	userService "github.com/varomnrg/money-tracker/service/user"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+const secret = "test-secret"
+
+func SetupAuthService(t *testing.T) (*gomock.Controller, *service.AuthService, *mock_service.MockIUserService, *mock_repository.MockISessionRepository) {
+	ctrl := gomock.NewController(t)
+	mockUserService := mock_service.NewMockIUserService(ctrl)
+	mockSessionRepo := mock_repository.NewMockISessionRepository(ctrl)
+	authService := service.NewAuthService(mockUserService, mockSessionRepo, secret, 15*time.Minute, 24*time.Hour)
+
+	return ctrl, authService, mockUserService, mockSessionRepo
+}
+
+func TestLogin(t *testing.T) {
+	ctrl, authService, mockUserService, mockSessionRepo := SetupAuthService(t)
+	defer ctrl.Finish()
+
+	var stored model.RefreshToken
+
+	mockSessionRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token model.RefreshToken) error {
+		stored = token
+		return nil
+	})
+	mockUserService.EXPECT().VerifyCredentials(gomock.Any(), "user1", "password123").Return(model.UserResponse{ID: "user-1", Username: "user1", Role: model.RoleAdmin}, nil)
+	mockUserService.EXPECT().VerifyCredentials(gomock.Any(), "user1", "wrong").Return(model.UserResponse{}, userService.ErrInvalidCredentials)
+
+	t.Run("Login", func(t *testing.T) {
+		token, err := authService.Login(ctx, model.LoginRequest{Username: "user1", Password: "password123"}, "test-agent")
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.TokenType, token.Token_Type)
+		assert.Equal(t, int64(900), token.Expires_In)
+
+		user, err := authService.ParseAccessToken(ctx, token.Access_Token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.AuthUser{ID: "user-1", Role: model.RoleAdmin}, user)
+
+		assert.NotEmpty(t, token.Refresh_Token)
+		assert.Equal(t, utils.HashToken(token.Refresh_Token), stored.Token_Hash, "Expected only the refresh token hash to be stored")
+		assert.Equal(t, "user-1", stored.User_ID)
+		assert.NotEmpty(t, stored.Session_ID)
+	})
+
+	t.Run("Login with wrong password", func(t *testing.T) {
+		_, err := authService.Login(ctx, model.LoginRequest{Username: "user1", Password: "wrong"}, "test-agent")
+
+		assert.ErrorIs(t, err, service.ErrInvalidCredentials)
+	})
+}
+
+func TestParseAccessToken(t *testing.T) {
+	ctrl, authService, _, _ := SetupAuthService(t)
+	defer ctrl.Finish()
+
+	sign := func(method jwt.SigningMethod, key interface{}, claims jwt.RegisteredClaims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	valid := jwt.RegisteredClaims{Subject: "user-1", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))}
+	expired := jwt.RegisteredClaims{Subject: "user-1", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))}
+	noExpiry := jwt.RegisteredClaims{Subject: "user-1"}
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"Valid token", sign(jwt.SigningMethodHS256, []byte(secret), valid), nil},
+		{"Expired token", sign(jwt.SigningMethodHS256, []byte(secret), expired), service.ErrInvalidToken},
+		{"Token without expiry", sign(jwt.SigningMethodHS256, []byte(secret), noExpiry), service.ErrInvalidToken},
+		{"Token signed with another secret", sign(jwt.SigningMethodHS256, []byte("other"), valid), service.ErrInvalidToken},
+		{"Unsigned token", sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid), service.ErrInvalidToken},
+		{"Malformed token", "not-a-token", service.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := authService.ParseAccessToken(ctx, tt.token)
+
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "user-1", user.ID)
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	ctrl, authService, mockUserService, mockSessionRepo := SetupAuthService(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	usedAt := now.Add(-time.Minute)
+
+	newToken := func(id string, mutate func(*model.RefreshToken)) model.RefreshToken {
+		token := model.RefreshToken{ID: id, Session_ID: "sess-1", User_ID: "user-1", Created_At: now.Add(-time.Hour), Expires_At: now.Add(time.Hour)}
+		if mutate != nil {
+			mutate(&token)
+		}
+		return token
+	}
+
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("fresh")).Return(newToken("rt-1", nil), nil)
+	mockSessionRepo.EXPECT().UseRefreshToken(gomock.Any(), "rt-1", gomock.Any()).Return(true, nil)
+	mockUserService.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Role: model.RoleUser}, nil)
+	mockSessionRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, token model.RefreshToken) error {
+		assert.Equal(t, "sess-1", token.Session_ID, "Expected rotated token to stay in the same session")
+		return nil
+	})
+
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("used")).Return(newToken("rt-2", func(token *model.RefreshToken) { token.Used_At = &usedAt }), nil)
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("raced")).Return(newToken("rt-3", nil), nil)
+	mockSessionRepo.EXPECT().UseRefreshToken(gomock.Any(), "rt-3", gomock.Any()).Return(false, nil)
+	mockSessionRepo.EXPECT().RevokeSession(gomock.Any(), "user-1", "sess-1", gomock.Any()).Return(nil).Times(2)
+
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("expired")).Return(newToken("rt-4", func(token *model.RefreshToken) { token.Expires_At = usedAt }), nil)
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("revoked")).Return(newToken("rt-5", func(token *model.RefreshToken) { token.Revoked_At = &usedAt }), nil)
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("unknown")).Return(model.RefreshToken{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Refresh", func(t *testing.T) {
+		token, err := authService.Refresh(ctx, "fresh", "test-agent")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token.Access_Token)
+		assert.NotEqual(t, "fresh", token.Refresh_Token, "Expected refresh token to be rotated")
+	})
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"Refresh with used token", "used", service.ErrRefreshTokenReused},
+		{"Refresh with token used concurrently", "raced", service.ErrRefreshTokenReused},
+		{"Refresh with expired token", "expired", service.ErrInvalidToken},
+		{"Refresh with revoked token", "revoked", service.ErrInvalidToken},
+		{"Refresh with unknown token", "unknown", service.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authService.Refresh(ctx, tt.token, "test-agent")
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ctrl, authService, _, mockSessionRepo := SetupAuthService(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("token")).Return(model.RefreshToken{ID: "rt-1", Session_ID: "sess-1", User_ID: "user-1"}, nil)
+	mockSessionRepo.EXPECT().RevokeSession(gomock.Any(), "user-1", "sess-1", gomock.Any()).Return(nil)
+	mockSessionRepo.EXPECT().GetRefreshTokenByHash(gomock.Any(), utils.HashToken("unknown")).Return(model.RefreshToken{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Logout", func(t *testing.T) {
+		err := authService.Logout(ctx, "token")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Logout with unknown token", func(t *testing.T) {
+		err := authService.Logout(ctx, "unknown")
+
+		assert.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	ctrl, authService, _, mockSessionRepo := SetupAuthService(t)
+	defer ctrl.Finish()
+
+	mockSessionRepo.EXPECT().RevokeSession(gomock.Any(), "user-1", "sess-1", gomock.Any()).Return(nil)
+	mockSessionRepo.EXPECT().RevokeSession(gomock.Any(), "user-1", "sess-2", gomock.Any()).Return(errors.New("sql: no rows in result set"))
+
+	t.Run("Revoke Session", func(t *testing.T) {
+		err := authService.RevokeSession(ctx, "user-1", "sess-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Revoke Session of another user", func(t *testing.T) {
+		err := authService.RevokeSession(ctx, "user-1", "sess-2")
+
+		assert.ErrorIs(t, err, service.ErrSessionNotFound)
+	})
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	budgetRepo "github.com/varomnrg/money-tracker/repository/budget"
+	catRepo "github.com/varomnrg/money-tracker/repository/category"
+	trxRepo "github.com/varomnrg/money-tracker/repository/transaction"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	userRepo "github.com/varomnrg/money-tracker/repository/user"
+	notificationService "github.com/varomnrg/money-tracker/service/notification"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type BudgetService struct {
+	budgetRepo      budgetRepo.IBudgetRepository
+	categoryRepo    catRepo.ICategoryRepository
+	transactionRepo trxRepo.ITransactionRepository
+	userRepo        userRepo.IUserRepository
+	converter       rateService.IConverter
+	notifier        notificationService.INotifier
+	uow             unitofwork.IUnitOfWork
+}
+
+// Categories are looked up from the request body, so their absence is a
+// validation error rather than a missing resource.
+var (
+	ErrUserNotFound       = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrBudgetNotFound     = model.NewError(model.CodeNotFound, "budget cannot be found")
+	ErrCategoryNotFound   = model.NewError(model.CodeValidation, "category cannot be found")
+	ErrBudgetAlreadyExist = model.NewError(model.CodeConflict, "category already has a budget for this month")
+	ErrInvalidAmount      = model.NewError(model.CodeValidation, "amount must be greater than zero")
+	ErrInvalidMonth       = model.NewError(model.CodeValidation, "month must be in YYYY-MM format")
+	ErrRateNotFound       = model.NewError(model.CodeValidation, "exchange rate cannot be found")
+)
+
+const monthLayout = "2006-01"
+
+// alertThresholds are the percentages of a budget whose reaching is
+// notified, highest first.
+var alertThresholds = []int64{100, 80}
+
+func NewBudgetService(
+	budgetRepo budgetRepo.IBudgetRepository,
+	categoryRepo catRepo.ICategoryRepository,
+	transactionRepo trxRepo.ITransactionRepository,
+	userRepo userRepo.IUserRepository,
+	converter rateService.IConverter,
+	notifier notificationService.INotifier,
+	uow unitofwork.IUnitOfWork,
+) *BudgetService {
+	return &BudgetService{
+		budgetRepo:      budgetRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		userRepo:        userRepo,
+		converter:       converter,
+		notifier:        notifier,
+		uow:             uow,
+	}
+}
+
+func (s *BudgetService) GetUserBudgets(ctx context.Context, userID string, month string) ([]model.BudgetProgress, error) {
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return []model.BudgetProgress{}, ErrUserNotFound
+	}
+
+	if _, _, err := monthBounds(month); err != nil {
+		return []model.BudgetProgress{}, err
+	}
+
+	budgets, err := s.budgetRepo.GetUserBudgets(ctx, userID, month)
+	if err != nil {
+		return []model.BudgetProgress{}, err
+	}
+
+	progress := make([]model.BudgetProgress, 0, len(budgets))
+	spending := spending{}
+
+	for _, budget := range budgets {
+		p, err := s.measure(ctx, budget, spending)
+		if err != nil {
+			return []model.BudgetProgress{}, err
+		}
+
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+func (s *BudgetService) GetBudget(ctx context.Context, id string) (model.BudgetProgress, error) {
+	budget, err := s.FindBudget(ctx, id)
+
+	if err != nil {
+		return model.BudgetProgress{}, err
+	}
+
+	return s.measure(ctx, budget, spending{})
+}
+
+func (s *BudgetService) FindBudget(ctx context.Context, id string) (model.Budget, error) {
+	budget, err := s.budgetRepo.GetBudget(ctx, id)
+
+	if err != nil {
+		return model.Budget{}, ErrBudgetNotFound
+	}
+
+	return budget, nil
+}
+
+func (s *BudgetService) CreateBudget(ctx context.Context, userID string, budget model.BudgetRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.userRepo.GetUser(ctx, userID)
+
+		if err != nil {
+			return ErrUserNotFound
+		}
+
+		amount, err := s.checkRequest(ctx, userID, "", budget)
+		if err != nil {
+			return err
+		}
+
+		newBudget := model.Budget{
+			ID:          "budget-" + utils.GenerateRandomID(10),
+			User_ID:     userID,
+			Category_ID: budget.Category_ID,
+			Month:       budget.Month,
+			Amount:      amount,
+			Rollover:    budget.Rollover,
+			Created_At:  utils.GetCurrentTime(),
+		}
+
+		return s.budgetRepo.CreateBudget(ctx, newBudget)
+	})
+}
+
+func (s *BudgetService) UpdateBudget(ctx context.Context, id string, budget model.BudgetRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.budgetRepo.GetBudget(ctx, id)
+
+		if err != nil {
+			return ErrBudgetNotFound
+		}
+
+		amount, err := s.checkRequest(ctx, existing.User_ID, id, budget)
+		if err != nil {
+			return err
+		}
+
+		existing.Category_ID = budget.Category_ID
+		existing.Month = budget.Month
+		existing.Amount = amount
+		existing.Rollover = budget.Rollover
+
+		return s.budgetRepo.UpdateBudget(ctx, id, existing)
+	})
+}
+
+func (s *BudgetService) DeleteBudget(ctx context.Context, id string) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.budgetRepo.GetBudget(ctx, id)
+
+		if err != nil {
+			return ErrBudgetNotFound
+		}
+
+		return s.budgetRepo.DeleteBudget(ctx, id)
+	})
+}
+
+// CheckBudget notifies only the highest threshold reached, and each
+// threshold of a budget at most once, so spending that jumps past both alerts
+// once.
+func (s *BudgetService) CheckBudget(ctx context.Context, userID string, categoryID string, date time.Time) error {
+	month := date.In(utils.GetCurrentTime().Location()).Format(monthLayout)
+
+	budget, err := s.budgetRepo.GetCategoryBudget(ctx, categoryID, month)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	progress, err := s.progress(ctx, budget, spending{})
+	if err != nil {
+		return err
+	}
+
+	for _, threshold := range alertThresholds {
+		// Compared in minor units, as the rounded percentage could reach a
+		// threshold the spending has not.
+		if progress.Spent.Amount*100 < progress.Available.Amount*threshold {
+			continue
+		}
+
+		category, err := s.categoryRepo.GetCategory(ctx, categoryID)
+		if err != nil {
+			return err
+		}
+
+		return s.notifier.Notify(ctx, alert(progress, category.Name, threshold))
+	}
+
+	return nil
+}
+
+func alert(progress model.BudgetProgress, category string, threshold int64) model.Notification {
+	title := fmt.Sprintf("%s budget for %s has reached %d%%", category, progress.Month, threshold)
+	if threshold >= 100 {
+		title = fmt.Sprintf("%s budget for %s is used up", category, progress.Month)
+	}
+
+	return model.Notification{
+		User_ID: progress.User_ID,
+		Kind:    model.NotificationBudgetThreshold,
+		Key:     fmt.Sprintf("budget:%s:%d", progress.ID, threshold),
+		Title:   title,
+		Message: fmt.Sprintf(
+			"You have spent %s of the %s %s available for %s in %s (%g%%).",
+			progress.Spent.Decimal(), progress.Available.Decimal(), progress.Available.Currency, category, progress.Month, progress.Percentage,
+		),
+	}
+}
+
+// checkRequest validates budget for the user's budget with the given id, or
+// for a new budget when id is empty, and returns its amount with the
+// currency defaulted.
+func (s *BudgetService) checkRequest(ctx context.Context, userID string, id string, budget model.BudgetRequest) (model.Money, error) {
+	if _, _, err := monthBounds(budget.Month); err != nil {
+		return model.Money{}, err
+	}
+
+	if !budget.Amount.IsPositive() {
+		return model.Money{}, ErrInvalidAmount
+	}
+
+	category, err := s.categoryRepo.GetCategory(ctx, budget.Category_ID)
+	if err != nil || category.User_ID != userID {
+		return model.Money{}, ErrCategoryNotFound
+	}
+
+	other, err := s.budgetRepo.GetCategoryBudget(ctx, budget.Category_ID, budget.Month)
+	if err == nil && other.ID != id {
+		return model.Money{}, ErrBudgetAlreadyExist
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return model.Money{}, err
+	}
+
+	amount := budget.Amount
+	if amount.Currency == "" {
+		amount.Currency = model.DefaultCurrency
+	}
+
+	return amount, nil
+}
+
+// spending caches the category totals of each month looked at while
+// computing progress, keyed by user and month.
+type spending map[[2]string][]model.CategoryTotal
+
+// measure returns the progress of budget, or budget with its progress marked
+// unavailable when an exchange rate it needs is missing, so one budget does
+// not fail a whole listing.
+func (s *BudgetService) measure(ctx context.Context, budget model.Budget, spending spending) (model.BudgetProgress, error) {
+	progress, err := s.progress(ctx, budget, spending)
+	if !errors.Is(err, ErrRateNotFound) {
+		return progress, err
+	}
+
+	zero := model.NewMoney(0, budget.Amount.Currency)
+
+	return model.BudgetProgress{
+		Budget:       budget,
+		Carried_Over: zero,
+		Available:    zero,
+		Spent:        zero,
+		Remaining:    zero,
+		Unavailable:  ErrRateNotFound.Error(),
+	}, nil
+}
+
+// progress measures budget against the expenses in its category during its
+// month. With Rollover set, what remains of the previous month's budget for
+// the category, computed the same way, is carried over; an overspent month
+// carries nothing.
+func (s *BudgetService) progress(ctx context.Context, budget model.Budget, spending spending) (model.BudgetProgress, error) {
+	currency := budget.Amount.Currency
+	progress := model.BudgetProgress{Budget: budget, Carried_Over: model.NewMoney(0, currency), Spent: model.NewMoney(0, currency)}
+
+	start, end, err := monthBounds(budget.Month)
+	if err != nil {
+		return model.BudgetProgress{}, err
+	}
+
+	// Amounts are converted at the rate in effect on the last day of the
+	// month.
+	rateDate := end.AddDate(0, 0, -1)
+
+	if budget.Rollover {
+		previous, err := s.budgetRepo.GetCategoryBudget(ctx, budget.Category_ID, start.AddDate(0, -1, 0).Format(monthLayout))
+
+		switch {
+		case err == nil:
+			p, err := s.progress(ctx, previous, spending)
+			if err != nil {
+				return model.BudgetProgress{}, err
+			}
+
+			if p.Remaining.IsPositive() {
+				progress.Carried_Over, err = s.converter.Convert(ctx, p.Remaining, currency, rateDate)
+				if errors.Is(err, rateService.ErrRateNotFound) {
+					return model.BudgetProgress{}, ErrRateNotFound
+				}
+				if err != nil {
+					return model.BudgetProgress{}, err
+				}
+			}
+		case !errors.Is(err, sql.ErrNoRows):
+			return model.BudgetProgress{}, err
+		}
+	}
+
+	key := [2]string{budget.User_ID, budget.Month}
+
+	totals, ok := spending[key]
+	if !ok {
+		totals, err = s.transactionRepo.GetCategoryTotals(ctx, budget.User_ID, start, end)
+		if err != nil {
+			return model.BudgetProgress{}, err
+		}
+
+		spending[key] = totals
+	}
+
+	for _, total := range totals {
+		if total.Category_ID != budget.Category_ID || total.Type != model.TransactionTypeExpense {
+			continue
+		}
+
+		spent, err := s.converter.Convert(ctx, total.Total, currency, rateDate)
+		if errors.Is(err, rateService.ErrRateNotFound) {
+			return model.BudgetProgress{}, ErrRateNotFound
+		}
+		if err != nil {
+			return model.BudgetProgress{}, err
+		}
+
+		progress.Spent, _ = progress.Spent.Add(spent)
+	}
+
+	// Every amount is in the budget's currency by now, so these cannot fail.
+	progress.Available, _ = budget.Amount.Add(progress.Carried_Over)
+	progress.Remaining, _ = progress.Available.Sub(progress.Spent)
+	progress.Percentage = math.Round(float64(progress.Spent.Amount)/float64(progress.Available.Amount)*10000) / 100
+
+	return progress, nil
+}
+
+// monthBounds returns the start of month, given as YYYY-MM, and of the month
+// after it in the local time zone.
+func monthBounds(month string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(monthLayout, month, utils.GetCurrentTime().Location())
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidMonth
+	}
+
+	return start, start.AddDate(0, 1, 0), nil
+}
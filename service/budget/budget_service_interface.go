@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// IBudgetChecker evaluates budgets as the transactions they cover change.
+type IBudgetChecker interface {
+	// CheckBudget notifies the user when spending has reached an alert
+	// threshold of the category's budget for the month date falls in.
+	CheckBudget(ctx context.Context, userID string, categoryID string, date time.Time) error
+}
+
+type IBudgetService interface {
+	IBudgetChecker
+	GetUserBudgets(ctx context.Context, userID string, month string) ([]model.BudgetProgress, error)
+	GetBudget(ctx context.Context, id string) (model.BudgetProgress, error)
+	// FindBudget returns the budget with the given id without measuring its
+	// progress.
+	FindBudget(ctx context.Context, id string) (model.Budget, error)
+	CreateBudget(ctx context.Context, userID string, budget model.BudgetRequest) error
+	UpdateBudget(ctx context.Context, id string, budget model.BudgetRequest) error
+	DeleteBudget(ctx context.Context, id string) error
+}
@@ -0,0 +1,366 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mockBudgetRepo "github.com/varomnrg/money-tracker/mocks/repository/budget"
+	mockCatRepo "github.com/varomnrg/money-tracker/mocks/repository/category"
+	mockTrxRepo "github.com/varomnrg/money-tracker/mocks/repository/transaction"
+	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
+	mockNotificationService "github.com/varomnrg/money-tracker/mocks/service/notification"
+	mockRateService "github.com/varomnrg/money-tracker/mocks/service/rate"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	service "github.com/varomnrg/money-tracker/service/budget"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+type budgetMocks struct {
+	budgetRepo *mockBudgetRepo.MockIBudgetRepository
+	catRepo    *mockCatRepo.MockICategoryRepository
+	trxRepo    *mockTrxRepo.MockITransactionRepository
+	userRepo   *mockUserRepo.MockIUserRepository
+	converter  *mockRateService.MockIConverter
+	notifier   *mockNotificationService.MockINotifier
+}
+
+func SetupBudgetService(t *testing.T) (*gomock.Controller, *service.BudgetService, budgetMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := budgetMocks{
+		budgetRepo: mockBudgetRepo.NewMockIBudgetRepository(ctrl),
+		catRepo:    mockCatRepo.NewMockICategoryRepository(ctrl),
+		trxRepo:    mockTrxRepo.NewMockITransactionRepository(ctrl),
+		userRepo:   mockUserRepo.NewMockIUserRepository(ctrl),
+		converter:  mockRateService.NewMockIConverter(ctrl),
+		notifier:   mockNotificationService.NewMockINotifier(ctrl),
+	}
+	budgetService := service.NewBudgetService(mocks.budgetRepo, mocks.catRepo, mocks.trxRepo, mocks.userRepo, mocks.converter, mocks.notifier, unitofwork.NewNopUnitOfWork())
+
+	return ctrl, budgetService, mocks
+}
+
+// month returns the local start of the given month of 2024.
+func month(m time.Month) time.Time {
+	return time.Date(2024, m, 1, 0, 0, 0, 0, utils.GetCurrentTime().Location())
+}
+
+// sameCurrency expects conversions that keep the currency.
+func sameCurrency(mocks budgetMocks) {
+	mocks.converter.EXPECT().Convert(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, amount model.Money, _ string, _ time.Time) (model.Money, error) {
+			return amount, nil
+		},
+	).AnyTimes()
+}
+
+func TestGetBudget(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+	sameCurrency(mocks)
+
+	food := model.Budget{ID: "budget-1", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(100000, "IDR")}
+
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "budget-1").Return(food, nil)
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "invalid_id").Return(model.Budget{}, sql.ErrNoRows)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", month(time.February), month(time.March)).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Type: model.TransactionTypeExpense, Total: model.NewMoney(25000, "IDR")},
+		{Category_ID: "cat-food", Type: model.TransactionTypeIncome, Total: model.NewMoney(90000, "IDR")},
+		{Category_ID: "cat-rent", Type: model.TransactionTypeExpense, Total: model.NewMoney(500000, "IDR")},
+	}, nil)
+
+	t.Run("Get Budget", func(t *testing.T) {
+		progress, err := budgetService.GetBudget(ctx, "budget-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, model.NewMoney(0, "IDR"), progress.Carried_Over)
+		assert.Equal(t, model.NewMoney(100000, "IDR"), progress.Available)
+		assert.Equal(t, model.NewMoney(25000, "IDR"), progress.Spent, "Expected only the category's expenses to count")
+		assert.Equal(t, model.NewMoney(75000, "IDR"), progress.Remaining)
+		assert.Equal(t, 25.0, progress.Percentage)
+	})
+
+	t.Run("Get Budget with invalid id", func(t *testing.T) {
+		_, err := budgetService.GetBudget(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrBudgetNotFound)
+	})
+}
+
+func TestGetBudget_Rollover(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+	sameCurrency(mocks)
+
+	january := model.Budget{ID: "budget-jan", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-01", Amount: model.NewMoney(100000, "IDR")}
+	february := model.Budget{ID: "budget-feb", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(100000, "IDR"), Rollover: true}
+	march := model.Budget{ID: "budget-mar", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-03", Amount: model.NewMoney(100000, "IDR"), Rollover: true}
+
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "budget-mar").Return(march, nil)
+	mocks.budgetRepo.EXPECT().GetCategoryBudget(gomock.Any(), "cat-food", "2024-02").Return(february, nil)
+	mocks.budgetRepo.EXPECT().GetCategoryBudget(gomock.Any(), "cat-food", "2024-01").Return(january, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", month(time.January), month(time.February)).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Type: model.TransactionTypeExpense, Total: model.NewMoney(40000, "IDR")},
+	}, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", month(time.February), month(time.March)).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Type: model.TransactionTypeExpense, Total: model.NewMoney(130000, "IDR")},
+	}, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", month(time.March), month(time.April)).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Type: model.TransactionTypeExpense, Total: model.NewMoney(150000, "IDR")},
+	}, nil)
+
+	progress, err := budgetService.GetBudget(ctx, "budget-mar")
+
+	require.NoError(t, err)
+	assert.Equal(t, model.NewMoney(30000, "IDR"), progress.Carried_Over, "Expected January's 60000 to roll into February and 30000 of it to be left")
+	assert.Equal(t, model.NewMoney(130000, "IDR"), progress.Available)
+	assert.Equal(t, model.NewMoney(-20000, "IDR"), progress.Remaining)
+	assert.Equal(t, 115.38, progress.Percentage)
+}
+
+func TestGetUserBudgets(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+	sameCurrency(mocks)
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil).AnyTimes()
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, sql.ErrNoRows)
+	mocks.budgetRepo.EXPECT().GetUserBudgets(gomock.Any(), "user-1", "2024-02").Return([]model.Budget{
+		{ID: "budget-1", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(100000, "IDR")},
+		{ID: "budget-2", User_ID: "user-1", Category_ID: "cat-rent", Month: "2024-02", Amount: model.NewMoney(500000, "IDR")},
+	}, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", month(time.February), month(time.March)).Return([]model.CategoryTotal{
+		{Category_ID: "cat-rent", Type: model.TransactionTypeExpense, Total: model.NewMoney(500000, "IDR")},
+	}, nil).Times(1)
+
+	t.Run("Get User Budgets", func(t *testing.T) {
+		budgets, err := budgetService.GetUserBudgets(ctx, "user-1", "2024-02")
+
+		require.NoError(t, err)
+		require.Len(t, budgets, 2)
+		assert.Equal(t, 0.0, budgets[0].Percentage)
+		assert.Equal(t, 100.0, budgets[1].Percentage)
+	})
+
+	t.Run("Get User Budgets with invalid month", func(t *testing.T) {
+		_, err := budgetService.GetUserBudgets(ctx, "user-1", "February")
+
+		assert.ErrorIs(t, err, service.ErrInvalidMonth)
+	})
+
+	t.Run("Get User Budgets with invalid user id", func(t *testing.T) {
+		_, err := budgetService.GetUserBudgets(ctx, "invalid_id", "2024-02")
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestCreateBudget(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+
+	request := model.BudgetRequest{Category_ID: "cat-food", Month: "2024-02", Amount: model.Money{Amount: 100000}}
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil).AnyTimes()
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, sql.ErrNoRows)
+	mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-food").Return(model.Category{ID: "cat-food", User_ID: "user-1"}, nil).AnyTimes()
+	mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-other").Return(model.Category{ID: "cat-other", User_ID: "user-2"}, nil)
+	mocks.budgetRepo.EXPECT().GetCategoryBudget(gomock.Any(), "cat-food", "2024-02").Return(model.Budget{}, sql.ErrNoRows)
+	mocks.budgetRepo.EXPECT().GetCategoryBudget(gomock.Any(), "cat-food", "2024-03").Return(model.Budget{ID: "budget-1"}, nil)
+	mocks.budgetRepo.EXPECT().CreateBudget(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, budget model.Budget) error {
+		assert.Equal(t, "user-1", budget.User_ID)
+		assert.Equal(t, model.NewMoney(100000, model.DefaultCurrency), budget.Amount)
+		return nil
+	})
+
+	t.Run("Create Budget", func(t *testing.T) {
+		assert.NoError(t, budgetService.CreateBudget(ctx, "user-1", request))
+	})
+
+	tests := []struct {
+		name   string
+		userID string
+		change func(*model.BudgetRequest)
+		err    error
+	}{
+		{"invalid user id", "invalid_id", func(*model.BudgetRequest) {}, service.ErrUserNotFound},
+		{"invalid month", "user-1", func(r *model.BudgetRequest) { r.Month = "2024-13" }, service.ErrInvalidMonth},
+		{"zero amount", "user-1", func(r *model.BudgetRequest) { r.Amount = model.Money{} }, service.ErrInvalidAmount},
+		{"another user's category", "user-1", func(r *model.BudgetRequest) { r.Category_ID = "cat-other" }, service.ErrCategoryNotFound},
+		{"existing budget", "user-1", func(r *model.BudgetRequest) { r.Month = "2024-03" }, service.ErrBudgetAlreadyExist},
+	}
+
+	for _, tt := range tests {
+		t.Run("Create Budget with "+tt.name, func(t *testing.T) {
+			budget := request
+			tt.change(&budget)
+
+			assert.ErrorIs(t, budgetService.CreateBudget(ctx, tt.userID, budget), tt.err)
+		})
+	}
+}
+
+func TestUpdateBudget(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+
+	existing := model.Budget{ID: "budget-1", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(100000, "IDR")}
+	request := model.BudgetRequest{Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(5000, "USD"), Rollover: true}
+
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "budget-1").Return(existing, nil)
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "invalid_id").Return(model.Budget{}, sql.ErrNoRows)
+	mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-food").Return(model.Category{ID: "cat-food", User_ID: "user-1"}, nil)
+	mocks.budgetRepo.EXPECT().GetCategoryBudget(gomock.Any(), "cat-food", "2024-02").Return(existing, nil)
+	mocks.budgetRepo.EXPECT().UpdateBudget(gomock.Any(), "budget-1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, budget model.Budget) error {
+		assert.Equal(t, model.NewMoney(5000, "USD"), budget.Amount)
+		assert.True(t, budget.Rollover)
+		return nil
+	})
+
+	t.Run("Update Budget", func(t *testing.T) {
+		assert.NoError(t, budgetService.UpdateBudget(ctx, "budget-1", request), "Expected keeping the month not to conflict with itself")
+	})
+
+	t.Run("Update Budget with invalid id", func(t *testing.T) {
+		assert.ErrorIs(t, budgetService.UpdateBudget(ctx, "invalid_id", request), service.ErrBudgetNotFound)
+	})
+}
+
+func TestDeleteBudget(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "budget-1").Return(model.Budget{ID: "budget-1"}, nil)
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "invalid_id").Return(model.Budget{}, sql.ErrNoRows)
+	mocks.budgetRepo.EXPECT().DeleteBudget(gomock.Any(), "budget-1").Return(nil)
+
+	t.Run("Delete Budget", func(t *testing.T) {
+		assert.NoError(t, budgetService.DeleteBudget(ctx, "budget-1"))
+	})
+
+	t.Run("Delete Budget with invalid id", func(t *testing.T) {
+		assert.ErrorIs(t, budgetService.DeleteBudget(ctx, "invalid_id"), service.ErrBudgetNotFound)
+	})
+}
+
+func TestGetBudget_WithoutRate(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "budget-1").Return(model.Budget{ID: "budget-1", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(100000, "IDR")}, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Type: model.TransactionTypeExpense, Total: model.NewMoney(1000, "USD")},
+	}, nil)
+	mocks.converter.EXPECT().Convert(gomock.Any(), gomock.Any(), "IDR", gomock.Any()).Return(model.Money{}, rateService.ErrRateNotFound)
+
+	budget, err := budgetService.GetBudget(ctx, "budget-1")
+
+	require.NoError(t, err, "Expected a missing rate to leave the budget readable")
+	assert.Equal(t, "budget-1", budget.ID)
+	assert.Equal(t, service.ErrRateNotFound.Error(), budget.Unavailable)
+	assert.Equal(t, model.NewMoney(0, "IDR"), budget.Spent)
+}
+
+func TestGetUserBudgets_WithoutRate(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mocks.budgetRepo.EXPECT().GetUserBudgets(gomock.Any(), "user-1", "2024-02").Return([]model.Budget{
+		{ID: "budget-1", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(100000, "IDR")},
+		{ID: "budget-2", User_ID: "user-1", Category_ID: "cat-rent", Month: "2024-02", Amount: model.NewMoney(500000, "IDR")},
+	}, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Type: model.TransactionTypeExpense, Total: model.NewMoney(1000, "USD")},
+		{Category_ID: "cat-rent", Type: model.TransactionTypeExpense, Total: model.NewMoney(250000, "IDR")},
+	}, nil)
+	mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(1000, "USD"), "IDR", gomock.Any()).Return(model.Money{}, rateService.ErrRateNotFound)
+	mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(250000, "IDR"), "IDR", gomock.Any()).Return(model.NewMoney(250000, "IDR"), nil)
+
+	budgets, err := budgetService.GetUserBudgets(ctx, "user-1", "2024-02")
+
+	require.NoError(t, err, "Expected a missing rate not to fail the listing")
+	require.Len(t, budgets, 2)
+	assert.Equal(t, service.ErrRateNotFound.Error(), budgets[0].Unavailable)
+	assert.Empty(t, budgets[1].Unavailable)
+	assert.Equal(t, 50.0, budgets[1].Percentage)
+}
+
+func TestFindBudget(t *testing.T) {
+	ctrl, budgetService, mocks := SetupBudgetService(t)
+	defer ctrl.Finish()
+
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "budget-1").Return(model.Budget{ID: "budget-1", User_ID: "user-1"}, nil)
+	mocks.budgetRepo.EXPECT().GetBudget(gomock.Any(), "invalid_id").Return(model.Budget{}, sql.ErrNoRows)
+
+	t.Run("Find Budget", func(t *testing.T) {
+		budget, err := budgetService.FindBudget(ctx, "budget-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", budget.User_ID)
+	})
+
+	t.Run("Find Budget with invalid id", func(t *testing.T) {
+		_, err := budgetService.FindBudget(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrBudgetNotFound)
+	})
+}
+
+func TestCheckBudget(t *testing.T) {
+	date := month(time.February).AddDate(0, 0, 9)
+	food := model.Budget{ID: "budget-1", User_ID: "user-1", Category_ID: "cat-food", Month: "2024-02", Amount: model.NewMoney(100000, "IDR")}
+
+	tests := []struct {
+		name  string
+		spent int64
+		key   string
+	}{
+		{"below every threshold", 79999, ""},
+		{"at 80%", 85000, "budget:budget-1:80"},
+		{"past 100%", 120000, "budget:budget-1:100"},
+	}
+
+	for _, tt := range tests {
+		t.Run("Check Budget "+tt.name, func(t *testing.T) {
+			ctrl, budgetService, mocks := SetupBudgetService(t)
+			defer ctrl.Finish()
+			sameCurrency(mocks)
+
+			mocks.budgetRepo.EXPECT().GetCategoryBudget(gomock.Any(), "cat-food", "2024-02").Return(food, nil)
+			mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", month(time.February), month(time.March)).Return([]model.CategoryTotal{
+				{Category_ID: "cat-food", Type: model.TransactionTypeExpense, Total: model.NewMoney(tt.spent, "IDR")},
+			}, nil)
+
+			if tt.key != "" {
+				mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-food").Return(model.Category{ID: "cat-food", Name: "Food", User_ID: "user-1"}, nil)
+				mocks.notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, notification model.Notification) error {
+					assert.Equal(t, "user-1", notification.User_ID)
+					assert.Equal(t, model.NotificationBudgetThreshold, notification.Kind)
+					assert.Equal(t, tt.key, notification.Key, "Expected only the highest threshold reached to be notified")
+					assert.Contains(t, notification.Title, "Food")
+					return nil
+				})
+			}
+
+			assert.NoError(t, budgetService.CheckBudget(ctx, "user-1", "cat-food", date))
+		})
+	}
+
+	t.Run("Check Budget of a category without one", func(t *testing.T) {
+		ctrl, budgetService, mocks := SetupBudgetService(t)
+		defer ctrl.Finish()
+
+		mocks.budgetRepo.EXPECT().GetCategoryBudget(gomock.Any(), "cat-rent", "2024-02").Return(model.Budget{}, sql.ErrNoRows)
+
+		assert.NoError(t, budgetService.CheckBudget(ctx, "user-1", "cat-rent", date))
+	})
+}
@@ -1,11 +1,13 @@
 package service
 
 import (
+	"context"
 	"errors"
-	"log"
+	"log/slog"
 
 	"github.com/varomnrg/money-tracker/model"
 	catRepo "github.com/varomnrg/money-tracker/repository/category"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
 	userRepo "github.com/varomnrg/money-tracker/repository/user"
 	"github.com/varomnrg/money-tracker/utils"
 )
@@ -13,39 +15,63 @@ import (
 type CategoryService struct {
 	categoryRepo catRepo.ICategoryRepository
 	userRepo    userRepo.IUserRepository
+	uow         unitofwork.IUnitOfWork
 }
 
 var (
-	ErrUserNotFound	 		= errors.New("user cannot be found")
-	ErrCategoryNotFound 	= errors.New("category cannot be found")
-	ErrCategoryAlreadyExist = errors.New("category already exist")
+	ErrUserNotFound	 		= model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrCategoryNotFound 	= model.NewError(model.CodeNotFound, "category cannot be found")
+	ErrCategoryAlreadyExist = model.NewError(model.CodeConflict, "category already exist")
+	ErrCategoryInUse        = model.NewError(model.CodeConflict, "category is in use")
 )
 
-func NewCategoryService(catRepo catRepo.ICategoryRepository, userRepo userRepo.IUserRepository) *CategoryService {
+func NewCategoryService(catRepo catRepo.ICategoryRepository, userRepo userRepo.IUserRepository, uow unitofwork.IUnitOfWork) *CategoryService {
 	return &CategoryService{
 		categoryRepo: catRepo,
 		userRepo:    userRepo,
+		uow:         uow,
 	}
 }
 
-func (c *CategoryService) GetCategories() ([]model.Category, error) {
-	return c.categoryRepo.GetCategories()
+func (c *CategoryService) GetCategories(ctx context.Context, query model.ListQuery) (model.Page[model.Category], error) {
+	err := query.Prepare(model.CategorySorts...)
+	if err != nil {
+		return model.Page[model.Category]{}, err
+	}
+
+	categories, err := c.categoryRepo.GetCategories(ctx, query.Lookahead())
+	if err != nil {
+		return model.Page[model.Category]{}, err
+	}
+
+	return model.NewPage(categories, query), nil
 }
 
-func (c *CategoryService) GetUserCategories(userID string) ([]model.Category, error) {
-	_, err := c.userRepo.GetUser(userID)
+func (c *CategoryService) GetUserCategories(ctx context.Context, userID string, query model.ListQuery) (model.Page[model.Category], error) {
+	_, err := c.userRepo.GetUser(ctx, userID)
 	
 
-	log.Printf("GetUser called with userID: %s\n", userID)
+	slog.Debug("GetUser called", "userID", userID)
+
+	if err != nil {
+		return model.Page[model.Category]{}, ErrUserNotFound
+	}
+
+	err = query.Prepare(model.CategorySorts...)
+	if err != nil {
+		return model.Page[model.Category]{}, err
+	}
 
+	categories, err := c.categoryRepo.GetUserCategories(ctx, userID, query.Lookahead())
 	if err != nil {
-		return []model.Category{}, ErrUserNotFound
+		return model.Page[model.Category]{}, err
 	}
-	return c.categoryRepo.GetUserCategories(userID)
+
+	return model.NewPage(categories, query), nil
 }
 
-func (c *CategoryService) GetCategory(id string) (model.Category, error) {
-	category, err := c.categoryRepo.GetCategory(id)
+func (c *CategoryService) GetCategory(ctx context.Context, id string) (model.Category, error) {
+	category, err := c.categoryRepo.GetCategory(ctx, id)
 
 	if err != nil {
 		return model.Category{}, ErrCategoryNotFound
@@ -54,37 +80,52 @@ func (c *CategoryService) GetCategory(id string) (model.Category, error) {
 	return category, nil
 }
 
-func (c *CategoryService) CreateCategory(userID string, category model.CategoryRequest) error {
-	_, err := c.userRepo.GetUser(userID);
+// CreateCategory checks the user and the name and inserts the category in one
+// transaction. PostgreSQL reads the user FOR KEY SHARE, so it cannot be
+// deleted in between; on SQLite a user deleted meanwhile fails the insert on
+// its foreign key instead.
+func (c *CategoryService) CreateCategory(ctx context.Context, userID string, category model.CategoryRequest) error {
+	return c.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := c.userRepo.GetUser(ctx, userID)
 
-	if err != nil {
-		return ErrUserNotFound
-	}
+		if err != nil {
+			return ErrUserNotFound
+		}
 
-	categoryExist := c.categoryRepo.IsUserCategoryExist(userID, category.Name)
+		categoryExist := c.categoryRepo.IsUserCategoryExist(ctx, userID, category.Name)
 
-	if categoryExist {
-		return ErrCategoryAlreadyExist
-	}
+		if categoryExist {
+			return ErrCategoryAlreadyExist
+		}
 
-	id := "cat-" + utils.GenerateRandomID(10)
+		id := "cat-" + utils.GenerateRandomID(10)
 
-	newCategory := model.Category{
-		ID: id,
-		Name: category.Name,
-		User_ID: userID,
-	}
+		newCategory := model.Category{
+			ID: id,
+			Name: category.Name,
+			User_ID: userID,
+		}
 
-	return c.categoryRepo.CreateCategory(newCategory)
+		return c.categoryRepo.CreateCategory(ctx, newCategory)
+	})
 }
 
-func (c *CategoryService) DeleteCategory(id string) error {
-	_, err := c.categoryRepo.GetCategory(id)
+// DeleteCategory deletes the category along with its budgets and recurring
+// transactions. A category that transactions still refer to is kept.
+func (c *CategoryService) DeleteCategory(ctx context.Context, id string) error {
+	return c.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := c.categoryRepo.GetCategory(ctx, id)
 
-	if err != nil {
-		return ErrCategoryNotFound
-	}
+		if err != nil {
+			return ErrCategoryNotFound
+		}
+
+		err = c.categoryRepo.DeleteCategory(ctx, id)
+		if errors.Is(err, model.ErrForeignKey) {
+			return ErrCategoryInUse
+		}
 
-	return c.categoryRepo.DeleteCategory(id)
+		return err
+	})
 }
 
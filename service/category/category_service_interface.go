@@ -1,11 +1,15 @@
 package service
 
-import "github.com/varomnrg/money-tracker/model"
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
 
 type ICategoryService interface {
-	GetCategories() ([]model.Category, error)
-	GetUserCategories(userID string) ([]model.Category, error)
-	GetCategory(id string) (model.Category, error)
-	CreateCategory(userID string, category model.CategoryRequest) error
-	DeleteCategory(id string) error
+	GetCategories(ctx context.Context, query model.ListQuery) (model.Page[model.Category], error)
+	GetUserCategories(ctx context.Context, userID string, query model.ListQuery) (model.Page[model.Category], error)
+	GetCategory(ctx context.Context, id string) (model.Category, error)
+	CreateCategory(ctx context.Context, userID string, category model.CategoryRequest) error
+	DeleteCategory(ctx context.Context, id string) error
 }
@@ -1,23 +1,29 @@
 package service_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	mockCatRepo "github.com/varomnrg/money-tracker/mocks/repository/category"
+	mockUnitOfWork "github.com/varomnrg/money-tracker/mocks/repository/unitofwork"
 	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
 	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
 	service "github.com/varomnrg/money-tracker/service/category"
 	"go.uber.org/mock/gomock"
 )
 
+var ctx = context.Background()
+
 
 func SetupCategoryService(t *testing.T) (*gomock.Controller, *service.CategoryService, *mockCatRepo.MockICategoryRepository,*mockUserRepo.MockIUserRepository){
 	ctrl := gomock.NewController(t)
 	mockUserRepo := mockUserRepo.NewMockIUserRepository(ctrl)
 	mockCatRepo := mockCatRepo.NewMockICategoryRepository(ctrl)
-	categoryService := service.NewCategoryService(mockCatRepo, mockUserRepo)
+	categoryService := service.NewCategoryService(mockCatRepo, mockUserRepo, unitofwork.NewNopUnitOfWork())
 
 	return ctrl, categoryService, mockCatRepo, mockUserRepo
 }
@@ -27,7 +33,7 @@ func TestGetCategories(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock for GetCategories
-	mockCatRepo.EXPECT().GetCategories().Return([]model.Category{
+	mockCatRepo.EXPECT().GetCategories(gomock.Any(), model.ListQuery{Sort: "name", Limit: model.DefaultPageSize + 1}).Return([]model.Category{
 		{ID: "cat-1", Name: "category1", User_ID: "user-1"},
 		{ID: "cat-2", Name: "category2", User_ID: "user-2"},
 		}, 
@@ -35,11 +41,11 @@ func TestGetCategories(t *testing.T) {
 	)
 
 	t.Run("Get Categories", func(t *testing.T){
-		categories, err := categoryService.GetCategories()
+		categories, err := categoryService.GetCategories(ctx, model.ListQuery{})
 
 		assert.NoError(t,err)
-		assert.Len(t, categories, 2, "Expected returned 2 categories")		
-		assert.Equal(t, "category1", categories[0].Name, "Expected first category name is category1")
+		assert.Len(t, categories.Items, 2, "Expected returned 2 categories")		
+		assert.Equal(t, "category1", categories.Items[0].Name, "Expected first category name is category1")
 	})
 }
 
@@ -48,8 +54,8 @@ func TestGetUserCategories(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock for GetUserCategories
-	mockUserRepo.EXPECT().GetUser("user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
-	mockCatRepo.EXPECT().GetUserCategories("user-1").Return([]model.Category{
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
+	mockCatRepo.EXPECT().GetUserCategories(gomock.Any(), "user-1", model.ListQuery{Sort: "name", Limit: model.DefaultPageSize + 1}).Return([]model.Category{
 		{ID: "cat-1", Name: "category1", User_ID: "user-1"},
 		{ID: "cat-2", Name: "category2", User_ID: "user-1"},
 		}, 
@@ -57,28 +63,28 @@ func TestGetUserCategories(t *testing.T) {
 	)
 
 	// Mock for GetUserCategories without categories
-	mockUserRepo.EXPECT().GetUser("user-2").Return(model.UserResponse{ID: "user-2", Username: "user2", Email: "user2@gmail.com", Created_At: time.Now()}, nil)
-	mockCatRepo.EXPECT().GetUserCategories("user-2").Return([]model.Category{}, nil)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-2").Return(model.UserResponse{ID: "user-2", Username: "user2", Email: "user2@gmail.com", Created_At: time.Now()}, nil)
+	mockCatRepo.EXPECT().GetUserCategories(gomock.Any(), "user-2", model.ListQuery{Sort: "name", Limit: model.DefaultPageSize + 1}).Return([]model.Category{}, nil)
 
 	// Mock for GetUserCategories with invalid user id
-	mockUserRepo.EXPECT().GetUser("invalid_id").Return(model.UserResponse{}, service.ErrUserNotFound)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, service.ErrUserNotFound)
 
 	t.Run("Get User Categories", func(t *testing.T){
-		categories, err := categoryService.GetUserCategories("user-1")
+		categories, err := categoryService.GetUserCategories(ctx, "user-1", model.ListQuery{})
 
 		assert.NoError(t,err)
-		assert.Len(t, categories, 2, "Expected returned 2 categories")		
-		assert.Equal(t, "category1", categories[0].Name, "Expected first category name is category1")
+		assert.Len(t, categories.Items, 2, "Expected returned 2 categories")		
+		assert.Equal(t, "category1", categories.Items[0].Name, "Expected first category name is category1")
 	})
 
 	t.Run("Get User Categories without categories", func(t *testing.T){
-		categories, err := categoryService.GetUserCategories("user-2")
+		categories, err := categoryService.GetUserCategories(ctx, "user-2", model.ListQuery{})
 		assert.NoError(t,err)
-		assert.Len(t, categories, 0, "Expected returned 0 categories")		
+		assert.Len(t, categories.Items, 0, "Expected returned 0 categories")		
 	})
 
 	t.Run("Get User Categories with invalid user id", func(t *testing.T){
-		_, err := categoryService.GetUserCategories("invalid_id")
+		_, err := categoryService.GetUserCategories(ctx, "invalid_id", model.ListQuery{})
 		assert.ErrorIs(t, err, service.ErrUserNotFound, "Expected user not found")
 	})
 }
@@ -88,20 +94,20 @@ func TestGetCategory(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock for GetCategory
-	mockCatRepo.EXPECT().GetCategory("cat-1").Return(model.Category{ID: "cat-1", Name: "category1", User_ID: "user-1"}, nil)
+	mockCatRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", Name: "category1", User_ID: "user-1"}, nil)
 	
 	// Mock for GetCategory with invalid id
-	mockCatRepo.EXPECT().GetCategory("invalid_id").Return(model.Category{}, service.ErrCategoryNotFound)
+	mockCatRepo.EXPECT().GetCategory(gomock.Any(), "invalid_id").Return(model.Category{}, service.ErrCategoryNotFound)
 
 	t.Run("Get Category", func(t *testing.T){
-		category, err := categoryService.GetCategory("cat-1")
+		category, err := categoryService.GetCategory(ctx, "cat-1")
 
 		assert.NoError(t,err)
 		assert.Equal(t, "category1", category.Name)
 	})
 
 	t.Run("Get Category with invalid id", func(t *testing.T){
-		_, err := categoryService.GetCategory("invalid_id")
+		_, err := categoryService.GetCategory(ctx, "invalid_id")
 
 		assert.ErrorIs(t, err, service.ErrCategoryNotFound)
 	})
@@ -112,31 +118,31 @@ func TestCreateCategory(t *testing.T) {
 	defer ctrl.Finish()
 
 	// Mock for Create Category
-	mockUserRepo.EXPECT().GetUser("user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
-	mockCatRepo.EXPECT().IsUserCategoryExist("user-1", "category1").Return(false)
-	mockCatRepo.EXPECT().CreateCategory(gomock.Any()).Return(nil)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
+	mockCatRepo.EXPECT().IsUserCategoryExist(gomock.Any(), "user-1", "category1").Return(false)
+	mockCatRepo.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(nil)
 
 	// Mock for Create Category with existing category name
-	mockUserRepo.EXPECT().GetUser("user-2").Return(model.UserResponse{ID: "user-2", Username: "user2", Email: "user2@gmail.com", Created_At: time.Now()}, nil)
-	mockCatRepo.EXPECT().IsUserCategoryExist("user-2", "category1").Return(true)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-2").Return(model.UserResponse{ID: "user-2", Username: "user2", Email: "user2@gmail.com", Created_At: time.Now()}, nil)
+	mockCatRepo.EXPECT().IsUserCategoryExist(gomock.Any(), "user-2", "category1").Return(true)
 
 	// Mock for Create Category with invalid user id
-	mockUserRepo.EXPECT().GetUser("invalid_id").Return(model.UserResponse{}, service.ErrUserNotFound)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, service.ErrUserNotFound)
 
 	t.Run("Create Category", func(t *testing.T){
-		err := categoryService.CreateCategory("user-1", model.CategoryRequest{Name: "category1"})
+		err := categoryService.CreateCategory(ctx, "user-1", model.CategoryRequest{Name: "category1"})
 
 		assert.NoError(t,err)
 	})
 
 	t.Run("Create Category with existing category name", func(t *testing.T){
-		err := categoryService.CreateCategory("user-2", model.CategoryRequest{Name: "category1"})
+		err := categoryService.CreateCategory(ctx, "user-2", model.CategoryRequest{Name: "category1"})
 
 		assert.ErrorIs(t, err, service.ErrCategoryAlreadyExist)
 	})
 
 	t.Run("Create Category with invalid user id", func(t *testing.T){
-		err := categoryService.CreateCategory("invalid_id", model.CategoryRequest{Name: "category1"})
+		err := categoryService.CreateCategory(ctx, "invalid_id", model.CategoryRequest{Name: "category1"})
 
 		assert.ErrorIs(t, err, service.ErrUserNotFound)
 	})
@@ -147,21 +153,65 @@ func TestDeleteCategory(t *testing.T){
 	defer ctrl.Finish()
 
 	// Mock for Delete Category
-	mockCatRepo.EXPECT().GetCategory("cat-1").Return(model.Category{ID: "cat-1", Name: "category1", User_ID: "user-1"}, nil)
-	mockCatRepo.EXPECT().DeleteCategory("cat-1").Return(nil)
+	mockCatRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", Name: "category1", User_ID: "user-1"}, nil)
+	mockCatRepo.EXPECT().DeleteCategory(gomock.Any(), "cat-1").Return(nil)
 
 	// Mock for Delete Category with invalid id
-	mockCatRepo.EXPECT().GetCategory("invalid_catid").Return(model.Category{}, service.ErrCategoryNotFound)
+	mockCatRepo.EXPECT().GetCategory(gomock.Any(), "invalid_catid").Return(model.Category{}, service.ErrCategoryNotFound)
 
 	t.Run("Delete Category", func(t *testing.T){
-		err := categoryService.DeleteCategory("cat-1")
+		err := categoryService.DeleteCategory(ctx, "cat-1")
 
 		assert.NoError(t,err)
 	})
 
 	t.Run("Delete Category with invalid id", func(t *testing.T){
-		err := categoryService.DeleteCategory("invalid_catid")
+		err := categoryService.DeleteCategory(ctx, "invalid_catid")
 
 		assert.ErrorIs(t, err, service.ErrCategoryNotFound)
 	})
-}
\ No newline at end of file
+}
+
+func TestDeleteCategory_InUse(t *testing.T) {
+	ctrl, categoryService, mockCatRepo, _ := SetupCategoryService(t)
+	defer ctrl.Finish()
+
+	mockCatRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", Name: "category1", User_ID: "user-1"}, nil)
+	mockCatRepo.EXPECT().DeleteCategory(gomock.Any(), "cat-1").Return(model.ErrForeignKey)
+
+	err := categoryService.DeleteCategory(ctx, "cat-1")
+
+	assert.ErrorIs(t, err, service.ErrCategoryInUse)
+}
+
+type txKey struct{}
+
+func TestCreateCategoryInUnitOfWork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mockUserRepo.NewMockIUserRepository(ctrl)
+	mockCatRepo := mockCatRepo.NewMockICategoryRepository(ctrl)
+	mockUnitOfWork := mockUnitOfWork.NewMockIUnitOfWork(ctrl)
+	categoryService := service.NewCategoryService(mockCatRepo, mockUserRepo, mockUnitOfWork)
+
+	txCtx := context.WithValue(ctx, txKey{}, "tx")
+	errCommit := errors.New("commit failed")
+
+	mockUnitOfWork.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		err := fn(txCtx)
+		if err != nil {
+			return err
+		}
+		return errCommit
+	})
+
+	// Every repository call must run on the unit of work's context.
+	mockUserRepo.EXPECT().GetUser(txCtx, "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mockCatRepo.EXPECT().IsUserCategoryExist(txCtx, "user-1", "food").Return(false)
+	mockCatRepo.EXPECT().CreateCategory(txCtx, gomock.Any()).Return(nil)
+
+	err := categoryService.CreateCategory(ctx, "user-1", model.CategoryRequest{Name: "food"})
+
+	assert.ErrorIs(t, err, errCommit)
+}
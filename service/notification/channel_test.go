@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/varomnrg/money-tracker/model"
+	service "github.com/varomnrg/money-tracker/service/notification"
+)
+
+var alice = model.UserResponse{ID: "user-1", Username: "alice", Email: "alice@example.com"}
+
+func TestWebhookChannel(t *testing.T) {
+	var (
+		body      []byte
+		signature string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get(service.SignatureHeader)
+
+		if r.URL.Path == "/failing" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("Send", func(t *testing.T) {
+		err := service.NewWebhookChannel(server.URL, "webhook-secret").Send(ctx, alice, alert())
+		require.NoError(t, err)
+
+		var notification model.Notification
+		require.NoError(t, json.Unmarshal(body, &notification))
+		assert.Equal(t, alert().Title, notification.Title)
+
+		mac := hmac.New(sha256.New, []byte("webhook-secret"))
+		mac.Write(body)
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), signature)
+	})
+
+	t.Run("Send without a secret", func(t *testing.T) {
+		require.NoError(t, service.NewWebhookChannel(server.URL, "").Send(ctx, alice, alert()))
+
+		assert.Empty(t, signature)
+	})
+
+	t.Run("Send to a failing webhook", func(t *testing.T) {
+		err := service.NewWebhookChannel(server.URL+"/failing", "").Send(ctx, alice, alert())
+
+		assert.ErrorContains(t, err, "503")
+	})
+}
+
+// smtpServer is a stand-in SMTP server that accepts one message per
+// connection and hands each on to messages.
+func smtpServer(t *testing.T) (string, <-chan string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan string, 1)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			serveSMTP(conn, messages)
+		}
+	}()
+
+	return listener.Addr().String(), messages
+}
+
+func serveSMTP(conn net.Conn, messages chan<- string) {
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 localhost ESMTP")
+
+	var envelope []string
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"), strings.HasPrefix(command, "RCPT TO:"):
+			envelope = append(envelope, strings.TrimSpace(line))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil || line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+
+			messages <- strings.Join(envelope, "\r\n") + "\r\n\r\n" + data.String()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSMTPChannel(t *testing.T) {
+	addr, messages := smtpServer(t)
+
+	t.Run("Send", func(t *testing.T) {
+		notification := alert()
+		notification.Message = "You have spent 850.00 of the 1000.00 IDR available for Food in 2024-02 (85%)."
+
+		err := service.NewSMTPChannel(addr, "", "", "alerts@example.com").Send(ctx, alice, notification)
+		require.NoError(t, err)
+
+		message := <-messages
+		assert.Contains(t, message, "MAIL FROM:<alerts@example.com>")
+		assert.Contains(t, message, "RCPT TO:<alice@example.com>")
+		assert.Contains(t, message, "Subject: "+notification.Title)
+		assert.Contains(t, message, notification.Message)
+	})
+
+	t.Run("Send to a user without email", func(t *testing.T) {
+		err := service.NewSMTPChannel(addr, "", "", "alerts@example.com").Send(ctx, model.UserResponse{ID: "user-2"}, alert())
+
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("Send through an unreachable server", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		unreachable := listener.Addr().String()
+		listener.Close()
+
+		err = service.NewSMTPChannel(unreachable, "", "", "alerts@example.com").Send(ctx, alice, alert())
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	notificationRepo "github.com/varomnrg/money-tracker/repository/notification"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	userRepo "github.com/varomnrg/money-tracker/repository/user"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+// Channel delivers notifications outside the inbox, such as by email.
+type Channel interface {
+	// Name identifies the channel in logs.
+	Name() string
+	Send(ctx context.Context, user model.UserResponse, notification model.Notification) error
+}
+
+type NotificationService struct {
+	notificationRepo notificationRepo.INotificationRepository
+	userRepo         userRepo.IUserRepository
+	channels         []Channel
+	timeout          time.Duration
+	deliveries       sync.WaitGroup
+}
+
+var (
+	ErrUserNotFound         = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrNotificationNotFound = model.NewError(model.CodeNotFound, "notification cannot be found")
+)
+
+// NewNotificationService delivers every notification through each of
+// channels, giving each delivery up to timeout.
+func NewNotificationService(notificationRepo notificationRepo.INotificationRepository, userRepo userRepo.IUserRepository, timeout time.Duration, channels ...Channel) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		channels:         channels,
+		timeout:          timeout,
+	}
+}
+
+func (s *NotificationService) GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) (model.Page[model.Notification], error) {
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return model.Page[model.Notification]{}, ErrUserNotFound
+	}
+
+	err = query.Prepare(model.NotificationSorts...)
+	if err != nil {
+		return model.Page[model.Notification]{}, err
+	}
+
+	fetch := query
+	fetch.ListQuery = query.Lookahead()
+
+	notifications, err := s.notificationRepo.GetUserNotifications(ctx, userID, fetch)
+	if err != nil {
+		return model.Page[model.Notification]{}, err
+	}
+
+	return model.NewPage(notifications, query.ListQuery), nil
+}
+
+func (s *NotificationService) GetNotification(ctx context.Context, id string) (model.Notification, error) {
+	notification, err := s.notificationRepo.GetNotification(ctx, id)
+
+	if err != nil {
+		return model.Notification{}, ErrNotificationNotFound
+	}
+
+	return notification, nil
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, id string) error {
+	_, err := s.notificationRepo.GetNotification(ctx, id)
+
+	if err != nil {
+		return ErrNotificationNotFound
+	}
+
+	return s.notificationRepo.MarkRead(ctx, id, utils.GetCurrentTime())
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID string) error {
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	return s.notificationRepo.MarkAllRead(ctx, userID, utils.GetCurrentTime())
+}
+
+// Notify stores notification in the user's inbox unless the user has already
+// been notified of the event its Key names, then hands it to the channels.
+// Deliveries start once the unit of work ctx belongs to has committed, run in
+// the background and their failures are only logged; the notification stays
+// in the inbox either way.
+func (s *NotificationService) Notify(ctx context.Context, notification model.Notification) error {
+	user, err := s.userRepo.GetUser(ctx, notification.User_ID)
+
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	notification.ID = "ntf-" + utils.GenerateRandomID(10)
+	notification.Created_At = utils.GetCurrentTime()
+	notification.Read_At = nil
+
+	if notification.Key == "" {
+		notification.Key = notification.ID
+	}
+
+	_, err = s.notificationRepo.GetNotificationByKey(ctx, notification.User_ID, notification.Key)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	err = s.notificationRepo.CreateNotification(ctx, notification)
+	if err != nil {
+		return err
+	}
+
+	unitofwork.AfterCommit(ctx, func() {
+		for _, channel := range s.channels {
+			s.deliveries.Add(1)
+			go s.deliver(ctx, channel, user, notification)
+		}
+	})
+
+	return nil
+}
+
+// deliver sends notification through channel. It outlives the request that
+// raised the notification, so it keeps only the values of ctx.
+func (s *NotificationService) deliver(ctx context.Context, channel Channel, user model.UserResponse, notification model.Notification) {
+	defer s.deliveries.Done()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.timeout)
+	defer cancel()
+
+	err := channel.Send(ctx, user, notification)
+	if err != nil {
+		slog.Error("Delivering notification", "notification", notification.ID, "channel", channel.Name(), "error", err)
+	}
+}
+
+// Wait blocks until every delivery started so far has finished.
+func (s *NotificationService) Wait() {
+	s.deliveries.Wait()
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// INotifier puts notifications in users' inboxes and delivers them through
+// the configured channels.
+type INotifier interface {
+	Notify(ctx context.Context, notification model.Notification) error
+}
+
+type INotificationService interface {
+	INotifier
+	GetUserNotifications(ctx context.Context, userID string, query model.NotificationQuery) (model.Page[model.Notification], error)
+	GetNotification(ctx context.Context, id string) (model.Notification, error)
+	MarkRead(ctx context.Context, id string) error
+	MarkAllRead(ctx context.Context, userID string) error
+}
@@ -0,0 +1,229 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mockNotificationRepo "github.com/varomnrg/money-tracker/mocks/repository/notification"
+	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/memory"
+	service "github.com/varomnrg/money-tracker/service/notification"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+type notificationMocks struct {
+	notificationRepo *mockNotificationRepo.MockINotificationRepository
+	userRepo         *mockUserRepo.MockIUserRepository
+}
+
+// recordingChannel keeps what it is sent, failing every send when err is set.
+type recordingChannel struct {
+	mu   sync.Mutex
+	sent []model.Notification
+	err  error
+}
+
+func (c *recordingChannel) Name() string {
+	return "recording"
+}
+
+func (c *recordingChannel) Send(_ context.Context, _ model.UserResponse, notification model.Notification) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sent = append(c.sent, notification)
+
+	return c.err
+}
+
+func SetupNotificationService(t *testing.T, channels ...service.Channel) (*gomock.Controller, *service.NotificationService, notificationMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := notificationMocks{
+		notificationRepo: mockNotificationRepo.NewMockINotificationRepository(ctrl),
+		userRepo:         mockUserRepo.NewMockIUserRepository(ctrl),
+	}
+
+	return ctrl, service.NewNotificationService(mocks.notificationRepo, mocks.userRepo, time.Second, channels...), mocks
+}
+
+func alert() model.Notification {
+	return model.Notification{User_ID: "user-1", Kind: model.NotificationBudgetThreshold, Key: "budget:budget-1:80", Title: "Food budget for 2024-02 has reached 80%"}
+}
+
+func TestNotify(t *testing.T) {
+	t.Run("Notify", func(t *testing.T) {
+		webhook, email := &recordingChannel{}, &recordingChannel{err: errors.New("connection refused")}
+		ctrl, notificationService, mocks := SetupNotificationService(t, webhook, email)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Email: "alice@example.com"}, nil)
+		mocks.notificationRepo.EXPECT().GetNotificationByKey(gomock.Any(), "user-1", "budget:budget-1:80").Return(model.Notification{}, sql.ErrNoRows)
+		mocks.notificationRepo.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, notification model.Notification) error {
+			assert.NotEmpty(t, notification.ID)
+			assert.False(t, notification.Created_At.IsZero())
+			return nil
+		})
+
+		err := notificationService.Notify(ctx, alert())
+		notificationService.Wait()
+
+		assert.NoError(t, err, "Expected a failing channel not to fail the notification")
+		require.Len(t, webhook.sent, 1)
+		assert.Equal(t, alert().Title, webhook.sent[0].Title)
+		assert.Len(t, email.sent, 1)
+	})
+
+	t.Run("Notify inside a unit of work", func(t *testing.T) {
+		channel := &recordingChannel{}
+		ctrl, notificationService, mocks := SetupNotificationService(t, channel)
+		defer ctrl.Finish()
+
+		store := memory.NewStore()
+		errBoom := errors.New("boom")
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil).Times(2)
+		mocks.notificationRepo.EXPECT().GetNotificationByKey(gomock.Any(), "user-1", "budget:budget-1:80").Return(model.Notification{}, sql.ErrNoRows).Times(2)
+		mocks.notificationRepo.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		err := store.Do(ctx, func(ctx context.Context) error {
+			err := notificationService.Notify(ctx, alert())
+			notificationService.Wait()
+
+			assert.Empty(t, channel.sent, "Expected no delivery before the commit")
+
+			return err
+		})
+		notificationService.Wait()
+
+		assert.NoError(t, err)
+		assert.Len(t, channel.sent, 1)
+
+		err = store.Do(ctx, func(ctx context.Context) error {
+			err := notificationService.Notify(ctx, alert())
+			if err != nil {
+				return err
+			}
+
+			return errBoom
+		})
+		notificationService.Wait()
+
+		assert.ErrorIs(t, err, errBoom)
+		assert.Len(t, channel.sent, 1, "Expected no delivery after a rollback")
+	})
+
+	t.Run("Notify of an event already notified", func(t *testing.T) {
+		channel := &recordingChannel{}
+		ctrl, notificationService, mocks := SetupNotificationService(t, channel)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.notificationRepo.EXPECT().GetNotificationByKey(gomock.Any(), "user-1", "budget:budget-1:80").Return(model.Notification{ID: "ntf-1"}, nil)
+
+		err := notificationService.Notify(ctx, alert())
+		notificationService.Wait()
+
+		assert.NoError(t, err)
+		assert.Empty(t, channel.sent)
+	})
+
+	t.Run("Notify without a key", func(t *testing.T) {
+		ctrl, notificationService, mocks := SetupNotificationService(t)
+		defer ctrl.Finish()
+
+		notification := alert()
+		notification.Key = ""
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.notificationRepo.EXPECT().GetNotificationByKey(gomock.Any(), "user-1", gomock.Any()).Return(model.Notification{}, sql.ErrNoRows)
+		mocks.notificationRepo.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, notification model.Notification) error {
+			assert.Equal(t, notification.ID, notification.Key, "Expected the notification to be its own event")
+			return nil
+		})
+
+		assert.NoError(t, notificationService.Notify(ctx, notification))
+	})
+
+	t.Run("Notify an invalid user", func(t *testing.T) {
+		ctrl, notificationService, mocks := SetupNotificationService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{}, sql.ErrNoRows)
+
+		assert.ErrorIs(t, notificationService.Notify(ctx, alert()), service.ErrUserNotFound)
+	})
+}
+
+func TestGetUserNotifications(t *testing.T) {
+	ctrl, notificationService, mocks := SetupNotificationService(t)
+	defer ctrl.Finish()
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil).AnyTimes()
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, sql.ErrNoRows)
+	mocks.notificationRepo.EXPECT().GetUserNotifications(gomock.Any(), "user-1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, query model.NotificationQuery) ([]model.Notification, error) {
+			assert.Equal(t, "-created_at", query.Sort)
+			assert.True(t, query.Unread)
+			assert.Equal(t, 3, query.Limit, "Expected one notification more than the page size")
+
+			return []model.Notification{{ID: "ntf-3"}, {ID: "ntf-2"}, {ID: "ntf-1"}}, nil
+		},
+	)
+
+	t.Run("Get User Notifications", func(t *testing.T) {
+		page, err := notificationService.GetUserNotifications(ctx, "user-1", model.NotificationQuery{ListQuery: model.ListQuery{Limit: 2}, Unread: true})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.NotEmpty(t, page.Next_Cursor)
+	})
+
+	t.Run("Get User Notifications with invalid sort", func(t *testing.T) {
+		_, err := notificationService.GetUserNotifications(ctx, "user-1", model.NotificationQuery{ListQuery: model.ListQuery{Sort: "title"}})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Get User Notifications with invalid user id", func(t *testing.T) {
+		_, err := notificationService.GetUserNotifications(ctx, "invalid_id", model.NotificationQuery{})
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestMarkRead(t *testing.T) {
+	ctrl, notificationService, mocks := SetupNotificationService(t)
+	defer ctrl.Finish()
+
+	mocks.notificationRepo.EXPECT().GetNotification(gomock.Any(), "ntf-1").Return(model.Notification{ID: "ntf-1"}, nil)
+	mocks.notificationRepo.EXPECT().GetNotification(gomock.Any(), "invalid_id").Return(model.Notification{}, sql.ErrNoRows)
+	mocks.notificationRepo.EXPECT().MarkRead(gomock.Any(), "ntf-1", gomock.Any()).Return(nil)
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, sql.ErrNoRows)
+	mocks.notificationRepo.EXPECT().MarkAllRead(gomock.Any(), "user-1", gomock.Any()).Return(nil)
+
+	t.Run("Mark Read", func(t *testing.T) {
+		assert.NoError(t, notificationService.MarkRead(ctx, "ntf-1"))
+	})
+
+	t.Run("Mark Read with invalid id", func(t *testing.T) {
+		assert.ErrorIs(t, notificationService.MarkRead(ctx, "invalid_id"), service.ErrNotificationNotFound)
+	})
+
+	t.Run("Mark All Read", func(t *testing.T) {
+		assert.NoError(t, notificationService.MarkAllRead(ctx, "user-1"))
+	})
+
+	t.Run("Mark All Read with invalid user id", func(t *testing.T) {
+		assert.ErrorIs(t, notificationService.MarkAllRead(ctx, "invalid_id"), service.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// SMTPChannel emails each notification to the user's address.
+type SMTPChannel struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPChannel sends mail from the address from through the server at
+// addr, given as host:port. The server is only authenticated with when
+// username is set; net/smtp refuses to send the password unencrypted to
+// anything but localhost.
+func NewSMTPChannel(addr string, username string, password string, from string) *SMTPChannel {
+	channel := &SMTPChannel{addr: addr, from: from}
+
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		channel.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return channel
+}
+
+func (c *SMTPChannel) Name() string {
+	return "smtp"
+}
+
+// Send works like smtp.SendMail, upgrading to TLS when the server offers it,
+// but gives up when ctx is done.
+func (c *SMTPChannel) Send(ctx context.Context, user model.UserResponse, notification model.Notification) error {
+	if user.Email == "" {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(c.addr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.auth != nil {
+		err = client.Auth(c.auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(c.from)
+	if err != nil {
+		return err
+	}
+
+	err = client.Rcpt(user.Email)
+	if err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(c.message(user, notification))
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (c *SMTPChannel) message(user model.UserResponse, notification model.Notification) []byte {
+	var message strings.Builder
+
+	fmt.Fprintf(&message, "From: %s\r\n", c.from)
+	fmt.Fprintf(&message, "To: %s\r\n", user.Email)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Title))
+	fmt.Fprintf(&message, "Date: %s\r\n", notification.Created_At.Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	message.WriteString("\r\n")
+	message.WriteString(strings.ReplaceAll(notification.Message, "\n", "\r\n"))
+	message.WriteString("\r\n")
+
+	return []byte(message.String())
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of a webhook body, keyed with
+// the webhook secret and prefixed with "sha256=".
+const SignatureHeader = "X-Signature-256"
+
+// WebhookChannel posts each notification as JSON to a URL.
+type WebhookChannel struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhookChannel signs the bodies it posts to url with secret, unless
+// secret is empty.
+func NewWebhookChannel(url string, secret string) *WebhookChannel {
+	return &WebhookChannel{url: url, secret: secret, client: &http.Client{}}
+}
+
+func (c *WebhookChannel) Name() string {
+	return "webhook"
+}
+
+func (c *WebhookChannel) Send(ctx context.Context, user model.UserResponse, notification model.Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.secret != "" {
+		mac := hmac.New(sha256.New, []byte(c.secret))
+		mac.Write(body)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+
+	return nil
+}
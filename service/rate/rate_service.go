@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	repository "github.com/varomnrg/money-tracker/repository/rate"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type RateService struct {
+	rateRepo repository.IRateRepository
+	uow      unitofwork.IUnitOfWork
+}
+
+var (
+	ErrRateNotFound = model.NewError(model.CodeNotFound, "exchange rate cannot be found")
+	ErrInvalidCSV   = model.NewError(model.CodeValidation, "invalid exchange rate csv")
+)
+
+// csvHeader is the column layout expected by ImportRates.
+var csvHeader = []string{"date", "base", "quote", "rate"}
+
+func NewRateService(rateRepo repository.IRateRepository, uow unitofwork.IUnitOfWork) *RateService {
+	return &RateService{
+		rateRepo: rateRepo,
+		uow:      uow,
+	}
+}
+
+func (s *RateService) GetRates(ctx context.Context) ([]model.ExchangeRate, error) {
+	return s.rateRepo.GetRates(ctx)
+}
+
+func (s *RateService) SaveRate(ctx context.Context, rate model.ExchangeRateRequest) error {
+	return s.rateRepo.SaveRate(ctx, model.ExchangeRate{
+		ID:    "rate-" + utils.GenerateRandomID(10),
+		Base:  rate.Base,
+		Quote: rate.Quote,
+		Rate:  rate.Rate,
+		Date:  truncateToDate(rate.Date),
+	})
+}
+
+func (s *RateService) DeleteRate(ctx context.Context, id string) error {
+	err := s.rateRepo.DeleteRate(ctx, id)
+
+	if err != nil {
+		return ErrRateNotFound
+	}
+
+	return nil
+}
+
+// ImportRates reads "date,base,quote,rate" rows (with that header line) and
+// saves each of them, returning the number of rates imported. Dates use the
+// YYYY-MM-DD format. The whole file is validated before anything is saved, and
+// the rates are saved together or not at all.
+func (s *RateService) ImportRates(ctx context.Context, file io.Reader) (int, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = len(csvHeader)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+	}
+
+	if len(records) == 0 || !strings.EqualFold(strings.Join(records[0], ","), strings.Join(csvHeader, ",")) {
+		return 0, fmt.Errorf("%w: header must be %s", ErrInvalidCSV, strings.Join(csvHeader, ","))
+	}
+
+	rates := make([]model.ExchangeRateRequest, 0, len(records)-1)
+
+	for i, record := range records[1:] {
+		rate, err := parseRateRecord(record)
+		if err != nil {
+			return 0, fmt.Errorf("%w: line %d: %v", ErrInvalidCSV, i+2, err)
+		}
+		rates = append(rates, rate)
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		for _, rate := range rates {
+			err := s.SaveRate(ctx, rate)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(rates), nil
+}
+
+// Convert expresses amount in currency using the rate in effect on date. When
+// no direct or inverse rate exists for the pair, it crosses through
+// model.DefaultCurrency.
+func (s *RateService) Convert(ctx context.Context, amount model.Money, currency string, date time.Time) (model.Money, error) {
+	if amount.Currency == currency {
+		return amount, nil
+	}
+
+	rate, err := s.lookupRate(ctx, amount.Currency, currency, date)
+
+	if errors.Is(err, ErrRateNotFound) && amount.Currency != model.DefaultCurrency && currency != model.DefaultCurrency {
+		var toPivot, fromPivot float64
+
+		toPivot, err = s.lookupRate(ctx, amount.Currency, model.DefaultCurrency, date)
+		if err == nil {
+			fromPivot, err = s.lookupRate(ctx, model.DefaultCurrency, currency, date)
+		}
+
+		rate = toPivot * fromPivot
+	}
+
+	if err != nil {
+		return model.Money{}, err
+	}
+
+	return amount.Convert(rate, currency), nil
+}
+
+func (s *RateService) lookupRate(ctx context.Context, base string, quote string, date time.Time) (float64, error) {
+	rate, err := s.rateRepo.GetRate(ctx, base, quote, date)
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	rate, err = s.rateRepo.GetRate(ctx, quote, base, date)
+	if err == nil {
+		return 1 / rate.Rate, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("%w: %s/%s on %s", ErrRateNotFound, base, quote, date.Format(time.DateOnly))
+}
+
+func parseRateRecord(record []string) (model.ExchangeRateRequest, error) {
+	date, err := time.Parse(time.DateOnly, record[0])
+	if err != nil {
+		return model.ExchangeRateRequest{}, fmt.Errorf("date %q is not YYYY-MM-DD", record[0])
+	}
+
+	rate, err := strconv.ParseFloat(record[3], 64)
+	if err != nil || rate <= 0 {
+		return model.ExchangeRateRequest{}, fmt.Errorf("rate %q must be a positive number", record[3])
+	}
+
+	base := strings.ToUpper(record[1])
+	quote := strings.ToUpper(record[2])
+
+	if len(base) != 3 || len(quote) != 3 || base == quote {
+		return model.ExchangeRateRequest{}, fmt.Errorf("currency pair %s/%s is not valid", base, quote)
+	}
+
+	return model.ExchangeRateRequest{Base: base, Quote: quote, Rate: rate, Date: date}, nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// IConverter converts money between currencies using stored exchange rates.
+type IConverter interface {
+	Convert(ctx context.Context, amount model.Money, currency string, date time.Time) (model.Money, error)
+}
+
+type IRateService interface {
+	IConverter
+	GetRates(ctx context.Context) ([]model.ExchangeRate, error)
+	SaveRate(ctx context.Context, rate model.ExchangeRateRequest) error
+	DeleteRate(ctx context.Context, id string) error
+	ImportRates(ctx context.Context, csv io.Reader) (int, error)
+}
@@ -0,0 +1,201 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mock_repository "github.com/varomnrg/money-tracker/mocks/repository/rate"
+	mockUnitOfWork "github.com/varomnrg/money-tracker/mocks/repository/unitofwork"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	service "github.com/varomnrg/money-tracker/service/rate"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+func SetupRateService(t *testing.T) (*gomock.Controller, *service.RateService, *mock_repository.MockIRateRepository) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mock_repository.NewMockIRateRepository(ctrl)
+	rateService := service.NewRateService(mockRepo, unitofwork.NewNopUnitOfWork())
+
+	return ctrl, rateService, mockRepo
+}
+
+var rateDate = time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+func TestSaveRate(t *testing.T) {
+	ctrl, rateService, mockRepo := SetupRateService(t)
+	defer ctrl.Finish()
+
+	mockRepo.EXPECT().SaveRate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rate model.ExchangeRate) error {
+		assert.Equal(t, rateDate, rate.Date, "Expected date to be truncated to the day")
+		assert.Equal(t, "USD", rate.Base)
+		return nil
+	})
+
+	err := rateService.SaveRate(ctx, model.ExchangeRateRequest{Base: "USD", Quote: "IDR", Rate: 15600, Date: rateDate.Add(15 * time.Hour)})
+
+	assert.NoError(t, err)
+}
+
+func TestImportRates(t *testing.T) {
+	t.Run("Import Rates", func(t *testing.T) {
+		ctrl, rateService, mockRepo := SetupRateService(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().SaveRate(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		count, err := rateService.ImportRates(ctx, strings.NewReader("date,base,quote,rate\n2024-01-31,USD,IDR,15600\n2024-01-31, sgd, idr, 11650.5\n"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Import Rates with invalid row", func(t *testing.T) {
+		ctrl, rateService, _ := SetupRateService(t)
+		defer ctrl.Finish()
+
+		_, err := rateService.ImportRates(ctx, strings.NewReader("date,base,quote,rate\n2024-01-31,USD,IDR,15600\n31/01/2024,SGD,IDR,11650\n"))
+
+		assert.ErrorIs(t, err, service.ErrInvalidCSV)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+
+	t.Run("Import Rates without header", func(t *testing.T) {
+		ctrl, rateService, _ := SetupRateService(t)
+		defer ctrl.Finish()
+
+		_, err := rateService.ImportRates(ctx, strings.NewReader("2024-01-31,USD,IDR,15600\n"))
+
+		assert.ErrorIs(t, err, service.ErrInvalidCSV)
+	})
+}
+
+type txKey struct{}
+
+func TestImportRatesInUnitOfWork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_repository.NewMockIRateRepository(ctrl)
+	mockUnitOfWork := mockUnitOfWork.NewMockIUnitOfWork(ctrl)
+	rateService := service.NewRateService(mockRepo, mockUnitOfWork)
+
+	txCtx := context.WithValue(ctx, txKey{}, "tx")
+	errSave := errors.New("save failed")
+
+	mockUnitOfWork.EXPECT().Do(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(txCtx)
+	})
+
+	// Every rate must be saved on the unit of work's context, so that the
+	// first one is rolled back when the second fails.
+	gomock.InOrder(
+		mockRepo.EXPECT().SaveRate(txCtx, gomock.Any()).Return(nil),
+		mockRepo.EXPECT().SaveRate(txCtx, gomock.Any()).Return(errSave),
+	)
+
+	count, err := rateService.ImportRates(ctx, strings.NewReader("date,base,quote,rate\n2024-01-31,USD,IDR,15600\n2024-01-31,SGD,IDR,11650.5\n2024-01-31,EUR,IDR,17000\n"))
+
+	assert.ErrorIs(t, err, errSave)
+	assert.Zero(t, count)
+}
+
+func TestConvert(t *testing.T) {
+	t.Run("Convert same currency", func(t *testing.T) {
+		ctrl, rateService, _ := SetupRateService(t)
+		defer ctrl.Finish()
+
+		money, err := rateService.Convert(ctx, model.NewMoney(100, "IDR"), "IDR", rateDate)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(100, "IDR"), money)
+	})
+
+	t.Run("Convert with direct rate", func(t *testing.T) {
+		ctrl, rateService, mockRepo := SetupRateService(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetRate(gomock.Any(), "USD", "IDR", rateDate).Return(model.ExchangeRate{Base: "USD", Quote: "IDR", Rate: 15600}, nil)
+
+		money, err := rateService.Convert(ctx, model.NewMoney(1000, "USD"), "IDR", rateDate)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(15600000, "IDR"), money)
+	})
+
+	t.Run("Convert with inverse rate", func(t *testing.T) {
+		ctrl, rateService, mockRepo := SetupRateService(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetRate(gomock.Any(), "IDR", "USD", rateDate).Return(model.ExchangeRate{}, sql.ErrNoRows)
+		mockRepo.EXPECT().GetRate(gomock.Any(), "USD", "IDR", rateDate).Return(model.ExchangeRate{Base: "USD", Quote: "IDR", Rate: 16000}, nil)
+
+		money, err := rateService.Convert(ctx, model.NewMoney(16000000, "IDR"), "USD", rateDate)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(1000, "USD"), money)
+	})
+
+	t.Run("Convert through default currency", func(t *testing.T) {
+		ctrl, rateService, mockRepo := SetupRateService(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetRate(gomock.Any(), "SGD", "USD", rateDate).Return(model.ExchangeRate{}, sql.ErrNoRows)
+		mockRepo.EXPECT().GetRate(gomock.Any(), "USD", "SGD", rateDate).Return(model.ExchangeRate{}, sql.ErrNoRows)
+		mockRepo.EXPECT().GetRate(gomock.Any(), "SGD", "IDR", rateDate).Return(model.ExchangeRate{Rate: 12000}, nil)
+		mockRepo.EXPECT().GetRate(gomock.Any(), "IDR", "USD", rateDate).Return(model.ExchangeRate{}, sql.ErrNoRows)
+		mockRepo.EXPECT().GetRate(gomock.Any(), "USD", "IDR", rateDate).Return(model.ExchangeRate{Rate: 16000}, nil)
+
+		money, err := rateService.Convert(ctx, model.NewMoney(400, "SGD"), "USD", rateDate)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(300, "USD"), money)
+	})
+
+	t.Run("Convert without rate", func(t *testing.T) {
+		ctrl, rateService, mockRepo := SetupRateService(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetRate(gomock.Any(), "USD", "IDR", rateDate).Return(model.ExchangeRate{}, sql.ErrNoRows)
+		mockRepo.EXPECT().GetRate(gomock.Any(), "IDR", "USD", rateDate).Return(model.ExchangeRate{}, sql.ErrNoRows)
+
+		_, err := rateService.Convert(ctx, model.NewMoney(1000, "USD"), "IDR", rateDate)
+
+		assert.ErrorIs(t, err, service.ErrRateNotFound)
+	})
+
+	t.Run("Convert when rates cannot be read", func(t *testing.T) {
+		ctrl, rateService, mockRepo := SetupRateService(t)
+		defer ctrl.Finish()
+
+		mockRepo.EXPECT().GetRate(gomock.Any(), "SGD", "USD", rateDate).Return(model.ExchangeRate{}, sql.ErrConnDone)
+
+		_, err := rateService.Convert(ctx, model.NewMoney(1000, "SGD"), "USD", rateDate)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.NotErrorIs(t, err, service.ErrRateNotFound)
+	})
+}
+
+func TestDeleteRate(t *testing.T) {
+	ctrl, rateService, mockRepo := SetupRateService(t)
+	defer ctrl.Finish()
+
+	mockRepo.EXPECT().DeleteRate(gomock.Any(), "rate-1").Return(nil)
+	mockRepo.EXPECT().DeleteRate(gomock.Any(), "invalid_id").Return(sql.ErrNoRows)
+
+	t.Run("Delete Rate", func(t *testing.T) {
+		assert.NoError(t, rateService.DeleteRate(ctx, "rate-1"))
+	})
+
+	t.Run("Delete Rate with invalid id", func(t *testing.T) {
+		assert.ErrorIs(t, rateService.DeleteRate(ctx, "invalid_id"), service.ErrRateNotFound)
+	})
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	catRepo "github.com/varomnrg/money-tracker/repository/category"
+	recurringRepo "github.com/varomnrg/money-tracker/repository/recurring"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	userRepo "github.com/varomnrg/money-tracker/repository/user"
+	walletRepo "github.com/varomnrg/money-tracker/repository/wallet"
+	transactionService "github.com/varomnrg/money-tracker/service/transaction"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type RecurringService struct {
+	recurringRepo recurringRepo.IRecurringRepository
+	walletRepo    walletRepo.IWalletRepository
+	categoryRepo  catRepo.ICategoryRepository
+	userRepo      userRepo.IUserRepository
+	transactions  transactionService.ITransactionCreator
+	uow           unitofwork.IUnitOfWork
+}
+
+// Wallets and categories are looked up from the request body, so their
+// absence is a validation error rather than a missing resource.
+var (
+	ErrUserNotFound      = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrRecurringNotFound = model.NewError(model.CodeNotFound, "recurring transaction cannot be found")
+	ErrWalletNotFound    = model.NewError(model.CodeValidation, "wallet cannot be found")
+	ErrCategoryNotFound  = model.NewError(model.CodeValidation, "category cannot be found")
+	ErrInvalidAmount     = model.NewError(model.CodeValidation, "amount must be greater than zero")
+	ErrInvalidWeekdays   = model.NewError(model.CodeValidation, "weekdays can only be given for weekly schedules")
+	ErrInvalidUntil      = model.NewError(model.CodeValidation, "until cannot be before start_date")
+	ErrRecurringEnded    = model.NewError(model.CodeConflict, "recurring transaction has no occurrences left")
+	ErrRecurringChanged  = model.NewError(model.CodeConflict, "recurring transaction changed meanwhile, try again")
+)
+
+func NewRecurringService(
+	recurringRepo recurringRepo.IRecurringRepository,
+	walletRepo walletRepo.IWalletRepository,
+	categoryRepo catRepo.ICategoryRepository,
+	userRepo userRepo.IUserRepository,
+	transactions transactionService.ITransactionCreator,
+	uow unitofwork.IUnitOfWork,
+) *RecurringService {
+	return &RecurringService{
+		recurringRepo: recurringRepo,
+		walletRepo:    walletRepo,
+		categoryRepo:  categoryRepo,
+		userRepo:      userRepo,
+		transactions:  transactions,
+		uow:           uow,
+	}
+}
+
+func (s *RecurringService) GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error) {
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return []model.RecurringTransaction{}, ErrUserNotFound
+	}
+
+	return s.recurringRepo.GetUserRecurringTransactions(ctx, userID)
+}
+
+func (s *RecurringService) GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error) {
+	recurring, err := s.recurringRepo.GetRecurringTransaction(ctx, id)
+
+	if err != nil {
+		return model.RecurringTransaction{}, ErrRecurringNotFound
+	}
+
+	return recurring, nil
+}
+
+func (s *RecurringService) CreateRecurringTransaction(ctx context.Context, userID string, recurring model.RecurringTransactionRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.userRepo.GetUser(ctx, userID)
+
+		if err != nil {
+			return ErrUserNotFound
+		}
+
+		recurring, amount, err := s.checkRequest(ctx, userID, recurring)
+		if err != nil {
+			return err
+		}
+
+		newRecurring := model.RecurringTransaction{
+			ID:          "rec-" + utils.GenerateRandomID(10),
+			User_ID:     userID,
+			Wallet_ID:   recurring.Wallet_ID,
+			Category_ID: recurring.Category_ID,
+			Type:        recurring.Type,
+			Amount:      amount,
+			Description: recurring.Description,
+			Recurrence:  recurring.Recurrence,
+			Created_At:  utils.GetCurrentTime(),
+		}
+		newRecurring.Next_Date = next(newRecurring)
+
+		return s.recurringRepo.CreateRecurringTransaction(ctx, newRecurring)
+	})
+}
+
+// UpdateRecurringTransaction leaves the transactions already materialized
+// alone. The schedule picks up after the last occurrence handled, so moving
+// the start date neither repeats nor back-fills occurrences.
+func (s *RecurringService) UpdateRecurringTransaction(ctx context.Context, id string, recurring model.RecurringTransactionRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.recurringRepo.GetRecurringTransaction(ctx, id)
+
+		if err != nil {
+			return ErrRecurringNotFound
+		}
+
+		recurring, amount, err := s.checkRequest(ctx, existing.User_ID, recurring)
+		if err != nil {
+			return err
+		}
+
+		updated := existing
+		updated.Wallet_ID = recurring.Wallet_ID
+		updated.Category_ID = recurring.Category_ID
+		updated.Type = recurring.Type
+		updated.Amount = amount
+		updated.Description = recurring.Description
+		updated.Recurrence = recurring.Recurrence
+		updated.Next_Date = next(updated)
+
+		return s.save(ctx, updated, existing.Occurrences)
+	})
+}
+
+func (s *RecurringService) DeleteRecurringTransaction(ctx context.Context, id string) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.recurringRepo.GetRecurringTransaction(ctx, id)
+
+		if err != nil {
+			return ErrRecurringNotFound
+		}
+
+		return s.recurringRepo.DeleteRecurringTransaction(ctx, id)
+	})
+}
+
+func (s *RecurringService) PauseRecurringTransaction(ctx context.Context, id string) error {
+	return s.change(ctx, id, func(recurring *model.RecurringTransaction) error {
+		recurring.Paused = true
+
+		return nil
+	})
+}
+
+func (s *RecurringService) ResumeRecurringTransaction(ctx context.Context, id string) error {
+	now := utils.GetCurrentTime()
+
+	return s.change(ctx, id, func(recurring *model.RecurringTransaction) error {
+		if !recurring.Paused {
+			return nil
+		}
+
+		recurring.Paused = false
+
+		for recurring.Next_Date != nil && !recurring.Next_Date.After(now) {
+			*recurring = advance(*recurring)
+		}
+
+		return nil
+	})
+}
+
+func (s *RecurringService) SkipRecurringTransaction(ctx context.Context, id string) error {
+	return s.change(ctx, id, func(recurring *model.RecurringTransaction) error {
+		if recurring.Next_Date == nil {
+			return ErrRecurringEnded
+		}
+
+		*recurring = advance(*recurring)
+
+		return nil
+	})
+}
+
+// MaterializeDue catches up on every occurrence missed, oldest first. A
+// recurring transaction whose occurrence cannot be materialized, for
+// instance for want of an exchange rate, is logged and retried on the next
+// call; the others go ahead.
+func (s *RecurringService) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.recurringRepo.GetDueRecurringTransactions(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+
+	for _, recurring := range due {
+		for recurring.Next_Date != nil && !recurring.Next_Date.After(now) {
+			if ctx.Err() != nil {
+				return created, ctx.Err()
+			}
+
+			advanced, err := s.materialize(ctx, recurring)
+			if errors.Is(err, ErrRecurringChanged) {
+				break
+			}
+			if err != nil {
+				slog.Error("Materializing recurring transaction", "recurring", recurring.ID, "due", recurring.Next_Date.Format(time.RFC3339), "error", err)
+				break
+			}
+
+			recurring = advanced
+			created++
+		}
+	}
+
+	return created, nil
+}
+
+// materialize creates the transaction of the next occurrence of recurring
+// and moves the schedule past it, both or neither. Moving the schedule only
+// succeeds while the occurrence is still unhandled, so running concurrently
+// with another scheduler or a skip does not create it twice; the loser gets
+// ErrRecurringChanged.
+func (s *RecurringService) materialize(ctx context.Context, recurring model.RecurringTransaction) (model.RecurringTransaction, error) {
+	advanced := advance(recurring)
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		err := s.save(ctx, advanced, recurring.Occurrences)
+		if err != nil {
+			return err
+		}
+
+		return s.transactions.CreateTransaction(ctx, recurring.User_ID, model.TransactionRequest{
+			Wallet_ID:        recurring.Wallet_ID,
+			Category_ID:      recurring.Category_ID,
+			Type:             recurring.Type,
+			Amount:           model.NewMoneyRequest(recurring.Amount),
+			Description:      recurring.Description,
+			Transaction_Date: *recurring.Next_Date,
+		})
+	})
+
+	return advanced, err
+}
+
+// change applies fn to the recurring transaction with the given id and saves
+// the result.
+func (s *RecurringService) change(ctx context.Context, id string, fn func(recurring *model.RecurringTransaction) error) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		recurring, err := s.recurringRepo.GetRecurringTransaction(ctx, id)
+
+		if err != nil {
+			return ErrRecurringNotFound
+		}
+
+		occurrences := recurring.Occurrences
+
+		err = fn(&recurring)
+		if err != nil {
+			return err
+		}
+
+		return s.save(ctx, recurring, occurrences)
+	})
+}
+
+// save stores recurring, provided no occurrence was handled since it was
+// read with occurrences occurrences.
+func (s *RecurringService) save(ctx context.Context, recurring model.RecurringTransaction, occurrences int) error {
+	updated, err := s.recurringRepo.UpdateRecurringTransaction(ctx, recurring, occurrences)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return ErrRecurringChanged
+	}
+
+	return nil
+}
+
+// checkRequest checks that the schedule is consistent, that the wallet and
+// category exist and belong to userID and that the amount is positive. It
+// returns the request with the defaults filled in, and the amount in the
+// wallet's currency unless the request states one.
+func (s *RecurringService) checkRequest(ctx context.Context, userID string, recurring model.RecurringTransactionRequest) (model.RecurringTransactionRequest, model.Money, error) {
+	if len(recurring.Weekdays) > 0 && recurring.Frequency != model.FrequencyWeekly {
+		return recurring, model.Money{}, ErrInvalidWeekdays
+	}
+
+	if recurring.Until != nil && recurring.Until.Before(recurring.Start_Date) {
+		return recurring, model.Money{}, ErrInvalidUntil
+	}
+
+	wallet, err := s.walletRepo.GetWallet(ctx, recurring.Wallet_ID)
+
+	if err != nil || wallet.User_ID != userID {
+		return recurring, model.Money{}, ErrWalletNotFound
+	}
+
+	category, err := s.categoryRepo.GetCategory(ctx, recurring.Category_ID)
+
+	if err != nil || category.User_ID != userID {
+		return recurring, model.Money{}, ErrCategoryNotFound
+	}
+
+	amount, err := recurring.Amount.Money(wallet.Currency)
+	if err != nil {
+		return recurring, model.Money{}, err
+	}
+
+	if !amount.IsPositive() {
+		return recurring, model.Money{}, ErrInvalidAmount
+	}
+
+	if recurring.Interval == 0 {
+		recurring.Interval = 1
+	}
+
+	if len(recurring.Weekdays) == 0 {
+		recurring.Weekdays = nil
+	}
+
+	return recurring, amount, nil
+}
+
+// next returns the occurrence of recurring due after those already handled,
+// reckoning days and months in the local time zone.
+func next(recurring model.RecurringTransaction) *time.Time {
+	schedule := recurring.Recurrence
+	schedule.Start_Date = schedule.Start_Date.In(utils.GetCurrentTime().Location())
+
+	return schedule.Next(recurring.Last_Date, recurring.Occurrences)
+}
+
+// advance returns recurring with its next occurrence handled.
+func advance(recurring model.RecurringTransaction) model.RecurringTransaction {
+	recurring.Last_Date = recurring.Next_Date
+	recurring.Occurrences++
+	recurring.Next_Date = next(recurring)
+
+	return recurring
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// IMaterializer turns the occurrences of recurring transactions that have
+// fallen due into transactions.
+type IMaterializer interface {
+	// MaterializeDue creates a transaction for every occurrence due at or
+	// before now that has not been materialized yet, and returns how many it
+	// created.
+	MaterializeDue(ctx context.Context, now time.Time) (int, error)
+}
+
+type IRecurringService interface {
+	IMaterializer
+	GetUserRecurringTransactions(ctx context.Context, userID string) ([]model.RecurringTransaction, error)
+	GetRecurringTransaction(ctx context.Context, id string) (model.RecurringTransaction, error)
+	CreateRecurringTransaction(ctx context.Context, userID string, recurring model.RecurringTransactionRequest) error
+	UpdateRecurringTransaction(ctx context.Context, id string, recurring model.RecurringTransactionRequest) error
+	DeleteRecurringTransaction(ctx context.Context, id string) error
+	PauseRecurringTransaction(ctx context.Context, id string) error
+	// ResumeRecurringTransaction skips the occurrences that fell due while
+	// the recurring transaction was paused.
+	ResumeRecurringTransaction(ctx context.Context, id string) error
+	// SkipRecurringTransaction skips the next occurrence.
+	SkipRecurringTransaction(ctx context.Context, id string) error
+}
@@ -0,0 +1,362 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mockCatRepo "github.com/varomnrg/money-tracker/mocks/repository/category"
+	mockRecurringRepo "github.com/varomnrg/money-tracker/mocks/repository/recurring"
+	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
+	mockWalletRepo "github.com/varomnrg/money-tracker/mocks/repository/wallet"
+	mockTransactionService "github.com/varomnrg/money-tracker/mocks/service/transaction"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	service "github.com/varomnrg/money-tracker/service/recurring"
+	"github.com/varomnrg/money-tracker/utils"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+type recurringMocks struct {
+	recurringRepo *mockRecurringRepo.MockIRecurringRepository
+	walletRepo    *mockWalletRepo.MockIWalletRepository
+	catRepo       *mockCatRepo.MockICategoryRepository
+	userRepo      *mockUserRepo.MockIUserRepository
+	transactions  *mockTransactionService.MockITransactionCreator
+}
+
+func SetupRecurringService(t *testing.T) (*gomock.Controller, *service.RecurringService, recurringMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := recurringMocks{
+		recurringRepo: mockRecurringRepo.NewMockIRecurringRepository(ctrl),
+		walletRepo:    mockWalletRepo.NewMockIWalletRepository(ctrl),
+		catRepo:       mockCatRepo.NewMockICategoryRepository(ctrl),
+		userRepo:      mockUserRepo.NewMockIUserRepository(ctrl),
+		transactions:  mockTransactionService.NewMockITransactionCreator(ctrl),
+	}
+	recurringService := service.NewRecurringService(mocks.recurringRepo, mocks.walletRepo, mocks.catRepo, mocks.userRepo, mocks.transactions, unitofwork.NewNopUnitOfWork())
+
+	return ctrl, recurringService, mocks
+}
+
+// day returns the local midnight of the given day.
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, utils.GetCurrentTime().Location())
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+// rentRequest returns a monthly rent of user-1 starting on 2024-01-01.
+func rentRequest() model.RecurringTransactionRequest {
+	return model.RecurringTransactionRequest{
+		Wallet_ID:   "wallet-1",
+		Category_ID: "cat-rent",
+		Type:        model.TransactionTypeExpense,
+		Amount:      model.MoneyRequest{Amount: "2500000.00"},
+		Description: "Rent",
+		Recurrence: model.Recurrence{
+			Frequency:  model.FrequencyMonthly,
+			Start_Date: day(2024, time.January, 1),
+		},
+	}
+}
+
+// rent returns the recurring transaction created from rentRequest with the
+// given number of occurrences handled.
+func rent(occurrences int) model.RecurringTransaction {
+	recurring := model.RecurringTransaction{
+		ID:          "rec-1",
+		User_ID:     "user-1",
+		Wallet_ID:   "wallet-1",
+		Category_ID: "cat-rent",
+		Type:        model.TransactionTypeExpense,
+		Amount:      model.NewMoney(250000000, "IDR"),
+		Description: "Rent",
+		Recurrence: model.Recurrence{
+			Frequency:  model.FrequencyMonthly,
+			Interval:   1,
+			Start_Date: day(2024, time.January, 1),
+		},
+		Next_Date:   ptr(day(2024, time.Month(occurrences+1), 1)),
+		Occurrences: occurrences,
+	}
+
+	if occurrences > 0 {
+		recurring.Last_Date = ptr(day(2024, time.Month(occurrences), 1))
+	}
+
+	return recurring
+}
+
+// ownedByUser expects lookups of the wallet and category of rentRequest,
+// both belonging to user-1.
+func ownedByUser(mocks recurringMocks) {
+	mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR"}, nil).AnyTimes()
+	mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-rent").Return(model.Category{ID: "cat-rent", User_ID: "user-1"}, nil).AnyTimes()
+}
+
+func TestCreateRecurringTransaction(t *testing.T) {
+	ctrl, recurringService, mocks := SetupRecurringService(t)
+	defer ctrl.Finish()
+	ownedByUser(mocks)
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil).AnyTimes()
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, sql.ErrNoRows)
+	mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-2").Return(model.Wallet{ID: "wallet-2", User_ID: "user-2"}, nil)
+	mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "invalid_id").Return(model.Category{}, sql.ErrNoRows)
+
+	t.Run("Create Recurring Transaction", func(t *testing.T) {
+		var created model.RecurringTransaction
+		mocks.recurringRepo.EXPECT().CreateRecurringTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, recurring model.RecurringTransaction) error {
+				created = recurring
+				return nil
+			},
+		)
+
+		err := recurringService.CreateRecurringTransaction(ctx, "user-1", rentRequest())
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(created.ID, "rec-"))
+		assert.Equal(t, "user-1", created.User_ID)
+		assert.Equal(t, model.NewMoney(250000000, "IDR"), created.Amount, "Expected the wallet's currency by default")
+		assert.Equal(t, 1, created.Interval)
+		assert.Equal(t, ptr(day(2024, time.January, 1)), created.Next_Date, "Expected the start date to be the first occurrence")
+		assert.Nil(t, created.Last_Date)
+	})
+
+	t.Run("Create Recurring Transaction without currency in a JPY wallet", func(t *testing.T) {
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-jpy").Return(model.Wallet{ID: "wallet-jpy", User_ID: "user-1", Currency: "JPY"}, nil)
+		mocks.recurringRepo.EXPECT().CreateRecurringTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, recurring model.RecurringTransaction) error {
+				assert.Equal(t, model.NewMoney(1500, "JPY"), recurring.Amount, "Expected the amount in whole yen")
+				return nil
+			},
+		)
+
+		request := rentRequest()
+		request.Wallet_ID = "wallet-jpy"
+		request.Amount = model.MoneyRequest{Amount: "1500"}
+
+		assert.NoError(t, recurringService.CreateRecurringTransaction(ctx, "user-1", request))
+	})
+
+	t.Run("Create Recurring Transaction without currency in a KWD wallet", func(t *testing.T) {
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-kwd").Return(model.Wallet{ID: "wallet-kwd", User_ID: "user-1", Currency: "KWD"}, nil)
+		mocks.recurringRepo.EXPECT().CreateRecurringTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, recurring model.RecurringTransaction) error {
+				assert.Equal(t, model.NewMoney(1500, "KWD"), recurring.Amount, "Expected the amount in fils")
+				return nil
+			},
+		)
+
+		request := rentRequest()
+		request.Wallet_ID = "wallet-kwd"
+		request.Amount = model.MoneyRequest{Amount: "1.5"}
+
+		assert.NoError(t, recurringService.CreateRecurringTransaction(ctx, "user-1", request))
+	})
+
+	items := []struct {
+		name    string
+		userID  string
+		request func(*model.RecurringTransactionRequest)
+		err     error
+	}{
+		{"with invalid user id", "invalid_id", func(*model.RecurringTransactionRequest) {}, service.ErrUserNotFound},
+		{"with zero amount", "user-1", func(r *model.RecurringTransactionRequest) { r.Amount = model.MoneyRequest{Amount: "0"} }, service.ErrInvalidAmount},
+		{"with weekdays on a monthly schedule", "user-1", func(r *model.RecurringTransactionRequest) { r.Weekdays = []string{"MO"} }, service.ErrInvalidWeekdays},
+		{"ending before it starts", "user-1", func(r *model.RecurringTransactionRequest) { r.Until = ptr(day(2023, time.December, 31)) }, service.ErrInvalidUntil},
+		{"in another user's wallet", "user-1", func(r *model.RecurringTransactionRequest) { r.Wallet_ID = "wallet-2" }, service.ErrWalletNotFound},
+		{"with invalid category id", "user-1", func(r *model.RecurringTransactionRequest) { r.Category_ID = "invalid_id" }, service.ErrCategoryNotFound},
+	}
+
+	for _, tt := range items {
+		t.Run("Create Recurring Transaction "+tt.name, func(t *testing.T) {
+			request := rentRequest()
+			tt.request(&request)
+
+			err := recurringService.CreateRecurringTransaction(ctx, tt.userID, request)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestUpdateRecurringTransaction(t *testing.T) {
+	ctrl, recurringService, mocks := SetupRecurringService(t)
+	defer ctrl.Finish()
+	ownedByUser(mocks)
+
+	mocks.recurringRepo.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(rent(2), nil).Times(2)
+	mocks.recurringRepo.EXPECT().GetRecurringTransaction(gomock.Any(), "invalid_id").Return(model.RecurringTransaction{}, sql.ErrNoRows)
+
+	t.Run("Update Recurring Transaction", func(t *testing.T) {
+		request := rentRequest()
+		request.Amount = model.MoneyRequest{Amount: "2750000.00", Currency: "IDR"}
+		request.Start_Date = day(2023, time.December, 15)
+
+		expected := rent(2)
+		expected.Amount = model.NewMoney(275000000, "IDR")
+		expected.Start_Date = day(2023, time.December, 15)
+		expected.Next_Date = ptr(day(2024, time.February, 15))
+
+		mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), expected, 2).Return(true, nil)
+
+		err := recurringService.UpdateRecurringTransaction(ctx, "rec-1", request)
+
+		assert.NoError(t, err, "Expected the schedule to pick up after the last occurrence handled")
+	})
+
+	t.Run("Update Recurring Transaction materialized meanwhile", func(t *testing.T) {
+		mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), gomock.Any(), 2).Return(false, nil)
+
+		err := recurringService.UpdateRecurringTransaction(ctx, "rec-1", rentRequest())
+
+		assert.ErrorIs(t, err, service.ErrRecurringChanged)
+	})
+
+	t.Run("Update Recurring Transaction with invalid id", func(t *testing.T) {
+		err := recurringService.UpdateRecurringTransaction(ctx, "invalid_id", rentRequest())
+
+		assert.ErrorIs(t, err, service.ErrRecurringNotFound)
+	})
+}
+
+func TestPauseRecurringTransaction(t *testing.T) {
+	ctrl, recurringService, mocks := SetupRecurringService(t)
+	defer ctrl.Finish()
+
+	paused := rent(1)
+	paused.Paused = true
+
+	mocks.recurringRepo.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(rent(1), nil)
+	mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), paused, 1).Return(true, nil)
+
+	assert.NoError(t, recurringService.PauseRecurringTransaction(ctx, "rec-1"))
+}
+
+func TestResumeRecurringTransaction(t *testing.T) {
+	ctrl, recurringService, mocks := SetupRecurringService(t)
+	defer ctrl.Finish()
+
+	paused := rent(1)
+	paused.Paused = true
+
+	mocks.recurringRepo.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(paused, nil)
+	mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), gomock.Any(), 1).DoAndReturn(
+		func(_ context.Context, recurring model.RecurringTransaction, _ int) (bool, error) {
+			assert.False(t, recurring.Paused)
+			assert.True(t, recurring.Next_Date.After(utils.GetCurrentTime()), "Expected the occurrences missed while paused to be skipped")
+			assert.Equal(t, 1, recurring.Next_Date.Day())
+			assert.Greater(t, recurring.Occurrences, 1)
+			return true, nil
+		},
+	)
+
+	assert.NoError(t, recurringService.ResumeRecurringTransaction(ctx, "rec-1"))
+}
+
+func TestSkipRecurringTransaction(t *testing.T) {
+	ctrl, recurringService, mocks := SetupRecurringService(t)
+	defer ctrl.Finish()
+
+	ended := rent(3)
+	ended.Next_Date = nil
+
+	mocks.recurringRepo.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-1").Return(rent(1), nil)
+	mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), rent(2), 1).Return(true, nil)
+	mocks.recurringRepo.EXPECT().GetRecurringTransaction(gomock.Any(), "rec-ended").Return(ended, nil)
+	mocks.recurringRepo.EXPECT().GetRecurringTransaction(gomock.Any(), "invalid_id").Return(model.RecurringTransaction{}, sql.ErrNoRows)
+
+	t.Run("Skip Recurring Transaction", func(t *testing.T) {
+		assert.NoError(t, recurringService.SkipRecurringTransaction(ctx, "rec-1"))
+	})
+
+	t.Run("Skip ended Recurring Transaction", func(t *testing.T) {
+		assert.ErrorIs(t, recurringService.SkipRecurringTransaction(ctx, "rec-ended"), service.ErrRecurringEnded)
+	})
+
+	t.Run("Skip Recurring Transaction with invalid id", func(t *testing.T) {
+		assert.ErrorIs(t, recurringService.SkipRecurringTransaction(ctx, "invalid_id"), service.ErrRecurringNotFound)
+	})
+}
+
+func TestMaterializeDue(t *testing.T) {
+	ctrl, recurringService, mocks := SetupRecurringService(t)
+	defer ctrl.Finish()
+
+	now := day(2024, time.March, 15)
+
+	limited := rent(0)
+	limited.Count = 2
+
+	taken := rent(0)
+	taken.ID = "rec-taken"
+
+	failing := rent(0)
+	failing.ID = "rec-failing"
+	failing.Wallet_ID = "wallet-missing"
+
+	mocks.recurringRepo.EXPECT().GetDueRecurringTransactions(gomock.Any(), now).Return([]model.RecurringTransaction{limited, taken, failing}, nil)
+
+	gomock.InOrder(
+		mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), gomock.Any(), 0).DoAndReturn(
+			func(_ context.Context, recurring model.RecurringTransaction, _ int) (bool, error) {
+				assert.Equal(t, ptr(day(2024, time.February, 1)), recurring.Next_Date)
+				return true, nil
+			},
+		),
+		mocks.transactions.EXPECT().CreateTransaction(gomock.Any(), "user-1", model.TransactionRequest{
+			Wallet_ID:        "wallet-1",
+			Category_ID:      "cat-rent",
+			Type:             model.TransactionTypeExpense,
+			Amount:           model.MoneyRequest{Amount: "2500000.00", Currency: "IDR"},
+			Description:      "Rent",
+			Transaction_Date: day(2024, time.January, 1),
+		}).Return(nil),
+		mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), gomock.Any(), 1).DoAndReturn(
+			func(_ context.Context, recurring model.RecurringTransaction, _ int) (bool, error) {
+				assert.Nil(t, recurring.Next_Date, "Expected the schedule to end after count occurrences")
+				return true, nil
+			},
+		),
+		mocks.transactions.EXPECT().CreateTransaction(gomock.Any(), "user-1", gomock.Any()).Return(nil),
+	)
+
+	// Another run materialized this one first.
+	mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), gomock.Cond(func(x any) bool {
+		return x.(model.RecurringTransaction).ID == "rec-taken"
+	}), 0).Return(false, nil)
+
+	mocks.recurringRepo.EXPECT().UpdateRecurringTransaction(gomock.Any(), gomock.Cond(func(x any) bool {
+		return x.(model.RecurringTransaction).ID == "rec-failing"
+	}), 0).Return(true, nil)
+	mocks.transactions.EXPECT().CreateTransaction(gomock.Any(), "user-1", gomock.Any()).Return(errors.New("wallet cannot be found"))
+
+	created, err := recurringService.MaterializeDue(ctx, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, created, "Expected only the occurrences of the first one to be created")
+}
+
+func TestMaterializeDue_RepositoryError(t *testing.T) {
+	ctrl, recurringService, mocks := SetupRecurringService(t)
+	defer ctrl.Finish()
+
+	mocks.recurringRepo.EXPECT().GetDueRecurringTransactions(gomock.Any(), gomock.Any()).Return(nil, sql.ErrConnDone)
+
+	created, err := recurringService.MaterializeDue(ctx, day(2024, time.March, 15))
+
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.Zero(t, created)
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+// Scheduler materializes due recurring transactions in the background.
+type Scheduler struct {
+	materializer IMaterializer
+	interval     time.Duration
+}
+
+// NewScheduler checks for due occurrences every interval. A zero interval
+// disables Run, leaving RunOnce to the caller.
+func NewScheduler(materializer IMaterializer, interval time.Duration) *Scheduler {
+	return &Scheduler{materializer: materializer, interval: interval}
+}
+
+// Run calls RunOnce straight away and then every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce materializes the occurrences due now.
+func (s *Scheduler) RunOnce(ctx context.Context) {
+	created, err := s.materializer.MaterializeDue(ctx, utils.GetCurrentTime())
+
+	if created > 0 {
+		slog.Info("Materialized recurring transactions", "count", created)
+	}
+
+	if err != nil && ctx.Err() == nil {
+		slog.Error("Materializing recurring transactions", "error", err)
+	}
+}
@@ -0,0 +1,51 @@
+package service_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	service "github.com/varomnrg/money-tracker/service/recurring"
+)
+
+// countingMaterializer counts its calls.
+type countingMaterializer struct {
+	calls atomic.Int32
+}
+
+func (m *countingMaterializer) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	m.calls.Add(1)
+	return 0, nil
+}
+
+func TestScheduler_Run(t *testing.T) {
+	materializer := &countingMaterializer{}
+	scheduler := service.NewScheduler(materializer, time.Millisecond)
+	runCtx, cancel := context.WithCancel(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scheduler.Run(runCtx)
+	}()
+
+	assert.Eventually(t, func() bool { return materializer.calls.Load() >= 3 }, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Run to return once its context is cancelled")
+	}
+}
+
+func TestScheduler_RunDisabled(t *testing.T) {
+	materializer := &countingMaterializer{}
+
+	service.NewScheduler(materializer, 0).Run(ctx)
+
+	assert.Zero(t, materializer.calls.Load(), "Expected a zero interval to disable the scheduler")
+}
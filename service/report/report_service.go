@@ -0,0 +1,127 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	trxRepo "github.com/varomnrg/money-tracker/repository/transaction"
+	userRepo "github.com/varomnrg/money-tracker/repository/user"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+)
+
+type ReportService struct {
+	transactionRepo trxRepo.ITransactionRepository
+	userRepo        userRepo.IUserRepository
+	converter       rateService.IConverter
+}
+
+var (
+	ErrUserNotFound  = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrInvalidPeriod = model.NewError(model.CodeValidation, "period must end after it starts")
+	ErrRateNotFound  = model.NewError(model.CodeValidation, "exchange rate cannot be found")
+)
+
+func NewReportService(transactionRepo trxRepo.ITransactionRepository, userRepo userRepo.IUserRepository, converter rateService.IConverter) *ReportService {
+	return &ReportService{
+		transactionRepo: transactionRepo,
+		userRepo:        userRepo,
+		converter:       converter,
+	}
+}
+
+// GetSummary reports the user's income and expenses dated in [from, to) in
+// currency. The repository sums each category per currency; totals in other
+// currencies are converted at the rate in effect on the last day of the
+// period.
+func (s *ReportService) GetSummary(ctx context.Context, userID string, from time.Time, to time.Time, currency string) (model.Summary, error) {
+	if !to.After(from) {
+		return model.Summary{}, ErrInvalidPeriod
+	}
+
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return model.Summary{}, ErrUserNotFound
+	}
+
+	totals, err := s.transactionRepo.GetCategoryTotals(ctx, userID, from, to)
+	if err != nil {
+		return model.Summary{}, err
+	}
+
+	summary := model.Summary{
+		From:       from,
+		To:         to,
+		Currency:   currency,
+		Income:     model.NewMoney(0, currency),
+		Expense:    model.NewMoney(0, currency),
+		Categories: make([]model.CategorySummary, 0, len(totals)),
+	}
+
+	// Totals arrive ordered by category and type, so the currencies of one
+	// category and type are adjacent.
+	for _, total := range totals {
+		amount, err := s.converter.Convert(ctx, total.Total, currency, to.AddDate(0, 0, -1))
+		if errors.Is(err, rateService.ErrRateNotFound) {
+			return model.Summary{}, ErrRateNotFound
+		}
+		if err != nil {
+			return model.Summary{}, err
+		}
+
+		last := len(summary.Categories) - 1
+		if last < 0 || summary.Categories[last].Category_ID != total.Category_ID || summary.Categories[last].Type != total.Type {
+			summary.Categories = append(summary.Categories, model.CategorySummary{
+				Category_ID: total.Category_ID,
+				Name:        total.Category_Name,
+				Type:        total.Type,
+				Total:       model.NewMoney(0, currency),
+			})
+			last++
+		}
+
+		// Every amount is in currency by now, so adding cannot fail.
+		summary.Categories[last].Total, _ = summary.Categories[last].Total.Add(amount)
+
+		if total.Type == model.TransactionTypeIncome {
+			summary.Income, _ = summary.Income.Add(amount)
+		} else {
+			summary.Expense, _ = summary.Expense.Add(amount)
+		}
+	}
+
+	summary.Net, _ = summary.Income.Sub(summary.Expense)
+
+	for i, category := range summary.Categories {
+		typeTotal := summary.Expense
+		if category.Type == model.TransactionTypeIncome {
+			typeTotal = summary.Income
+		}
+
+		summary.Categories[i].Percentage = percentage(category.Total, typeTotal)
+	}
+
+	slices.SortStableFunc(summary.Categories, func(a, b model.CategorySummary) int {
+		if c := cmp.Compare(a.Type, b.Type); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(b.Total.Amount, a.Total.Amount)
+	})
+
+	return summary, nil
+}
+
+// percentage returns part as a percentage of whole, rounded to two decimals.
+func percentage(part model.Money, whole model.Money) float64 {
+	if whole.IsZero() {
+		return 0
+	}
+
+	return math.Round(float64(part.Amount)/float64(whole.Amount)*10000) / 100
+}
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IReportService interface {
+	GetSummary(ctx context.Context, userID string, from time.Time, to time.Time, currency string) (model.Summary, error)
+}
@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mockTrxRepo "github.com/varomnrg/money-tracker/mocks/repository/transaction"
+	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
+	mockRateService "github.com/varomnrg/money-tracker/mocks/service/rate"
+	"github.com/varomnrg/money-tracker/model"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	service "github.com/varomnrg/money-tracker/service/report"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	ctx      = context.Background()
+	january  = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	february = time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type reportMocks struct {
+	trxRepo   *mockTrxRepo.MockITransactionRepository
+	userRepo  *mockUserRepo.MockIUserRepository
+	converter *mockRateService.MockIConverter
+}
+
+func SetupReportService(t *testing.T) (*gomock.Controller, *service.ReportService, reportMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := reportMocks{
+		trxRepo:   mockTrxRepo.NewMockITransactionRepository(ctrl),
+		userRepo:  mockUserRepo.NewMockIUserRepository(ctrl),
+		converter: mockRateService.NewMockIConverter(ctrl),
+	}
+
+	return ctrl, service.NewReportService(mocks.trxRepo, mocks.userRepo, mocks.converter), mocks
+}
+
+func TestGetSummary(t *testing.T) {
+	ctrl, reportService, mocks := SetupReportService(t)
+	defer ctrl.Finish()
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil).AnyTimes()
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("sql: no rows in result set"))
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", january, february).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Category_Name: "Food", Type: model.TransactionTypeExpense, Total: model.NewMoney(150000, "IDR")},
+		{Category_ID: "cat-food", Category_Name: "Food", Type: model.TransactionTypeExpense, Total: model.NewMoney(1000, "USD")},
+		{Category_ID: "cat-rent", Category_Name: "Rent", Type: model.TransactionTypeExpense, Total: model.NewMoney(300000, "IDR")},
+		{Category_ID: "cat-salary", Category_Name: "Salary", Type: model.TransactionTypeIncome, Total: model.NewMoney(1000000, "IDR")},
+	}, nil)
+	mocks.converter.EXPECT().Convert(gomock.Any(), gomock.Any(), "IDR", time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)).DoAndReturn(
+		func(_ context.Context, amount model.Money, currency string, _ time.Time) (model.Money, error) {
+			if amount.Currency == "USD" {
+				return amount.Convert(150, currency), nil
+			}
+
+			return amount, nil
+		},
+	).Times(4)
+
+	t.Run("Get Summary", func(t *testing.T) {
+		summary, err := reportService.GetSummary(ctx, "user-1", january, february, "IDR")
+
+		require.NoError(t, err)
+		assert.Equal(t, model.NewMoney(1000000, "IDR"), summary.Income)
+		assert.Equal(t, model.NewMoney(600000, "IDR"), summary.Expense)
+		assert.Equal(t, model.NewMoney(400000, "IDR"), summary.Net)
+		assert.Equal(t, []model.CategorySummary{
+			{Category_ID: "cat-food", Name: "Food", Type: model.TransactionTypeExpense, Total: model.NewMoney(300000, "IDR"), Percentage: 50},
+			{Category_ID: "cat-rent", Name: "Rent", Type: model.TransactionTypeExpense, Total: model.NewMoney(300000, "IDR"), Percentage: 50},
+			{Category_ID: "cat-salary", Name: "Salary", Type: model.TransactionTypeIncome, Total: model.NewMoney(1000000, "IDR"), Percentage: 100},
+		}, summary.Categories)
+	})
+
+	t.Run("Get Summary with invalid period", func(t *testing.T) {
+		_, err := reportService.GetSummary(ctx, "user-1", february, january, "IDR")
+
+		assert.ErrorIs(t, err, service.ErrInvalidPeriod)
+	})
+
+	t.Run("Get Summary with invalid user id", func(t *testing.T) {
+		_, err := reportService.GetSummary(ctx, "invalid_id", january, february, "IDR")
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestGetSummary_WithoutTransactions(t *testing.T) {
+	ctrl, reportService, mocks := SetupReportService(t)
+	defer ctrl.Finish()
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", january, february).Return([]model.CategoryTotal{}, nil)
+
+	summary, err := reportService.GetSummary(ctx, "user-1", january, february, "USD")
+
+	require.NoError(t, err)
+	assert.Equal(t, model.NewMoney(0, "USD"), summary.Net)
+	assert.NotNil(t, summary.Categories)
+	assert.Empty(t, summary.Categories)
+}
+
+func TestGetSummary_WithoutRate(t *testing.T) {
+	ctrl, reportService, mocks := SetupReportService(t)
+	defer ctrl.Finish()
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mocks.trxRepo.EXPECT().GetCategoryTotals(gomock.Any(), "user-1", january, february).Return([]model.CategoryTotal{
+		{Category_ID: "cat-food", Category_Name: "Food", Type: model.TransactionTypeExpense, Total: model.NewMoney(1000, "USD")},
+	}, nil)
+	mocks.converter.EXPECT().Convert(gomock.Any(), gomock.Any(), "IDR", gomock.Any()).Return(model.Money{}, rateService.ErrRateNotFound)
+
+	_, err := reportService.GetSummary(ctx, "user-1", january, february, "IDR")
+
+	assert.ErrorIs(t, err, service.ErrRateNotFound)
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/varomnrg/money-tracker/model"
+	catRepo "github.com/varomnrg/money-tracker/repository/category"
+	trxRepo "github.com/varomnrg/money-tracker/repository/transaction"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	userRepo "github.com/varomnrg/money-tracker/repository/user"
+	walletRepo "github.com/varomnrg/money-tracker/repository/wallet"
+	budgetService "github.com/varomnrg/money-tracker/service/budget"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type TransactionService struct {
+	transactionRepo trxRepo.ITransactionRepository
+	walletRepo      walletRepo.IWalletRepository
+	categoryRepo    catRepo.ICategoryRepository
+	userRepo        userRepo.IUserRepository
+	converter       rateService.IConverter
+	budgetChecker   budgetService.IBudgetChecker
+	uow             unitofwork.IUnitOfWork
+}
+
+// Wallets, categories and rates are looked up from the request body, so
+// their absence is a validation error rather than a missing resource.
+var (
+	ErrUserNotFound        = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrWalletNotFound      = model.NewError(model.CodeValidation, "wallet cannot be found")
+	ErrCategoryNotFound    = model.NewError(model.CodeValidation, "category cannot be found")
+	ErrTransactionNotFound = model.NewError(model.CodeNotFound, "transaction cannot be found")
+	ErrInvalidAmount       = model.NewError(model.CodeValidation, "amount must be greater than zero")
+	ErrRateNotFound        = model.NewError(model.CodeValidation, "exchange rate cannot be found")
+	ErrTransferTransaction = model.NewError(model.CodeValidation, "transfer transactions must be changed through their transfer")
+	ErrAmountRangeCurrency = model.NewError(model.CodeValidation, "min_amount and max_amount must be in the same currency")
+)
+
+func NewTransactionService(
+	transactionRepo trxRepo.ITransactionRepository,
+	walletRepo walletRepo.IWalletRepository,
+	categoryRepo catRepo.ICategoryRepository,
+	userRepo userRepo.IUserRepository,
+	converter rateService.IConverter,
+	budgetChecker budgetService.IBudgetChecker,
+	uow unitofwork.IUnitOfWork,
+) *TransactionService {
+	return &TransactionService{
+		transactionRepo: transactionRepo,
+		walletRepo:      walletRepo,
+		categoryRepo:    categoryRepo,
+		userRepo:        userRepo,
+		converter:       converter,
+		budgetChecker:   budgetChecker,
+		uow:             uow,
+	}
+}
+
+func (s *TransactionService) GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) (model.Page[model.Transaction], error) {
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return model.Page[model.Transaction]{}, ErrUserNotFound
+	}
+
+	err = query.Prepare(model.TransactionSorts...)
+	if err != nil {
+		return model.Page[model.Transaction]{}, err
+	}
+
+	if query.Min_Amount != nil && query.Max_Amount != nil && query.Min_Amount.Currency != query.Max_Amount.Currency {
+		return model.Page[model.Transaction]{}, ErrAmountRangeCurrency
+	}
+
+	fetch := query
+	fetch.ListQuery = query.Lookahead()
+
+	transactions, err := s.transactionRepo.GetUserTransactions(ctx, userID, fetch)
+	if err != nil {
+		return model.Page[model.Transaction]{}, err
+	}
+
+	return model.NewPage(transactions, query.ListQuery), nil
+}
+
+func (s *TransactionService) GetTransaction(ctx context.Context, id string) (model.Transaction, error) {
+	transaction, err := s.transactionRepo.GetTransaction(ctx, id)
+
+	if err != nil {
+		return model.Transaction{}, ErrTransactionNotFound
+	}
+
+	return transaction, nil
+}
+
+func (s *TransactionService) CreateTransaction(ctx context.Context, userID string, transaction model.TransactionRequest) error {
+	var saved model.Transaction
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.userRepo.GetUser(ctx, userID)
+
+		if err != nil {
+			return ErrUserNotFound
+		}
+
+		amount, original, err := s.validateTransaction(ctx, userID, transaction)
+
+		if err != nil {
+			return err
+		}
+
+		id := "trx-" + utils.GenerateRandomID(10)
+
+		newTransaction := model.Transaction{
+			ID:               id,
+			User_ID:          userID,
+			Wallet_ID:        transaction.Wallet_ID,
+			Category_ID:      transaction.Category_ID,
+			Type:             transaction.Type,
+			Amount:           amount,
+			Original_Amount:  original,
+			Description:      transaction.Description,
+			Transaction_Date: transaction.Transaction_Date,
+			Created_At:       utils.GetCurrentTime(),
+		}
+
+		saved = newTransaction
+
+		return s.transactionRepo.CreateTransaction(ctx, newTransaction)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.checkBudget(ctx, saved)
+
+	return nil
+}
+
+func (s *TransactionService) UpdateTransaction(ctx context.Context, id string, transaction model.TransactionRequest) error {
+	var saved model.Transaction
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.transactionRepo.GetTransaction(ctx, id)
+
+		if err != nil {
+			return ErrTransactionNotFound
+		}
+
+		if existing.IsTransfer() {
+			return ErrTransferTransaction
+		}
+
+		amount, original, err := s.validateTransaction(ctx, existing.User_ID, transaction)
+
+		if err != nil {
+			return err
+		}
+
+		existing.Wallet_ID = transaction.Wallet_ID
+		existing.Category_ID = transaction.Category_ID
+		existing.Type = transaction.Type
+		existing.Amount = amount
+		existing.Original_Amount = original
+		existing.Description = transaction.Description
+		existing.Transaction_Date = transaction.Transaction_Date
+
+		saved = existing
+
+		return s.transactionRepo.UpdateTransaction(ctx, id, existing)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.checkBudget(ctx, saved)
+
+	return nil
+}
+
+func (s *TransactionService) DeleteTransaction(ctx context.Context, id string) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.transactionRepo.GetTransaction(ctx, id)
+
+		if err != nil {
+			return ErrTransactionNotFound
+		}
+
+		if existing.IsTransfer() {
+			return ErrTransferTransaction
+		}
+
+		return s.transactionRepo.DeleteTransaction(ctx, id)
+	})
+}
+
+// checkBudget evaluates the budget covering a saved expense. The transaction
+// stands even when that fails, so the error is only logged.
+func (s *TransactionService) checkBudget(ctx context.Context, transaction model.Transaction) {
+	if transaction.Type != model.TransactionTypeExpense {
+		return
+	}
+
+	err := s.budgetChecker.CheckBudget(ctx, transaction.User_ID, transaction.Category_ID, transaction.Transaction_Date)
+	if err != nil {
+		slog.Warn("Checking budget", "category", transaction.Category_ID, "error", err)
+	}
+}
+
+// validateTransaction checks that the amount is positive and that the wallet
+// and category referenced by the request exist and belong to userID. It
+// returns the amount converted into the wallet's currency along with the
+// amount as originally entered.
+func (s *TransactionService) validateTransaction(ctx context.Context, userID string, transaction model.TransactionRequest) (model.Money, model.Money, error) {
+	wallet, err := s.walletRepo.GetWallet(ctx, transaction.Wallet_ID)
+
+	if err != nil || wallet.User_ID != userID {
+		return model.Money{}, model.Money{}, ErrWalletNotFound
+	}
+
+	category, err := s.categoryRepo.GetCategory(ctx, transaction.Category_ID)
+
+	if err != nil || category.User_ID != userID {
+		return model.Money{}, model.Money{}, ErrCategoryNotFound
+	}
+
+	original, err := transaction.Amount.Money(wallet.Currency)
+	if err != nil {
+		return model.Money{}, model.Money{}, err
+	}
+
+	if !original.IsPositive() {
+		return model.Money{}, model.Money{}, ErrInvalidAmount
+	}
+
+	amount, err := s.converter.Convert(ctx, original, wallet.Currency, transaction.Transaction_Date)
+
+	if errors.Is(err, rateService.ErrRateNotFound) {
+		return model.Money{}, model.Money{}, ErrRateNotFound
+	}
+	if err != nil {
+		return model.Money{}, model.Money{}, err
+	}
+
+	return amount, original, nil
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+// ITransactionCreator records transactions on behalf of other services.
+type ITransactionCreator interface {
+	CreateTransaction(ctx context.Context, userID string, transaction model.TransactionRequest) error
+}
+
+type ITransactionService interface {
+	ITransactionCreator
+	GetUserTransactions(ctx context.Context, userID string, query model.TransactionQuery) (model.Page[model.Transaction], error)
+	GetTransaction(ctx context.Context, id string) (model.Transaction, error)
+	UpdateTransaction(ctx context.Context, id string, transaction model.TransactionRequest) error
+	DeleteTransaction(ctx context.Context, id string) error
+}
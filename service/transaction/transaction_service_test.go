@@ -0,0 +1,448 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mockCatRepo "github.com/varomnrg/money-tracker/mocks/repository/category"
+	mockTrxRepo "github.com/varomnrg/money-tracker/mocks/repository/transaction"
+	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
+	mockWalletRepo "github.com/varomnrg/money-tracker/mocks/repository/wallet"
+	mockBudgetService "github.com/varomnrg/money-tracker/mocks/service/budget"
+	mockRateService "github.com/varomnrg/money-tracker/mocks/service/rate"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	service "github.com/varomnrg/money-tracker/service/transaction"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+type transactionMocks struct {
+	trxRepo    *mockTrxRepo.MockITransactionRepository
+	walletRepo *mockWalletRepo.MockIWalletRepository
+	catRepo    *mockCatRepo.MockICategoryRepository
+	userRepo   *mockUserRepo.MockIUserRepository
+	converter  *mockRateService.MockIConverter
+	budgets    *mockBudgetService.MockIBudgetChecker
+}
+
+func SetupTransactionService(t *testing.T) (*gomock.Controller, *service.TransactionService, transactionMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := transactionMocks{
+		trxRepo:    mockTrxRepo.NewMockITransactionRepository(ctrl),
+		walletRepo: mockWalletRepo.NewMockIWalletRepository(ctrl),
+		catRepo:    mockCatRepo.NewMockICategoryRepository(ctrl),
+		userRepo:   mockUserRepo.NewMockIUserRepository(ctrl),
+		converter:  mockRateService.NewMockIConverter(ctrl),
+		budgets:    mockBudgetService.NewMockIBudgetChecker(ctrl),
+	}
+	transactionService := service.NewTransactionService(mocks.trxRepo, mocks.walletRepo, mocks.catRepo, mocks.userRepo, mocks.converter, mocks.budgets, unitofwork.NewNopUnitOfWork())
+
+	return ctrl, transactionService, mocks
+}
+
+var errNoRows = errors.New("sql: no rows in result set")
+
+func newTransactionRequest() model.TransactionRequest {
+	return model.TransactionRequest{
+		Wallet_ID:        "wallet-1",
+		Category_ID:      "cat-1",
+		Type:             model.TransactionTypeExpense,
+		Amount:           model.MoneyRequest{Amount: "25000.00", Currency: "IDR"},
+		Description:      "Lunch",
+		Transaction_Date: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGetUserTransactions(t *testing.T) {
+	ctrl, transactionService, mocks := SetupTransactionService(t)
+	defer ctrl.Finish()
+
+	wallet := model.TransactionQuery{ListQuery: model.ListQuery{Sort: "-date", Limit: model.DefaultPageSize + 1}, Wallet_ID: "wallet-1"}
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil).AnyTimes()
+	mocks.trxRepo.EXPECT().GetUserTransactions(gomock.Any(), "user-1", wallet).Return([]model.Transaction{
+		{ID: "trx-1", User_ID: "user-1", Amount: model.NewMoney(2500000, "IDR")},
+	}, nil)
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errNoRows)
+
+	t.Run("Get User Transactions", func(t *testing.T) {
+		transactions, err := transactionService.GetUserTransactions(ctx, "user-1", model.TransactionQuery{Wallet_ID: "wallet-1"})
+
+		assert.NoError(t, err)
+		assert.Len(t, transactions.Items, 1)
+		assert.Empty(t, transactions.Next_Cursor)
+	})
+
+	t.Run("Get User Transactions with invalid user id", func(t *testing.T) {
+		_, err := transactionService.GetUserTransactions(ctx, "invalid_id", model.TransactionQuery{})
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Get User Transactions with cursor of another sort", func(t *testing.T) {
+		cursor := model.Transaction{ID: "trx-1"}.Cursor("-date")
+		query := model.TransactionQuery{ListQuery: model.ListQuery{Sort: "amount", After: &cursor}}
+
+		_, err := transactionService.GetUserTransactions(ctx, "user-1", query)
+
+		assert.ErrorIs(t, err, model.ErrInvalidCursor)
+	})
+
+	t.Run("Get User Transactions with amounts in different currencies", func(t *testing.T) {
+		min := model.NewMoney(100, "IDR")
+		max := model.NewMoney(100, "USD")
+
+		_, err := transactionService.GetUserTransactions(ctx, "user-1", model.TransactionQuery{Min_Amount: &min, Max_Amount: &max})
+
+		assert.ErrorIs(t, err, service.ErrAmountRangeCurrency)
+	})
+}
+
+func TestGetTransaction(t *testing.T) {
+	ctrl, transactionService, mocks := SetupTransactionService(t)
+	defer ctrl.Finish()
+
+	mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "trx-1").Return(model.Transaction{ID: "trx-1", Amount: model.NewMoney(2500000, "IDR")}, nil)
+	mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "invalid_id").Return(model.Transaction{}, errNoRows)
+
+	t.Run("Get Transaction", func(t *testing.T) {
+		transaction, err := transactionService.GetTransaction(ctx, "trx-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "25000.00", transaction.Amount.Decimal())
+	})
+
+	t.Run("Get Transaction with invalid id", func(t *testing.T) {
+		_, err := transactionService.GetTransaction(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrTransactionNotFound)
+	})
+}
+
+func TestCreateTransaction(t *testing.T) {
+	t.Run("Create Transaction", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(2500000, "IDR"), "IDR", gomock.Any()).Return(model.NewMoney(2500000, "IDR"), nil)
+		mocks.trxRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transaction model.Transaction) error {
+			assert.Equal(t, "user-1", transaction.User_ID)
+			assert.Equal(t, model.NewMoney(-2500000, "IDR"), transaction.BalanceDelta(), "Expected expense to decrease the balance")
+			return nil
+		})
+		mocks.budgets.EXPECT().CheckBudget(gomock.Any(), "user-1", "cat-1", newTransactionRequest().Transaction_Date).Return(nil)
+
+		err := transactionService.CreateTransaction(ctx, "user-1", newTransactionRequest())
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transaction when checking the budget fails", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), gomock.Any(), "IDR", gomock.Any()).Return(model.NewMoney(2500000, "IDR"), nil)
+		mocks.trxRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).Return(nil)
+		mocks.budgets.EXPECT().CheckBudget(gomock.Any(), "user-1", "cat-1", gomock.Any()).Return(errors.New("exchange rate cannot be found"))
+
+		err := transactionService.CreateTransaction(ctx, "user-1", newTransactionRequest())
+
+		assert.NoError(t, err, "Expected the saved transaction to stand")
+	})
+
+	t.Run("Create income does not check the budget", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), gomock.Any(), "IDR", gomock.Any()).Return(model.NewMoney(2500000, "IDR"), nil)
+		mocks.trxRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).Return(nil)
+
+		request := newTransactionRequest()
+		request.Type = model.TransactionTypeIncome
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transaction without currency uses wallet currency", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(2500000, "IDR"), "IDR", gomock.Any()).Return(model.NewMoney(2500000, "IDR"), nil)
+		mocks.trxRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transaction model.Transaction) error {
+			assert.Equal(t, "IDR", transaction.Amount.Currency)
+			assert.Equal(t, "IDR", transaction.Original_Amount.Currency)
+			return nil
+		})
+		mocks.budgets.EXPECT().CheckBudget(gomock.Any(), "user-1", "cat-1", gomock.Any()).Return(nil)
+
+		request := newTransactionRequest()
+		request.Amount.Currency = ""
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transaction without currency in a JPY wallet", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "JPY", Balance: model.NewMoney(0, "JPY")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(1500, "JPY"), "JPY", gomock.Any()).Return(model.NewMoney(1500, "JPY"), nil)
+		mocks.trxRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transaction model.Transaction) error {
+			assert.Equal(t, "1500", transaction.Amount.Decimal(), "Expected the amount in whole yen")
+			return nil
+		})
+		mocks.budgets.EXPECT().CheckBudget(gomock.Any(), "user-1", "cat-1", gomock.Any()).Return(nil)
+
+		request := newTransactionRequest()
+		request.Amount = model.MoneyRequest{Amount: "1500"}
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transaction without currency with yen fractions", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "JPY", Balance: model.NewMoney(0, "JPY")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+
+		request := newTransactionRequest()
+		request.Amount = model.MoneyRequest{Amount: "1.5"}
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.ErrorIs(t, err, model.ErrInvalidAmount)
+	})
+
+	t.Run("Create Transaction without currency in a KWD wallet", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "KWD", Balance: model.NewMoney(0, "KWD")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(1500, "KWD"), "KWD", gomock.Any()).Return(model.NewMoney(1500, "KWD"), nil)
+		mocks.trxRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transaction model.Transaction) error {
+			assert.Equal(t, "1.500", transaction.Amount.Decimal(), "Expected the amount in fils")
+			return nil
+		})
+		mocks.budgets.EXPECT().CheckBudget(gomock.Any(), "user-1", "cat-1", gomock.Any()).Return(nil)
+
+		request := newTransactionRequest()
+		request.Amount = model.MoneyRequest{Amount: "1.5"}
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transaction with non positive amount", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+
+		request := newTransactionRequest()
+		request.Amount = model.MoneyRequest{Amount: "-1.00", Currency: "IDR"}
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.ErrorIs(t, err, service.ErrInvalidAmount)
+	})
+
+	t.Run("Create Transaction in a foreign currency", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		request := newTransactionRequest()
+		request.Amount = model.MoneyRequest{Amount: "10.00", Currency: "USD"}
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(1000, "USD"), "IDR", request.Transaction_Date).Return(model.NewMoney(15600000, "IDR"), nil)
+		mocks.trxRepo.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transaction model.Transaction) error {
+			assert.Equal(t, model.NewMoney(15600000, "IDR"), transaction.Amount, "Expected amount in wallet currency")
+			assert.Equal(t, model.NewMoney(1000, "USD"), transaction.Original_Amount, "Expected original amount to be kept")
+			return nil
+		})
+		mocks.budgets.EXPECT().CheckBudget(gomock.Any(), "user-1", "cat-1", request.Transaction_Date).Return(nil)
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transaction without exchange rate", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		request := newTransactionRequest()
+		request.Amount = model.MoneyRequest{Amount: "10.00", Currency: "SGD"}
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(1000, "SGD"), "IDR", request.Transaction_Date).Return(model.Money{}, rateService.ErrRateNotFound)
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.ErrorIs(t, err, service.ErrRateNotFound)
+	})
+
+	t.Run("Create Transaction when exchange rates cannot be read", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		request := newTransactionRequest()
+		request.Amount = model.MoneyRequest{Amount: "10.00", Currency: "SGD"}
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(1000, "SGD"), "IDR", request.Transaction_Date).Return(model.Money{}, sql.ErrConnDone)
+
+		err := transactionService.CreateTransaction(ctx, "user-1", request)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.NotErrorIs(t, err, service.ErrRateNotFound)
+	})
+
+	t.Run("Create Transaction with invalid user id", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errNoRows)
+
+		err := transactionService.CreateTransaction(ctx, "invalid_id", newTransactionRequest())
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+
+	t.Run("Create Transaction with another user's wallet", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-2"}, nil)
+
+		err := transactionService.CreateTransaction(ctx, "user-1", newTransactionRequest())
+
+		assert.ErrorIs(t, err, service.ErrWalletNotFound)
+	})
+
+	t.Run("Create Transaction with another user's category", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-2"}, nil)
+
+		err := transactionService.CreateTransaction(ctx, "user-1", newTransactionRequest())
+
+		assert.ErrorIs(t, err, service.ErrCategoryNotFound)
+	})
+}
+
+func TestUpdateTransaction(t *testing.T) {
+	t.Run("Update Transaction", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "trx-1").Return(model.Transaction{ID: "trx-1", User_ID: "user-1", Wallet_ID: "wallet-1", Amount: model.NewMoney(1000000, "IDR")}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", User_ID: "user-1", Currency: "IDR", Balance: model.NewMoney(0, "IDR")}, nil)
+		mocks.catRepo.EXPECT().GetCategory(gomock.Any(), "cat-1").Return(model.Category{ID: "cat-1", User_ID: "user-1"}, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(2500000, "IDR"), "IDR", gomock.Any()).Return(model.NewMoney(2500000, "IDR"), nil)
+		mocks.trxRepo.EXPECT().UpdateTransaction(gomock.Any(), "trx-1", gomock.Any()).DoAndReturn(func(_ context.Context, id string, transaction model.Transaction) error {
+			assert.Equal(t, "user-1", transaction.User_ID, "Expected owner to be preserved")
+			assert.Equal(t, model.NewMoney(2500000, "IDR"), transaction.Amount)
+			return nil
+		})
+		mocks.budgets.EXPECT().CheckBudget(gomock.Any(), "user-1", "cat-1", newTransactionRequest().Transaction_Date).Return(nil)
+
+		err := transactionService.UpdateTransaction(ctx, "trx-1", newTransactionRequest())
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Update Transaction that belongs to a transfer", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "trf-1-out").Return(model.Transaction{ID: "trf-1-out", User_ID: "user-1", Transfer_ID: "trf-1"}, nil)
+
+		err := transactionService.UpdateTransaction(ctx, "trf-1-out", newTransactionRequest())
+
+		assert.ErrorIs(t, err, service.ErrTransferTransaction)
+	})
+
+	t.Run("Update Transaction with invalid id", func(t *testing.T) {
+		ctrl, transactionService, mocks := SetupTransactionService(t)
+		defer ctrl.Finish()
+
+		mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "invalid_id").Return(model.Transaction{}, errNoRows)
+
+		err := transactionService.UpdateTransaction(ctx, "invalid_id", newTransactionRequest())
+
+		assert.ErrorIs(t, err, service.ErrTransactionNotFound)
+	})
+}
+
+func TestDeleteTransaction(t *testing.T) {
+	ctrl, transactionService, mocks := SetupTransactionService(t)
+	defer ctrl.Finish()
+
+	mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "trx-1").Return(model.Transaction{ID: "trx-1"}, nil)
+	mocks.trxRepo.EXPECT().DeleteTransaction(gomock.Any(), "trx-1").Return(nil)
+	mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "invalid_id").Return(model.Transaction{}, errNoRows)
+	mocks.trxRepo.EXPECT().GetTransaction(gomock.Any(), "trf-1-in").Return(model.Transaction{ID: "trf-1-in", Transfer_ID: "trf-1"}, nil)
+
+	t.Run("Delete Transaction", func(t *testing.T) {
+		err := transactionService.DeleteTransaction(ctx, "trx-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete Transaction with invalid id", func(t *testing.T) {
+		err := transactionService.DeleteTransaction(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrTransactionNotFound)
+	})
+
+	t.Run("Delete Transaction that belongs to a transfer", func(t *testing.T) {
+		err := transactionService.DeleteTransaction(ctx, "trf-1-in")
+
+		assert.ErrorIs(t, err, service.ErrTransferTransaction)
+	})
+}
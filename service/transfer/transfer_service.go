@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/varomnrg/money-tracker/model"
+	transferRepo "github.com/varomnrg/money-tracker/repository/transfer"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	userRepo "github.com/varomnrg/money-tracker/repository/user"
+	walletRepo "github.com/varomnrg/money-tracker/repository/wallet"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type TransferService struct {
+	transferRepo transferRepo.ITransferRepository
+	walletRepo   walletRepo.IWalletRepository
+	userRepo     userRepo.IUserRepository
+	converter    rateService.IConverter
+	uow          unitofwork.IUnitOfWork
+}
+
+// A transfer names its wallets in the request body, so a wallet that cannot
+// be found is invalid input.
+var (
+	ErrUserNotFound     = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrWalletNotFound   = model.NewError(model.CodeValidation, "wallet cannot be found")
+	ErrTransferNotFound = model.NewError(model.CodeNotFound, "transfer cannot be found")
+	ErrSameWallet       = model.NewError(model.CodeValidation, "cannot transfer to the same wallet")
+	ErrInvalidAmount    = model.NewError(model.CodeValidation, "amount must be greater than zero")
+	ErrCurrencyMismatch = model.NewError(model.CodeValidation, "currency does not match wallet currency")
+	ErrRateNotFound     = model.NewError(model.CodeValidation, "exchange rate cannot be found")
+)
+
+func NewTransferService(
+	transferRepo transferRepo.ITransferRepository,
+	walletRepo walletRepo.IWalletRepository,
+	userRepo userRepo.IUserRepository,
+	converter rateService.IConverter,
+	uow unitofwork.IUnitOfWork,
+) *TransferService {
+	return &TransferService{
+		transferRepo: transferRepo,
+		walletRepo:   walletRepo,
+		userRepo:     userRepo,
+		converter:    converter,
+		uow:          uow,
+	}
+}
+
+func (s *TransferService) GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error) {
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return []model.Transfer{}, ErrUserNotFound
+	}
+
+	return s.transferRepo.GetUserTransfers(ctx, userID)
+}
+
+func (s *TransferService) GetTransfer(ctx context.Context, id string) (model.Transfer, error) {
+	transfer, err := s.transferRepo.GetTransfer(ctx, id)
+
+	if err != nil {
+		return model.Transfer{}, ErrTransferNotFound
+	}
+
+	return transfer, nil
+}
+
+func (s *TransferService) CreateTransfer(ctx context.Context, userID string, transfer model.TransferRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.userRepo.GetUser(ctx, userID)
+
+		if err != nil {
+			return ErrUserNotFound
+		}
+
+		newTransfer := model.Transfer{
+			ID:         "trf-" + utils.GenerateRandomID(10),
+			User_ID:    userID,
+			Created_At: utils.GetCurrentTime(),
+		}
+
+		err = s.applyRequest(ctx, &newTransfer, transfer)
+
+		if err != nil {
+			return err
+		}
+
+		return s.transferRepo.CreateTransfer(ctx, newTransfer)
+	})
+}
+
+func (s *TransferService) UpdateTransfer(ctx context.Context, id string, transfer model.TransferRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.transferRepo.GetTransfer(ctx, id)
+
+		if err != nil {
+			return ErrTransferNotFound
+		}
+
+		err = s.applyRequest(ctx, &existing, transfer)
+
+		if err != nil {
+			return err
+		}
+
+		return s.transferRepo.UpdateTransfer(ctx, id, existing)
+	})
+}
+
+func (s *TransferService) DeleteTransfer(ctx context.Context, id string) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.transferRepo.GetTransfer(ctx, id)
+
+		if err != nil {
+			return ErrTransferNotFound
+		}
+
+		return s.transferRepo.DeleteTransfer(ctx, id)
+	})
+}
+
+// applyRequest validates request against the wallets of transfer.User_ID and
+// copies it onto transfer, working out the amount credited to the destination
+// wallet.
+func (s *TransferService) applyRequest(ctx context.Context, transfer *model.Transfer, request model.TransferRequest) error {
+	if request.From_Wallet_ID == request.To_Wallet_ID {
+		return ErrSameWallet
+	}
+
+	from, err := s.walletRepo.GetWallet(ctx, request.From_Wallet_ID)
+
+	if err != nil || from.User_ID != transfer.User_ID {
+		return ErrWalletNotFound
+	}
+
+	to, err := s.walletRepo.GetWallet(ctx, request.To_Wallet_ID)
+
+	if err != nil || to.User_ID != transfer.User_ID {
+		return ErrWalletNotFound
+	}
+
+	amount, err := request.Amount.Money(from.Currency)
+	if err != nil {
+		return err
+	}
+
+	if amount.Currency != from.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	if !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	var toAmount model.Money
+
+	switch {
+	case request.To_Amount != nil:
+		toAmount, err = request.To_Amount.Money(to.Currency)
+		if err != nil {
+			return err
+		}
+
+		if toAmount.Currency != to.Currency {
+			return ErrCurrencyMismatch
+		}
+
+		if !toAmount.IsPositive() {
+			return ErrInvalidAmount
+		}
+	default:
+		toAmount, err = s.converter.Convert(ctx, amount, to.Currency, request.Transfer_Date)
+		if errors.Is(err, rateService.ErrRateNotFound) {
+			return ErrRateNotFound
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	transfer.From_Wallet_ID = from.ID
+	transfer.To_Wallet_ID = to.ID
+	transfer.Amount = amount
+	transfer.To_Amount = toAmount
+	transfer.Description = request.Description
+	transfer.Transfer_Date = request.Transfer_Date
+
+	return nil
+}
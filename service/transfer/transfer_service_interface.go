@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type ITransferService interface {
+	GetUserTransfers(ctx context.Context, userID string) ([]model.Transfer, error)
+	GetTransfer(ctx context.Context, id string) (model.Transfer, error)
+	CreateTransfer(ctx context.Context, userID string, transfer model.TransferRequest) error
+	UpdateTransfer(ctx context.Context, id string, transfer model.TransferRequest) error
+	DeleteTransfer(ctx context.Context, id string) error
+}
@@ -0,0 +1,323 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mockTransferRepo "github.com/varomnrg/money-tracker/mocks/repository/transfer"
+	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
+	mockWalletRepo "github.com/varomnrg/money-tracker/mocks/repository/wallet"
+	mockRateService "github.com/varomnrg/money-tracker/mocks/service/rate"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	service "github.com/varomnrg/money-tracker/service/transfer"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+type transferMocks struct {
+	transferRepo *mockTransferRepo.MockITransferRepository
+	walletRepo   *mockWalletRepo.MockIWalletRepository
+	userRepo     *mockUserRepo.MockIUserRepository
+	converter    *mockRateService.MockIConverter
+}
+
+func SetupTransferService(t *testing.T) (*gomock.Controller, *service.TransferService, transferMocks) {
+	ctrl := gomock.NewController(t)
+	mocks := transferMocks{
+		transferRepo: mockTransferRepo.NewMockITransferRepository(ctrl),
+		walletRepo:   mockWalletRepo.NewMockIWalletRepository(ctrl),
+		userRepo:     mockUserRepo.NewMockIUserRepository(ctrl),
+		converter:    mockRateService.NewMockIConverter(ctrl),
+	}
+	transferService := service.NewTransferService(mocks.transferRepo, mocks.walletRepo, mocks.userRepo, mocks.converter, unitofwork.NewNopUnitOfWork())
+
+	return ctrl, transferService, mocks
+}
+
+var (
+	errNoRows    = errors.New("sql: no rows in result set")
+	transferDate = time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	bankWallet   = model.Wallet{ID: "wallet-bank", Name: "Bank", Currency: "IDR", User_ID: "user-1"}
+	cashWallet   = model.Wallet{ID: "wallet-cash", Name: "Cash", Currency: "IDR", User_ID: "user-1"}
+	usdWallet    = model.Wallet{ID: "wallet-usd", Name: "Travel", Currency: "USD", User_ID: "user-1"}
+	jpyWallet    = model.Wallet{ID: "wallet-jpy", Name: "Tokyo", Currency: "JPY", User_ID: "user-1"}
+	kwdWallet    = model.Wallet{ID: "wallet-kwd", Name: "Kuwait", Currency: "KWD", User_ID: "user-1"}
+)
+
+func newTransferRequest(to string) model.TransferRequest {
+	return model.TransferRequest{
+		From_Wallet_ID: "wallet-bank",
+		To_Wallet_ID:   to,
+		Amount:         model.MoneyRequest{Amount: "500000.00", Currency: "IDR"},
+		Description:    "ATM withdrawal",
+		Transfer_Date:  transferDate,
+	}
+}
+
+func TestCreateTransfer(t *testing.T) {
+	t.Run("Create Transfer", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-cash").Return(cashWallet, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(50000000, "IDR"), "IDR", transferDate).Return(model.NewMoney(50000000, "IDR"), nil)
+		mocks.transferRepo.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transfer model.Transfer) error {
+			debit, credit := transfer.Legs()
+
+			assert.Equal(t, "wallet-bank", debit.Wallet_ID)
+			assert.Equal(t, model.NewMoney(-50000000, "IDR"), debit.BalanceDelta(), "Expected source wallet to be debited")
+			assert.Equal(t, "wallet-cash", credit.Wallet_ID)
+			assert.Equal(t, model.NewMoney(50000000, "IDR"), credit.BalanceDelta(), "Expected destination wallet to be credited")
+			assert.Equal(t, transfer.ID, debit.Transfer_ID)
+			assert.Equal(t, transfer.ID, credit.Transfer_ID)
+			assert.True(t, debit.IsTransfer() && credit.IsTransfer())
+			return nil
+		})
+
+		err := transferService.CreateTransfer(ctx, "user-1", newTransferRequest("wallet-cash"))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transfer across currencies with explicit amount", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		request := newTransferRequest("wallet-usd")
+		request.To_Amount = &model.MoneyRequest{Amount: "31.50", Currency: "USD"}
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-usd").Return(usdWallet, nil)
+		mocks.transferRepo.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transfer model.Transfer) error {
+			assert.Equal(t, model.NewMoney(50000000, "IDR"), transfer.Amount)
+			assert.Equal(t, model.NewMoney(3150, "USD"), transfer.To_Amount)
+			return nil
+		})
+
+		err := transferService.CreateTransfer(ctx, "user-1", request)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transfer across currencies with stored rate", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-usd").Return(usdWallet, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(50000000, "IDR"), "USD", transferDate).Return(model.NewMoney(3205, "USD"), nil)
+		mocks.transferRepo.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transfer model.Transfer) error {
+			assert.Equal(t, model.NewMoney(3205, "USD"), transfer.To_Amount)
+			return nil
+		})
+
+		err := transferService.CreateTransfer(ctx, "user-1", newTransferRequest("wallet-usd"))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transfer across currencies without rate", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-usd").Return(usdWallet, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(50000000, "IDR"), "USD", transferDate).Return(model.Money{}, rateService.ErrRateNotFound)
+
+		err := transferService.CreateTransfer(ctx, "user-1", newTransferRequest("wallet-usd"))
+
+		assert.ErrorIs(t, err, service.ErrRateNotFound)
+	})
+
+	t.Run("Create Transfer across currencies when rates cannot be read", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-usd").Return(usdWallet, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(50000000, "IDR"), "USD", transferDate).Return(model.Money{}, sql.ErrConnDone)
+
+		err := transferService.CreateTransfer(ctx, "user-1", newTransferRequest("wallet-usd"))
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.NotErrorIs(t, err, service.ErrRateNotFound)
+	})
+
+	t.Run("Create Transfer without currencies between JPY and KWD wallets", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		request := newTransferRequest("wallet-kwd")
+		request.From_Wallet_ID = "wallet-jpy"
+		request.Amount = model.MoneyRequest{Amount: "1500"}
+		request.To_Amount = &model.MoneyRequest{Amount: "3.5"}
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-jpy").Return(jpyWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-kwd").Return(kwdWallet, nil)
+		mocks.transferRepo.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transfer model.Transfer) error {
+			assert.Equal(t, model.NewMoney(1500, "JPY"), transfer.Amount, "Expected the amount in whole yen")
+			assert.Equal(t, model.NewMoney(3500, "KWD"), transfer.To_Amount, "Expected the amount in fils")
+			return nil
+		})
+
+		err := transferService.CreateTransfer(ctx, "user-1", request)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Transfer without currency with yen fractions", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		request := newTransferRequest("wallet-kwd")
+		request.From_Wallet_ID = "wallet-jpy"
+		request.Amount = model.MoneyRequest{Amount: "1.5"}
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-jpy").Return(jpyWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-kwd").Return(kwdWallet, nil)
+
+		err := transferService.CreateTransfer(ctx, "user-1", request)
+
+		assert.ErrorIs(t, err, model.ErrInvalidAmount)
+	})
+
+	t.Run("Create Transfer to another user's wallet", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-other").Return(model.Wallet{ID: "wallet-other", Currency: "IDR", User_ID: "user-2"}, nil)
+
+		err := transferService.CreateTransfer(ctx, "user-1", newTransferRequest("wallet-other"))
+
+		assert.ErrorIs(t, err, service.ErrWalletNotFound)
+	})
+
+	t.Run("Create Transfer to the same wallet", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+
+		err := transferService.CreateTransfer(ctx, "user-1", newTransferRequest("wallet-bank"))
+
+		assert.ErrorIs(t, err, service.ErrSameWallet)
+	})
+
+	t.Run("Create Transfer in a currency other than the source wallet", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		request := newTransferRequest("wallet-cash")
+		request.Amount = model.MoneyRequest{Amount: "10.00", Currency: "USD"}
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-cash").Return(cashWallet, nil)
+
+		err := transferService.CreateTransfer(ctx, "user-1", request)
+
+		assert.ErrorIs(t, err, service.ErrCurrencyMismatch)
+	})
+
+	t.Run("Create Transfer with invalid user id", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errNoRows)
+
+		err := transferService.CreateTransfer(ctx, "invalid_id", newTransferRequest("wallet-cash"))
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestUpdateTransfer(t *testing.T) {
+	t.Run("Update Transfer", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		existing := model.Transfer{ID: "trf-1", User_ID: "user-1", From_Wallet_ID: "wallet-bank", To_Wallet_ID: "wallet-cash", Amount: model.NewMoney(100, "IDR"), To_Amount: model.NewMoney(100, "IDR")}
+
+		mocks.transferRepo.EXPECT().GetTransfer(gomock.Any(), "trf-1").Return(existing, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-bank").Return(bankWallet, nil)
+		mocks.walletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-cash").Return(cashWallet, nil)
+		mocks.converter.EXPECT().Convert(gomock.Any(), model.NewMoney(50000000, "IDR"), "IDR", transferDate).Return(model.NewMoney(50000000, "IDR"), nil)
+		mocks.transferRepo.EXPECT().UpdateTransfer(gomock.Any(), "trf-1", gomock.Any()).DoAndReturn(func(_ context.Context, id string, transfer model.Transfer) error {
+			assert.Equal(t, "user-1", transfer.User_ID, "Expected owner to be preserved")
+			assert.Equal(t, model.NewMoney(50000000, "IDR"), transfer.Amount)
+			assert.Equal(t, model.NewMoney(50000000, "IDR"), transfer.To_Amount)
+			return nil
+		})
+
+		err := transferService.UpdateTransfer(ctx, "trf-1", newTransferRequest("wallet-cash"))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Update Transfer with invalid id", func(t *testing.T) {
+		ctrl, transferService, mocks := SetupTransferService(t)
+		defer ctrl.Finish()
+
+		mocks.transferRepo.EXPECT().GetTransfer(gomock.Any(), "invalid_id").Return(model.Transfer{}, errNoRows)
+
+		err := transferService.UpdateTransfer(ctx, "invalid_id", newTransferRequest("wallet-cash"))
+
+		assert.ErrorIs(t, err, service.ErrTransferNotFound)
+	})
+}
+
+func TestDeleteTransfer(t *testing.T) {
+	ctrl, transferService, mocks := SetupTransferService(t)
+	defer ctrl.Finish()
+
+	mocks.transferRepo.EXPECT().GetTransfer(gomock.Any(), "trf-1").Return(model.Transfer{ID: "trf-1"}, nil)
+	mocks.transferRepo.EXPECT().DeleteTransfer(gomock.Any(), "trf-1").Return(nil)
+	mocks.transferRepo.EXPECT().GetTransfer(gomock.Any(), "invalid_id").Return(model.Transfer{}, errNoRows)
+
+	t.Run("Delete Transfer", func(t *testing.T) {
+		assert.NoError(t, transferService.DeleteTransfer(ctx, "trf-1"))
+	})
+
+	t.Run("Delete Transfer with invalid id", func(t *testing.T) {
+		assert.ErrorIs(t, transferService.DeleteTransfer(ctx, "invalid_id"), service.ErrTransferNotFound)
+	})
+}
+
+func TestGetUserTransfers(t *testing.T) {
+	ctrl, transferService, mocks := SetupTransferService(t)
+	defer ctrl.Finish()
+
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mocks.transferRepo.EXPECT().GetUserTransfers(gomock.Any(), "user-1").Return([]model.Transfer{{ID: "trf-1"}}, nil)
+	mocks.userRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errNoRows)
+
+	t.Run("Get User Transfers", func(t *testing.T) {
+		transfers, err := transferService.GetUserTransfers(ctx, "user-1")
+
+		assert.NoError(t, err)
+		assert.Len(t, transfers, 1)
+	})
+
+	t.Run("Get User Transfers with invalid user id", func(t *testing.T) {
+		_, err := transferService.GetUserTransfers(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/varomnrg/money-tracker/model"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrPasswordTooLong is returned for passwords bcrypt cannot hash. The limit
+// is in bytes, so the character limit of UserRequest does not cover every
+// password over it.
+var ErrPasswordTooLong = model.NewValidationError(map[string]string{"Password": "Password must be at most 72 bytes"})
+
+// HashPassword hashes password with bcrypt at the given cost. Costs below
+// bcrypt.MinCost fall back to bcrypt.DefaultCost.
+func HashPassword(password string, cost int) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return "", ErrPasswordTooLong
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+// CheckPassword reports whether password matches the bcrypt hash.
+func CheckPassword(hash string, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// IsPasswordHashed reports whether value is a bcrypt hash rather than a
+// plaintext password left over from before passwords were hashed.
+func IsPasswordHashed(value string) bool {
+	_, err := bcrypt.Cost([]byte(value))
+
+	return err == nil
+}
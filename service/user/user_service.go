@@ -1,36 +1,67 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"log/slog"
+	"sync"
 
 	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
 	repository "github.com/varomnrg/money-tracker/repository/user"
 	"github.com/varomnrg/money-tracker/utils"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type UserService struct {
-	userRepo repository.IUserRepository
+	userRepo     repository.IUserRepository
+	uow          unitofwork.IUnitOfWork
+	passwordCost int
+
+	// dummyHash is compared against when the username is unknown, so that
+	// VerifyCredentials takes as long as for a wrong password.
+	dummyHash     string
+	dummyHashOnce sync.Once
 }
 
 var (
-	ErrIDIsNotValid         = errors.New("id is not valid")
-	ErrUserNotFound         = errors.New("user cannot be found")
-	ErrUserAlreadyExist     = errors.New("user already exist")
-	ErrUsernameAlreadyExist = errors.New("username already exist")
+	ErrIDIsNotValid         = model.NewError(model.CodeValidation, "id is not valid")
+	ErrUserNotFound         = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrUserAlreadyExist     = model.NewError(model.CodeConflict, "user already exist")
+	ErrUsernameAlreadyExist = model.NewError(model.CodeConflict, "username already exist")
+	ErrInvalidCredentials   = model.NewError(model.CodeUnauthorized, "invalid username or password")
 )
 
-func NewUserService(repository repository.IUserRepository) *UserService {
+func NewUserService(repository repository.IUserRepository, uow unitofwork.IUnitOfWork) *UserService {
+	return NewUserServiceWithPasswordCost(repository, uow, bcrypt.DefaultCost)
+}
+
+// NewUserServiceWithPasswordCost creates a UserService that hashes passwords
+// with the given bcrypt cost.
+func NewUserServiceWithPasswordCost(repository repository.IUserRepository, uow unitofwork.IUnitOfWork, passwordCost int) *UserService {
 	return &UserService{
-		userRepo: repository,
+		userRepo:     repository,
+		uow:          uow,
+		passwordCost: passwordCost,
 	}
 }
 
-func (u *UserService) GetUsers() ([]model.UserResponse, error) {
-	return u.userRepo.GetUsers()
+func (u *UserService) GetUsers(ctx context.Context, query model.ListQuery) (model.Page[model.UserResponse], error) {
+	err := query.Prepare(model.UserSorts...)
+	if err != nil {
+		return model.Page[model.UserResponse]{}, err
+	}
+
+	users, err := u.userRepo.GetUsers(ctx, query.Lookahead())
+	if err != nil {
+		return model.Page[model.UserResponse]{}, err
+	}
+
+	return model.NewPage(users, query), nil
 }
 
-func (u *UserService) GetUser(id string) (model.UserResponse, error) {
-	user, err := u.userRepo.GetUser(id)
+func (u *UserService) GetUser(ctx context.Context, id string) (model.UserResponse, error) {
+	user, err := u.userRepo.GetUser(ctx, id)
 
 	if err != nil {
 		return model.UserResponse{}, ErrUserNotFound
@@ -38,43 +69,141 @@ func (u *UserService) GetUser(id string) (model.UserResponse, error) {
 	return user, nil
 }
 
-func (u *UserService) CreateUser(userRequest model.UserRequest) error {
-	UsernameExist := u.userRepo.IsUsernameExist(userRequest.Username)
+// CreateUser hashes the password before opening the transaction so that the
+// slow bcrypt work does not hold it open.
+func (u *UserService) CreateUser(ctx context.Context, userRequest model.UserRequest) error {
+	password, err := HashPassword(userRequest.Password, u.passwordCost)
 
-	if UsernameExist {
-		return ErrUsernameAlreadyExist
+	if err != nil {
+		return err
 	}
 
-	id := "user-" + utils.GenerateRandomID(10)
-	time := utils.GetCurrentTime()
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		UsernameExist := u.userRepo.IsUsernameExist(ctx, userRequest.Username)
 
-	user := model.User{
-		ID:         id,
-		Username:   userRequest.Username,
-		Email:      userRequest.Email,
-		Password:   userRequest.Password,
-		Created_At: time,
+		if UsernameExist {
+			return ErrUsernameAlreadyExist
+		}
+
+		id := "user-" + utils.GenerateRandomID(10)
+		time := utils.GetCurrentTime()
+
+		user := model.User{
+			ID:         id,
+			Username:   userRequest.Username,
+			Email:      userRequest.Email,
+			Password:   password,
+			Role:       model.RoleUser,
+			Created_At: time,
+		}
+
+		return u.userRepo.CreateUser(ctx, user)
+	})
+}
+
+func (u *UserService) UpdateUser(ctx context.Context, id string, user model.UserRequest) error {
+	var err error
+
+	user.Password, err = HashPassword(user.Password, u.passwordCost)
+
+	if err != nil {
+		return err
 	}
 
-	return u.userRepo.CreateUser(user)
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := u.userRepo.GetUser(ctx, id)
+
+		if err != nil {
+			return ErrUserNotFound
+		}
+
+		if user.Username != existing.Username && u.userRepo.IsUsernameExist(ctx, user.Username) {
+			return ErrUsernameAlreadyExist
+		}
+
+		return u.userRepo.UpdateUser(ctx, id, user)
+	})
 }
 
-func (u *UserService) UpdateUser(id string, user model.UserRequest) error {
-	_, err := u.userRepo.GetUser(id)
+func (u *UserService) DeleteUser(ctx context.Context, id string) error {
+	return u.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := u.userRepo.GetUser(ctx, id)
+
+		if err != nil {
+			return ErrUserNotFound
+		}
+
+		return u.userRepo.DeleteUser(ctx, id)
+	})
+}
+
+// VerifyCredentials returns the user with the given username when password
+// matches their stored hash.
+func (u *UserService) VerifyCredentials(ctx context.Context, username string, password string) (model.UserResponse, error) {
+	user, err := u.userRepo.GetUserByUsername(ctx, username)
 
 	if err != nil {
-		return ErrUserNotFound
+		CheckPassword(u.dummyPasswordHash(), password)
+		return model.UserResponse{}, ErrInvalidCredentials
+	}
+
+	if !CheckPassword(user.Password, password) {
+		return model.UserResponse{}, ErrInvalidCredentials
 	}
 
-	return u.userRepo.UpdateUser(id, user)
+	return model.UserResponse{
+		ID:         user.ID,
+		Username:   user.Username,
+		Email:      user.Email,
+		Role:       user.Role,
+		Created_At: user.Created_At,
+	}, nil
 }
 
-func (u *UserService) DeleteUser(id string) error {
-	_, err := u.userRepo.GetUser(id)
+// dummyPasswordHash returns a hash, at the service's cost, of a password no
+// user has. It is computed on first use.
+func (u *UserService) dummyPasswordHash() string {
+	u.dummyHashOnce.Do(func() {
+		u.dummyHash, _ = HashPassword("money-tracker-dummy-password", u.passwordCost)
+	})
+
+	return u.dummyHash
+}
+
+// RehashPlaintextPasswords hashes every password that is still stored in
+// plaintext and returns how many were updated. It is safe to run repeatedly.
+// Passwords too long for bcrypt are left as they are and logged; their users
+// cannot log in until they are given a new password.
+func (u *UserService) RehashPlaintextPasswords(ctx context.Context) (int, error) {
+	users, err := u.userRepo.GetUsersWithPassword(ctx)
 
 	if err != nil {
-		return ErrUserNotFound
+		return 0, err
+	}
+
+	count := 0
+
+	for _, user := range users {
+		if IsPasswordHashed(user.Password) {
+			continue
+		}
+
+		password, err := HashPassword(user.Password, u.passwordCost)
+		if errors.Is(err, ErrPasswordTooLong) {
+			slog.Warn("Password too long to hash", "user", user.ID)
+			continue
+		}
+		if err != nil {
+			return count, err
+		}
+
+		err = u.userRepo.UpdatePassword(ctx, user.ID, password)
+		if err != nil {
+			return count, err
+		}
+
+		count++
 	}
 
-	return u.userRepo.DeleteUser(id)
+	return count, nil
 }
@@ -1,11 +1,16 @@
 package service
 
-import "github.com/varomnrg/money-tracker/model"
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
 
 type IUserService interface {
-	GetUsers() ([]model.UserResponse, error)
-	GetUser(id string) (model.UserResponse, error)
-	CreateUser(movie model.UserRequest) error
-	DeleteUser(id string) error
-	UpdateUser(id string, movie model.UserRequest) error
+	GetUsers(ctx context.Context, query model.ListQuery) (model.Page[model.UserResponse], error)
+	GetUser(ctx context.Context, id string) (model.UserResponse, error)
+	CreateUser(ctx context.Context, movie model.UserRequest) error
+	DeleteUser(ctx context.Context, id string) error
+	UpdateUser(ctx context.Context, id string, movie model.UserRequest) error
+	VerifyCredentials(ctx context.Context, username string, password string) (model.UserResponse, error)
 }
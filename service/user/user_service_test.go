@@ -1,21 +1,27 @@
 package service_test
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	mock_repository "github.com/varomnrg/money-tracker/mocks/repository/user"
 	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
 	service "github.com/varomnrg/money-tracker/service/user"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
 )
 
+var ctx = context.Background()
+
 func SetupUserService(t *testing.T) (*gomock.Controller, *service.UserService, *mock_repository.MockIUserRepository) {
 	ctrl := gomock.NewController(t)
 	mockRepo := mock_repository.NewMockIUserRepository(ctrl)
-	userService := service.NewUserService(mockRepo)
+	userService := service.NewUserServiceWithPasswordCost(mockRepo, unitofwork.NewNopUnitOfWork(), bcrypt.MinCost)
 
 	return ctrl, userService, mockRepo
 }
@@ -24,14 +30,37 @@ func TestGetUsers(t *testing.T) {
 	ctrl, userService, mockRepo := SetupUserService(t)
 	defer ctrl.Finish()
 
-	mockRepo.EXPECT().GetUsers().Return([]model.UserResponse{{ID: "1", Username: "user1", Email: "user1@example.com"}}, nil)
+	mockRepo.EXPECT().GetUsers(gomock.Any(), model.ListQuery{Sort: "created_at", Limit: model.DefaultPageSize + 1}).Return([]model.UserResponse{{ID: "1", Username: "user1", Email: "user1@example.com"}}, nil)
+	mockRepo.EXPECT().GetUsers(gomock.Any(), model.ListQuery{Sort: "-username", Limit: 3}).Return([]model.UserResponse{
+		{ID: "3", Username: "user3"},
+		{ID: "2", Username: "user2"},
+		{ID: "1", Username: "user1"},
+	}, nil)
 
 	t.Run("Get Users", func(t *testing.T) {
-		users, err := userService.GetUsers()
+		users, err := userService.GetUsers(ctx, model.ListQuery{})
+
+		assert.NoError(t, err)
+		assert.Len(t, users.Items, 1)
+		assert.Equal(t, "user1", users.Items[0].Username)
+		assert.Empty(t, users.Next_Cursor)
+	})
 
+	t.Run("Get Users with a next page", func(t *testing.T) {
+		users, err := userService.GetUsers(ctx, model.ListQuery{Sort: "-username", Limit: 2})
+
+		assert.NoError(t, err)
+		assert.Len(t, users.Items, 2)
+
+		cursor, err := model.ParseCursor(users.Next_Cursor)
 		assert.NoError(t, err)
-		assert.Len(t, users, 1)
-		assert.Equal(t, "user1", users[0].Username)
+		assert.Equal(t, model.Cursor{Sort: "-username", Key: "user2", ID: "2"}, *cursor)
+	})
+
+	t.Run("Get Users with unknown sort", func(t *testing.T) {
+		_, err := userService.GetUsers(ctx, model.ListQuery{Sort: "password"})
+
+		assert.ErrorIs(t, err, model.ErrValidation)
 	})
 }
 
@@ -39,18 +68,18 @@ func TestGetUser(t *testing.T) {
 	ctrl, userService, mockRepo := SetupUserService(t)
 	defer ctrl.Finish()
 
-	mockRepo.EXPECT().GetUser("1").Return(model.UserResponse{ID: "1", Username: "user1", Email: "user1@example.com"}, nil)
-	mockRepo.EXPECT().GetUser("invalid_id").Return(model.UserResponse{}, errors.New("user cannot be found"))
+	mockRepo.EXPECT().GetUser(gomock.Any(), "1").Return(model.UserResponse{ID: "1", Username: "user1", Email: "user1@example.com"}, nil)
+	mockRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("user cannot be found"))
 
 	t.Run("Get User", func(t *testing.T) {
-		user, err := userService.GetUser("1")
+		user, err := userService.GetUser(ctx, "1")
 
 		assert.NoError(t, err)
 		assert.Equal(t, "user1", user.Username)
 	})
 
 	t.Run("Get User with invalid id", func(t *testing.T) {
-		_, err := userService.GetUser("invalid_id")
+		_, err := userService.GetUser(ctx, "invalid_id")
 
 		assert.ErrorIs(t, err, service.ErrUserNotFound)
 	})
@@ -60,17 +89,21 @@ func TestCreateUser(t *testing.T) {
 	ctrl, userService, mockRepo := SetupUserService(t)
 	defer ctrl.Finish()
 
-	mockRepo.EXPECT().IsUsernameExist("existing_username").Return(true)
-	mockRepo.EXPECT().IsUsernameExist("new_username").Return(false)
-	mockRepo.EXPECT().CreateUser(gomock.Any()).Return(nil)
+	mockRepo.EXPECT().IsUsernameExist(gomock.Any(), "existing_username").Return(true)
+	mockRepo.EXPECT().IsUsernameExist(gomock.Any(), "new_username").Return(false)
+	mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user model.User) error {
+		assert.NotEqual(t, "password123", user.Password, "Expected password not to be stored in plaintext")
+		assert.True(t, service.CheckPassword(user.Password, "password123"), "Expected stored hash to match password")
+		return nil
+	})
 
 	t.Run("Create User", func(t *testing.T) {
-		err := userService.CreateUser(model.UserRequest{Username: "existing_username"})
+		err := userService.CreateUser(ctx, model.UserRequest{Username: "existing_username"})
 		assert.ErrorIs(t, err, service.ErrUsernameAlreadyExist)
 	})
 
 	t.Run("Create User with new username", func(t *testing.T) {
-		err := userService.CreateUser(model.UserRequest{Username: "new_username"})
+		err := userService.CreateUser(ctx, model.UserRequest{Username: "new_username", Password: "password123"})
 		assert.NoError(t, err)
 	})
 }
@@ -79,36 +112,137 @@ func TestUpdateUser(t *testing.T) {
 	ctrl, userService, mockRepo := SetupUserService(t)
 	defer ctrl.Finish()
 
-	mockRepo.EXPECT().GetUser("user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
-	mockRepo.EXPECT().GetUser("invalid_id").Return(model.UserResponse{}, errors.New("user cannot be found"))
-	mockRepo.EXPECT().UpdateUser("user-1", gomock.Any()).Return(nil)
+	mockRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
+	mockRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("user cannot be found"))
+	mockRepo.EXPECT().UpdateUser(gomock.Any(), "user-1", gomock.Any()).DoAndReturn(func(_ context.Context, id string, user model.UserRequest) error {
+		assert.True(t, service.CheckPassword(user.Password, "user1"), "Expected updated password to be hashed")
+		return nil
+	})
 
 	t.Run("Update User", func(t *testing.T) {
-		err := userService.UpdateUser("user-1", model.UserRequest{Username: "user1", Email: "user1up@gmail.com", Password: "user1"})
+		err := userService.UpdateUser(ctx, "user-1", model.UserRequest{Username: "user1", Email: "user1up@gmail.com", Password: "user1"})
 		assert.NoError(t, err)
 	})
 
 	t.Run("Update User with invalid id", func(t *testing.T) {
-		err := userService.UpdateUser("invalid_id", model.UserRequest{Username: "user1", Email: "user1up@gmail.com", Password: "user1"})
+		err := userService.UpdateUser(ctx, "invalid_id", model.UserRequest{Username: "user1", Email: "user1up@gmail.com", Password: "user1"})
 		assert.ErrorIs(t, err, service.ErrUserNotFound)
 	})
+
+	t.Run("Update User with taken username", func(t *testing.T) {
+		mockRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Username: "user1"}, nil)
+		mockRepo.EXPECT().IsUsernameExist(gomock.Any(), "user2").Return(true)
+
+		err := userService.UpdateUser(ctx, "user-1", model.UserRequest{Username: "user2", Email: "user1up@gmail.com", Password: "user1"})
+		assert.ErrorIs(t, err, service.ErrUsernameAlreadyExist)
+	})
+}
+
+func TestPasswordTooLong(t *testing.T) {
+	ctrl, userService, mockRepo := SetupUserService(t)
+	defer ctrl.Finish()
+
+	// Within the 72 characters of UserRequest, but over bcrypt's 72 bytes.
+	password := strings.Repeat("é", 40)
+
+	t.Run("Create User", func(t *testing.T) {
+		err := userService.CreateUser(ctx, model.UserRequest{Username: "user1", Password: password})
+		assert.ErrorIs(t, err, service.ErrPasswordTooLong)
+		assert.ErrorIs(t, err, model.ErrValidation)
+	})
+
+	t.Run("Update User", func(t *testing.T) {
+		err := userService.UpdateUser(ctx, "user-1", model.UserRequest{Username: "user1", Password: password})
+		assert.ErrorIs(t, err, service.ErrPasswordTooLong)
+	})
+
+	t.Run("Rehash Plaintext Passwords", func(t *testing.T) {
+		mockRepo.EXPECT().GetUsersWithPassword(gomock.Any()).Return([]model.User{
+			{ID: "user-1", Password: password},
+			{ID: "user-2", Password: "plaintext"},
+		}, nil)
+		mockRepo.EXPECT().UpdatePassword(gomock.Any(), "user-2", gomock.Any()).Return(nil)
+
+		count, err := userService.RehashPlaintextPasswords(ctx)
+
+		assert.NoError(t, err, "Expected a password too long to be skipped")
+		assert.Equal(t, 1, count)
+	})
 }
 
 func TestDeleteUser(t *testing.T) {
 	ctrl, userService, mockRepo := SetupUserService(t)
 	defer ctrl.Finish()
 
-	mockRepo.EXPECT().GetUser("user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
-	mockRepo.EXPECT().GetUser("invalid_id").Return(model.UserResponse{}, errors.New("user cannot be found"))
-	mockRepo.EXPECT().DeleteUser("user-1").Return(nil)
+	mockRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
+	mockRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("user cannot be found"))
+	mockRepo.EXPECT().DeleteUser(gomock.Any(), "user-1").Return(nil)
 
 	t.Run("Delete User", func(t *testing.T) {
-		err := userService.DeleteUser("user-1")
+		err := userService.DeleteUser(ctx, "user-1")
 		assert.NoError(t, err)
 	})
 
 	t.Run("Delete User with invalid id", func(t *testing.T) {
-		err := userService.DeleteUser("invalid_id")
+		err := userService.DeleteUser(ctx, "invalid_id")
 		assert.ErrorIs(t, err, service.ErrUserNotFound)
 	})
 }
+
+func TestVerifyCredentials(t *testing.T) {
+	ctrl, userService, mockRepo := SetupUserService(t)
+	defer ctrl.Finish()
+
+	hash, _ := service.HashPassword("password123", bcrypt.MinCost)
+
+	mockRepo.EXPECT().GetUserByUsername(gomock.Any(), "user1").Return(model.User{ID: "user-1", Username: "user1", Password: hash}, nil).Times(2)
+	mockRepo.EXPECT().GetUserByUsername(gomock.Any(), "unknown").Return(model.User{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Verify Credentials", func(t *testing.T) {
+		user, err := userService.VerifyCredentials(ctx, "user1", "password123")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user-1", user.ID)
+	})
+
+	t.Run("Verify Credentials with wrong password", func(t *testing.T) {
+		_, err := userService.VerifyCredentials(ctx, "user1", "wrong-password")
+
+		assert.ErrorIs(t, err, service.ErrInvalidCredentials)
+	})
+
+	t.Run("Verify Credentials with unknown username", func(t *testing.T) {
+		_, err := userService.VerifyCredentials(ctx, "unknown", "password123")
+
+		assert.ErrorIs(t, err, service.ErrInvalidCredentials)
+	})
+}
+
+func TestRehashPlaintextPasswords(t *testing.T) {
+	ctrl, userService, mockRepo := SetupUserService(t)
+	defer ctrl.Finish()
+
+	hash, _ := service.HashPassword("already-hashed", bcrypt.MinCost)
+
+	mockRepo.EXPECT().GetUsersWithPassword(gomock.Any()).Return([]model.User{
+		{ID: "user-1", Password: "plaintext"},
+		{ID: "user-2", Password: hash},
+	}, nil)
+	mockRepo.EXPECT().UpdatePassword(gomock.Any(), "user-1", gomock.Any()).DoAndReturn(func(_ context.Context, id string, password string) error {
+		assert.True(t, service.CheckPassword(password, "plaintext"))
+		return nil
+	})
+
+	count, err := userService.RehashPlaintextPasswords(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count, "Expected only the plaintext password to be rehashed")
+}
+
+func TestIsPasswordHashed(t *testing.T) {
+	hash, _ := service.HashPassword("secret", bcrypt.MinCost)
+
+	assert.True(t, service.IsPasswordHashed(hash))
+	assert.False(t, service.IsPasswordHashed("secret"))
+	assert.False(t, service.IsPasswordHashed(""))
+}
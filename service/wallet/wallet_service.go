@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	userRepo "github.com/varomnrg/money-tracker/repository/user"
+	walletRepo "github.com/varomnrg/money-tracker/repository/wallet"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	"github.com/varomnrg/money-tracker/utils"
+)
+
+type WalletService struct {
+	walletRepo walletRepo.IWalletRepository
+	userRepo   userRepo.IUserRepository
+	converter  rateService.IConverter
+	uow        unitofwork.IUnitOfWork
+}
+
+var (
+	ErrUserNotFound       = model.NewError(model.CodeNotFound, "user cannot be found")
+	ErrWalletNotFound     = model.NewError(model.CodeNotFound, "wallet cannot be found")
+	ErrWalletAlreadyExist = model.NewError(model.CodeConflict, "wallet already exist")
+	ErrWalletHasTransfers = model.NewError(model.CodeConflict, "wallet has transfers")
+	ErrRateNotFound       = model.NewError(model.CodeValidation, "exchange rate cannot be found")
+)
+
+func NewWalletService(walletRepo walletRepo.IWalletRepository, userRepo userRepo.IUserRepository, converter rateService.IConverter, uow unitofwork.IUnitOfWork) *WalletService {
+	return &WalletService{
+		walletRepo: walletRepo,
+		userRepo:   userRepo,
+		converter:  converter,
+		uow:        uow,
+	}
+}
+
+func (s *WalletService) GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error) {
+	_, err := s.userRepo.GetUser(ctx, userID)
+
+	if err != nil {
+		return []model.Wallet{}, ErrUserNotFound
+	}
+
+	return s.walletRepo.GetUserWallets(ctx, userID)
+}
+
+func (s *WalletService) GetWallet(ctx context.Context, id string) (model.Wallet, error) {
+	wallet, err := s.walletRepo.GetWallet(ctx, id)
+
+	if err != nil {
+		return model.Wallet{}, ErrWalletNotFound
+	}
+
+	return wallet, nil
+}
+
+func (s *WalletService) CreateWallet(ctx context.Context, userID string, wallet model.WalletRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.userRepo.GetUser(ctx, userID)
+
+		if err != nil {
+			return ErrUserNotFound
+		}
+
+		if s.walletRepo.IsUserWalletExist(ctx, userID, wallet.Name) {
+			return ErrWalletAlreadyExist
+		}
+
+		id := "wallet-" + utils.GenerateRandomID(10)
+
+		currency := wallet.Currency
+		if currency == "" {
+			currency = model.DefaultCurrency
+		}
+
+		newWallet := model.Wallet{
+			ID:       id,
+			Name:     wallet.Name,
+			Currency: currency,
+			Balance:  model.NewMoney(0, currency),
+			User_ID:  userID,
+		}
+
+		return s.walletRepo.CreateWallet(ctx, newWallet)
+	})
+}
+
+func (s *WalletService) UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.walletRepo.GetWallet(ctx, id)
+
+		if err != nil {
+			return ErrWalletNotFound
+		}
+
+		// Renaming to the current name is a no-op rather than a conflict.
+		if existing.Name != wallet.Name && s.walletRepo.IsUserWalletExist(ctx, existing.User_ID, wallet.Name) {
+			return ErrWalletAlreadyExist
+		}
+
+		return s.walletRepo.UpdateWallet(ctx, id, wallet)
+	})
+}
+
+// DeleteWallet deletes the wallet along with its transactions. A wallet that
+// has transfers is kept, as deleting it would change the other wallet's
+// history.
+func (s *WalletService) DeleteWallet(ctx context.Context, id string) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		_, err := s.walletRepo.GetWallet(ctx, id)
+
+		if err != nil {
+			return ErrWalletNotFound
+		}
+
+		err = s.walletRepo.DeleteWallet(ctx, id)
+		if errors.Is(err, model.ErrForeignKey) {
+			return ErrWalletHasTransfers
+		}
+
+		return err
+	})
+}
+
+// GetUserNetTotal sums the balances of all of the user's wallets in currency,
+// converting each balance at the rate in effect on date.
+func (s *WalletService) GetUserNetTotal(ctx context.Context, userID string, currency string, date time.Time) (model.Money, error) {
+	wallets, err := s.GetUserWallets(ctx, userID)
+
+	if err != nil {
+		return model.Money{}, err
+	}
+
+	total := model.NewMoney(0, currency)
+
+	for _, wallet := range wallets {
+		balance, err := s.converter.Convert(ctx, wallet.Balance, currency, date)
+		if errors.Is(err, rateService.ErrRateNotFound) {
+			return model.Money{}, ErrRateNotFound
+		}
+		if err != nil {
+			return model.Money{}, err
+		}
+
+		total, err = total.Add(balance)
+		if err != nil {
+			return model.Money{}, err
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type IWalletService interface {
+	GetUserWallets(ctx context.Context, userID string) ([]model.Wallet, error)
+	GetWallet(ctx context.Context, id string) (model.Wallet, error)
+	CreateWallet(ctx context.Context, userID string, wallet model.WalletRequest) error
+	UpdateWallet(ctx context.Context, id string, wallet model.WalletRequest) error
+	DeleteWallet(ctx context.Context, id string) error
+	GetUserNetTotal(ctx context.Context, userID string, currency string, date time.Time) (model.Money, error)
+}
@@ -0,0 +1,284 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mockUserRepo "github.com/varomnrg/money-tracker/mocks/repository/user"
+	mockWalletRepo "github.com/varomnrg/money-tracker/mocks/repository/wallet"
+	mockRateService "github.com/varomnrg/money-tracker/mocks/service/rate"
+	"github.com/varomnrg/money-tracker/model"
+	"github.com/varomnrg/money-tracker/repository/unitofwork"
+	rateService "github.com/varomnrg/money-tracker/service/rate"
+	service "github.com/varomnrg/money-tracker/service/wallet"
+	"go.uber.org/mock/gomock"
+)
+
+var ctx = context.Background()
+
+func SetupWalletService(t *testing.T) (*gomock.Controller, *service.WalletService, *mockWalletRepo.MockIWalletRepository, *mockUserRepo.MockIUserRepository) {
+	ctrl, walletService, mockWalletRepo, mockUserRepo, _ := SetupWalletServiceWithConverter(t)
+
+	return ctrl, walletService, mockWalletRepo, mockUserRepo
+}
+
+func SetupWalletServiceWithConverter(t *testing.T) (*gomock.Controller, *service.WalletService, *mockWalletRepo.MockIWalletRepository, *mockUserRepo.MockIUserRepository, *mockRateService.MockIConverter) {
+	ctrl := gomock.NewController(t)
+	mockUserRepo := mockUserRepo.NewMockIUserRepository(ctrl)
+	mockWalletRepo := mockWalletRepo.NewMockIWalletRepository(ctrl)
+	mockConverter := mockRateService.NewMockIConverter(ctrl)
+	walletService := service.NewWalletService(mockWalletRepo, mockUserRepo, mockConverter, unitofwork.NewNopUnitOfWork())
+
+	return ctrl, walletService, mockWalletRepo, mockUserRepo, mockConverter
+}
+
+func TestGetUserWallets(t *testing.T) {
+	ctrl, walletService, mockWalletRepo, mockUserRepo := SetupWalletService(t)
+	defer ctrl.Finish()
+
+	// Mock for GetUserWallets
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1", Username: "user1", Email: "user1@gmail.com", Created_At: time.Now()}, nil)
+	mockWalletRepo.EXPECT().GetUserWallets(gomock.Any(), "user-1").Return([]model.Wallet{
+		{ID: "wallet-1", Name: "Cash", User_ID: "user-1"},
+		{ID: "wallet-2", Name: "Bank", User_ID: "user-1"},
+	},
+		nil,
+	)
+
+	// Mock for GetUserWallets with invalid user id
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Get User Wallets", func(t *testing.T) {
+		wallets, err := walletService.GetUserWallets(ctx, "user-1")
+
+		assert.NoError(t, err)
+		assert.Len(t, wallets, 2, "Expected returned 2 wallets")
+		assert.Equal(t, "Cash", wallets[0].Name)
+	})
+
+	t.Run("Get User Wallets with invalid user id", func(t *testing.T) {
+		_, err := walletService.GetUserWallets(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestGetWallet(t *testing.T) {
+	ctrl, walletService, mockWalletRepo, _ := SetupWalletService(t)
+	defer ctrl.Finish()
+
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", Name: "Cash", User_ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "invalid_id").Return(model.Wallet{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Get Wallet", func(t *testing.T) {
+		wallet, err := walletService.GetWallet(ctx, "wallet-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Cash", wallet.Name)
+	})
+
+	t.Run("Get Wallet with invalid id", func(t *testing.T) {
+		_, err := walletService.GetWallet(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrWalletNotFound)
+	})
+}
+
+func TestCreateWallet(t *testing.T) {
+	ctrl, walletService, mockWalletRepo, mockUserRepo := SetupWalletService(t)
+	defer ctrl.Finish()
+
+	// Mock for Create Wallet
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().IsUserWalletExist(gomock.Any(), "user-1", "Cash").Return(false)
+	mockWalletRepo.EXPECT().CreateWallet(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, wallet model.Wallet) error {
+		assert.Equal(t, "user-1", wallet.User_ID)
+		assert.Equal(t, model.DefaultCurrency, wallet.Currency, "Expected default currency")
+		assert.True(t, wallet.Balance.IsZero(), "Expected new wallet to start empty")
+		return nil
+	})
+
+	// Mock for Create Wallet with currency
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().IsUserWalletExist(gomock.Any(), "user-1", "Travel").Return(false)
+	mockWalletRepo.EXPECT().CreateWallet(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, wallet model.Wallet) error {
+		assert.Equal(t, "USD", wallet.Currency)
+		assert.Equal(t, "USD", wallet.Balance.Currency)
+		return nil
+	})
+
+	// Mock for Create Wallet with existing wallet name
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-2").Return(model.UserResponse{ID: "user-2"}, nil)
+	mockWalletRepo.EXPECT().IsUserWalletExist(gomock.Any(), "user-2", "Cash").Return(true)
+
+	// Mock for Create Wallet with invalid user id
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Create Wallet", func(t *testing.T) {
+		err := walletService.CreateWallet(ctx, "user-1", model.WalletRequest{Name: "Cash"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Wallet with currency", func(t *testing.T) {
+		err := walletService.CreateWallet(ctx, "user-1", model.WalletRequest{Name: "Travel", Currency: "USD"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Create Wallet with existing wallet name", func(t *testing.T) {
+		err := walletService.CreateWallet(ctx, "user-2", model.WalletRequest{Name: "Cash"})
+
+		assert.ErrorIs(t, err, service.ErrWalletAlreadyExist)
+	})
+
+	t.Run("Create Wallet with invalid user id", func(t *testing.T) {
+		err := walletService.CreateWallet(ctx, "invalid_id", model.WalletRequest{Name: "Cash"})
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
+
+func TestUpdateWallet(t *testing.T) {
+	ctrl, walletService, mockWalletRepo, _ := SetupWalletService(t)
+	defer ctrl.Finish()
+
+	// Mock for Update Wallet
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", Name: "Cash", User_ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().IsUserWalletExist(gomock.Any(), "user-1", "Pocket").Return(false)
+	mockWalletRepo.EXPECT().UpdateWallet(gomock.Any(), "wallet-1", model.WalletRequest{Name: "Pocket"}).Return(nil)
+
+	// Mock for Update Wallet with the same name
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", Name: "Cash", User_ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().UpdateWallet(gomock.Any(), "wallet-1", model.WalletRequest{Name: "Cash"}).Return(nil)
+
+	// Mock for Update Wallet with existing wallet name
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-2").Return(model.Wallet{ID: "wallet-2", Name: "Bank", User_ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().IsUserWalletExist(gomock.Any(), "user-1", "Cash").Return(true)
+
+	// Mock for Update Wallet with invalid id
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "invalid_id").Return(model.Wallet{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Update Wallet", func(t *testing.T) {
+		err := walletService.UpdateWallet(ctx, "wallet-1", model.WalletRequest{Name: "Pocket"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Update Wallet with the same name", func(t *testing.T) {
+		err := walletService.UpdateWallet(ctx, "wallet-1", model.WalletRequest{Name: "Cash"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Update Wallet with existing wallet name", func(t *testing.T) {
+		err := walletService.UpdateWallet(ctx, "wallet-2", model.WalletRequest{Name: "Cash"})
+
+		assert.ErrorIs(t, err, service.ErrWalletAlreadyExist)
+	})
+
+	t.Run("Update Wallet with invalid id", func(t *testing.T) {
+		err := walletService.UpdateWallet(ctx, "invalid_id", model.WalletRequest{Name: "Cash"})
+
+		assert.ErrorIs(t, err, service.ErrWalletNotFound)
+	})
+}
+
+func TestDeleteWallet(t *testing.T) {
+	ctrl, walletService, mockWalletRepo, _ := SetupWalletService(t)
+	defer ctrl.Finish()
+
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", Name: "Cash", User_ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().DeleteWallet(gomock.Any(), "wallet-1").Return(nil)
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "invalid_id").Return(model.Wallet{}, errors.New("sql: no rows in result set"))
+
+	t.Run("Delete Wallet", func(t *testing.T) {
+		err := walletService.DeleteWallet(ctx, "wallet-1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete Wallet with invalid id", func(t *testing.T) {
+		err := walletService.DeleteWallet(ctx, "invalid_id")
+
+		assert.ErrorIs(t, err, service.ErrWalletNotFound)
+	})
+}
+
+func TestDeleteWallet_WithTransfers(t *testing.T) {
+	ctrl, walletService, mockWalletRepo, _ := SetupWalletService(t)
+	defer ctrl.Finish()
+
+	mockWalletRepo.EXPECT().GetWallet(gomock.Any(), "wallet-1").Return(model.Wallet{ID: "wallet-1", Name: "Cash", User_ID: "user-1"}, nil)
+	mockWalletRepo.EXPECT().DeleteWallet(gomock.Any(), "wallet-1").Return(model.ErrForeignKey)
+
+	err := walletService.DeleteWallet(ctx, "wallet-1")
+
+	assert.ErrorIs(t, err, service.ErrWalletHasTransfers)
+	assert.ErrorIs(t, err, model.ErrConflict)
+}
+
+func TestGetUserNetTotal(t *testing.T) {
+	date := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	wallets := []model.Wallet{
+		{ID: "wallet-1", Name: "Cash", Currency: "IDR", Balance: model.NewMoney(15600000, "IDR"), User_ID: "user-1"},
+		{ID: "wallet-2", Name: "Travel", Currency: "USD", Balance: model.NewMoney(2500, "USD"), User_ID: "user-1"},
+	}
+
+	t.Run("Get User Net Total", func(t *testing.T) {
+		ctrl, walletService, mockWalletRepo, mockUserRepo, mockConverter := SetupWalletServiceWithConverter(t)
+		defer ctrl.Finish()
+
+		mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mockWalletRepo.EXPECT().GetUserWallets(gomock.Any(), "user-1").Return(wallets, nil)
+		mockConverter.EXPECT().Convert(gomock.Any(), wallets[0].Balance, "USD", date).Return(model.NewMoney(1000, "USD"), nil)
+		mockConverter.EXPECT().Convert(gomock.Any(), wallets[1].Balance, "USD", date).Return(wallets[1].Balance, nil)
+
+		total, err := walletService.GetUserNetTotal(ctx, "user-1", "USD", date)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.NewMoney(3500, "USD"), total)
+	})
+
+	t.Run("Get User Net Total without exchange rate", func(t *testing.T) {
+		ctrl, walletService, mockWalletRepo, mockUserRepo, mockConverter := SetupWalletServiceWithConverter(t)
+		defer ctrl.Finish()
+
+		mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mockWalletRepo.EXPECT().GetUserWallets(gomock.Any(), "user-1").Return(wallets, nil)
+		mockConverter.EXPECT().Convert(gomock.Any(), wallets[0].Balance, "SGD", date).Return(model.Money{}, rateService.ErrRateNotFound)
+
+		_, err := walletService.GetUserNetTotal(ctx, "user-1", "SGD", date)
+
+		assert.ErrorIs(t, err, service.ErrRateNotFound)
+	})
+
+	t.Run("Get User Net Total when exchange rates cannot be read", func(t *testing.T) {
+		ctrl, walletService, mockWalletRepo, mockUserRepo, mockConverter := SetupWalletServiceWithConverter(t)
+		defer ctrl.Finish()
+
+		mockUserRepo.EXPECT().GetUser(gomock.Any(), "user-1").Return(model.UserResponse{ID: "user-1"}, nil)
+		mockWalletRepo.EXPECT().GetUserWallets(gomock.Any(), "user-1").Return(wallets, nil)
+		mockConverter.EXPECT().Convert(gomock.Any(), wallets[0].Balance, "SGD", date).Return(model.Money{}, sql.ErrConnDone)
+
+		_, err := walletService.GetUserNetTotal(ctx, "user-1", "SGD", date)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.NotErrorIs(t, err, service.ErrRateNotFound)
+	})
+
+	t.Run("Get User Net Total with invalid user id", func(t *testing.T) {
+		ctrl, walletService, _, mockUserRepo, _ := SetupWalletServiceWithConverter(t)
+		defer ctrl.Finish()
+
+		mockUserRepo.EXPECT().GetUser(gomock.Any(), "invalid_id").Return(model.UserResponse{}, errors.New("sql: no rows in result set"))
+
+		_, err := walletService.GetUserNetTotal(ctx, "invalid_id", "USD", date)
+
+		assert.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/varomnrg/money-tracker/model"
+)
+
+type contextKey string
+
+const authUserKey contextKey = "authUser"
+
+// WithAuthUser returns a copy of ctx carrying the authenticated caller.
+func WithAuthUser(ctx context.Context, user model.AuthUser) context.Context {
+	return context.WithValue(ctx, authUserKey, user)
+}
+
+// AuthUserFromContext returns the authenticated caller stored in ctx, if any.
+func AuthUserFromContext(ctx context.Context) (model.AuthUser, bool) {
+	user, ok := ctx.Value(authUserKey).(model.AuthUser)
+
+	return user, ok && user.ID != ""
+}
+
+// CanAccess reports whether the caller stored in ctx may access a resource
+// owned by ownerID. Requests without an authenticated caller are denied.
+func CanAccess(ctx context.Context, ownerID string) bool {
+	user, ok := AuthUserFromContext(ctx)
+
+	return ok && user.CanAccess(ownerID)
+}
+
+// Authorize loads the resource with id through get and returns it when the
+// caller stored in ctx may access it, judged by the owner ID that owner reads
+// from it. A resource owned by someone else is reported as notFound so its
+// existence is not leaked.
+func Authorize[T any](ctx context.Context, get func(context.Context, string) (T, error), id string, owner func(T) string, notFound error) (T, error) {
+	resource, err := get(ctx, id)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	if !CanAccess(ctx, owner(resource)) {
+		var zero T
+		return zero, notFound
+	}
+
+	return resource, nil
+}
+
+// IsAdmin reports whether the caller stored in ctx is an admin.
+func IsAdmin(ctx context.Context) bool {
+	user, ok := AuthUserFromContext(ctx)
+
+	return ok && user.IsAdmin()
+}
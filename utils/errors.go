@@ -2,29 +2,74 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/varomnrg/money-tracker/model"
 )
 
-func JSONError(w http.ResponseWriter, err error, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	var errorResponse model.ErrorResponse
-	errorResponse.Error = err.Error()
-	json.NewEncoder(w).Encode(errorResponse)
+// ProblemContentType is the media type of every error response.
+const ProblemContentType = "application/problem+json"
+
+var errInternal = model.NewError(model.CodeInternal, "internal server error")
+
+var statusCodes = map[model.ErrorCode]int{
+	model.CodeValidation:   http.StatusBadRequest,
+	model.CodeUnauthorized: http.StatusUnauthorized,
+	model.CodeForbidden:    http.StatusForbidden,
+	model.CodeNotFound:     http.StatusNotFound,
+	model.CodeConflict:     http.StatusConflict,
+	model.CodeInternal:     http.StatusInternalServerError,
 }
 
-func JSONErrorMap(w http.ResponseWriter, err map[string]string, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"error": err,
+// StatusCode returns the HTTP status reported for errors with code.
+func StatusCode(code model.ErrorCode) int {
+	status, ok := statusCodes[code]
+	if !ok {
+		return http.StatusInternalServerError
+	}
+
+	return status
+}
+
+// WriteError writes err as a problem details response whose status follows
+// from the model.Error in err's chain. Any other error is logged and reported
+// as an internal error, without its message.
+func WriteError(w http.ResponseWriter, err error) {
+	var e *model.Error
+	if !errors.As(err, &e) {
+		slog.Error("Internal error", "error", err)
+		e, err = errInternal, errInternal
+	}
+
+	status := StatusCode(e.Code)
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(model.Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: err.Error(),
+		Code:   e.Code,
+		Errors: e.Fields,
 	})
 }
 
+// ValidationError turns the result of validate.Struct into a validation
+// error naming each invalid field.
+func ValidationError(err error, validate *validator.Validate) error {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return err
+	}
+
+	return model.NewValidationError(MapErrors(errs, validate))
+}
+
 func MapErrors(err error, validate *validator.Validate) map[string]string {
 	errs := make(map[string]string)
 
@@ -42,6 +87,10 @@ func MapErrors(err error, validate *validator.Validate) map[string]string {
 			message = fmt.Sprintf("%s must be at most %s character", err.Field(), err.Param())
 		case "alphanum":
 			message = fmt.Sprintf("%s must be alphanumeric", err.Field())
+		case "gt":
+			message = fmt.Sprintf("%s must be greater than %s", err.Field(), err.Param())
+		case "oneof":
+			message = fmt.Sprintf("%s must be one of [%s]", err.Field(), err.Param())
 		default:
 			message = fmt.Sprintf("%s is not valid", err.Field())
 		}
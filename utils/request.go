@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/varomnrg/money-tracker/model"
+)
+
+var (
+	ErrInvalidBody  = model.NewError(model.CodeValidation, "request body is not valid JSON")
+	ErrInvalidLimit = model.NewError(model.CodeValidation, "limit must be a positive integer")
+)
+
+// DecodeJSON decodes the request body into v and validates it against its
+// validate tags. Malformed bodies and invalid fields are reported as
+// validation errors.
+func DecodeJSON(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		if errors.Is(err, model.ErrValidation) {
+			return err
+		}
+
+		return ErrInvalidBody
+	}
+
+	validate := validator.New()
+
+	err = validate.Struct(v)
+	if err != nil {
+		return ValidationError(err, validate)
+	}
+
+	return nil
+}
+
+// ListQuery reads the search, sort, limit and cursor query parameters shared
+// by every listing. Defaults and allowed sorts are left to the service.
+func ListQuery(r *http.Request) (model.ListQuery, error) {
+	values := r.URL.Query()
+
+	query := model.ListQuery{
+		Search: values.Get("search"),
+		Sort:   values.Get("sort"),
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return model.ListQuery{}, ErrInvalidLimit
+		}
+
+		query.Limit = n
+	}
+
+	if cursor := values.Get("cursor"); cursor != "" {
+		after, err := model.ParseCursor(cursor)
+		if err != nil {
+			return model.ListQuery{}, err
+		}
+
+		query.After = after
+	}
+
+	return query, nil
+}
@@ -2,10 +2,16 @@ package utils
 
 import "time"
 
+var location = time.FixedZone("Asia/Jakarta", 7*60*60)
+
+// SetLocation changes the time zone GetCurrentTime reports in. It is meant to
+// be called once at startup, before the server handles requests.
+func SetLocation(loc *time.Location) {
+	location = loc
+}
+
 func GetCurrentTime() time.Time {
 	current := time.Now().UTC()
 
-	location := time.FixedZone("Asia/Jakarta", 7*60*60)
-
 	return current.In(location)
 }